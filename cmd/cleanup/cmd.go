@@ -0,0 +1,96 @@
+package cleanup
+
+import (
+	"fmt"
+
+	"github.com/InditexTech/gh-sherpa/cmd/common"
+	"github.com/InditexTech/gh-sherpa/internal/config"
+	"github.com/InditexTech/gh-sherpa/internal/gh"
+	"github.com/InditexTech/gh-sherpa/internal/git"
+	"github.com/InditexTech/gh-sherpa/internal/interactive"
+	"github.com/InditexTech/gh-sherpa/internal/issue_trackers"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/spf13/cobra"
+)
+
+const cmdName = "cleanup"
+
+var Command = &cobra.Command{
+	Use:     cmdName,
+	Short:   "Delete the branches whose pull request is merged or closed or whose issue is done",
+	Long:    "Delete the local issue branches, and optionally the remote ones, whose pull request has been merged or closed or whose issue is done. The current branch, the base branch and the default branch are never deleted",
+	PreRunE: preRunCommand,
+	RunE:    runCommand,
+	Example: "`gh sherpa " + cmdName + " --dry-run` or `gh sherpa " + cmdName + " --remote --yes`",
+}
+
+type cleanupFlags struct {
+	BaseValue        string
+	DeleteRemote     bool
+	UseDefaultValues bool
+	DryRun           bool
+	OutputFormat     string
+}
+
+var flags cleanupFlags
+
+func init() {
+	Command.PersistentFlags().StringVarP(&flags.BaseValue, "base", "b", "", "base branch that must never be deleted, besides the default branch of the repository")
+	Command.PersistentFlags().BoolVar(&flags.DeleteRemote, "remote", false, "also delete the branches from your fork, only when working with one")
+	Command.PersistentFlags().BoolVar(&flags.DryRun, "dry-run", false, "print the branches that would be deleted without deleting them")
+	Command.PersistentFlags().StringVar(&flags.OutputFormat, "output", "", "output format: '' (default human-readable) or 'json'")
+}
+
+func preRunCommand(cmd *cobra.Command, _ []string) error {
+	yesFlag := cmd.Flags().Lookup("yes")
+	if yesFlag != nil {
+		flags.UseDefaultValues = yesFlag.Changed
+	}
+
+	// Prompts are disabled with --output json, so deleting branches must be explicitly confirmed
+	if flags.OutputFormat == "json" && !flags.UseDefaultValues && !flags.DryRun {
+		return fmt.Errorf("--output json requires --yes or --dry-run")
+	}
+
+	return nil
+}
+
+func runCommand(cmd *cobra.Command, _ []string) error {
+	if flags.OutputFormat != "json" {
+		logging.PrintCommandHeader(cmdName)
+	}
+
+	cfg := config.GetConfig()
+
+	issueTrackers, err := issue_trackers.NewFromConfiguration(cfg)
+	if err != nil {
+		return err
+	}
+
+	isInteractive := !flags.UseDefaultValues
+	// --output json implies non-interactive to prevent stdin prompts from corrupting JSON output.
+	if flags.OutputFormat == "json" {
+		isInteractive = false
+	}
+
+	ghCli := gh.NewClient(cmd.Context(), cfg.Github.Client)
+
+	cleanup := use_cases.Cleanup{
+		Cfg: use_cases.CleanupConfiguration{
+			BaseBranch:    flags.BaseValue,
+			DeleteRemote:  flags.DeleteRemote,
+			IsInteractive: isInteractive,
+			DryRun:        flags.DryRun,
+			OutputFormat:  flags.OutputFormat,
+		},
+		Git:                     git.NewProvider(cfg.Git.Backend),
+		RepositoryProvider:      ghCli,
+		IssueTrackerProvider:    issueTrackers,
+		PullRequestProvider:     ghCli,
+		UserInteractionProvider: &interactive.UserInteractionProvider{},
+	}
+
+	_, err = cleanup.Execute(cmd.Context())
+	return common.OutputError(err, flags.OutputFormat)
+}
@@ -0,0 +1,34 @@
+package common
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/InditexTech/gh-sherpa/internal/config"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+)
+
+// GetCollisionStrategy returns the branch collision strategy given by flag or, if it is empty, the configured one
+func GetCollisionStrategy(cfg config.Configuration, flagValue string) use_cases.BranchCollisionStrategy {
+	if flagValue != "" {
+		return use_cases.BranchCollisionStrategy(flagValue)
+	}
+
+	return use_cases.BranchCollisionStrategy(cfg.Branches.CollisionStrategy)
+}
+
+// ValidateCollisionStrategy returns an error if the given value is not a valid branch collision strategy
+func ValidateCollisionStrategy(value string) error {
+	validValues := []use_cases.BranchCollisionStrategy{
+		use_cases.BranchCollisionFail,
+		use_cases.BranchCollisionReuse,
+		use_cases.BranchCollisionSuffix,
+		use_cases.BranchCollisionAsk,
+	}
+
+	if value != "" && !slices.Contains(validValues, use_cases.BranchCollisionStrategy(value)) {
+		return fmt.Errorf("invalid value %q for --on-collision, valid values are %v", value, validValues)
+	}
+
+	return nil
+}
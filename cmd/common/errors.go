@@ -0,0 +1,77 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+)
+
+// exitCodes are the exit codes of the commands for each error code. The rest of the errors exit
+// with 1. They are documented in the usage guide and must not change between versions.
+var exitCodes = map[domain.ErrorCode]int{
+	domain.ErrorCodeIssueNotFound:     3,
+	domain.ErrorCodeBranchExists:      4,
+	domain.ErrorCodePullRequestExists: 5,
+	domain.ErrorCodeAuthFailed:        6,
+	domain.ErrorCodeUndeterminedType:  7,
+	domain.ErrorCodeNetwork:           8,
+	domain.ErrorCodeRateLimited:       9,
+	domain.ErrorCodeDirtyWorkingTree:  10,
+	domain.ErrorCodeTimeout:           124,
+	domain.ErrorCodeCancelled:         130,
+}
+
+// jsonError is the error printed by the commands with --output json
+type jsonError struct {
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// ExitCode returns the exit code of a command that failed with the error
+func ExitCode(err error) int {
+	if code, ok := exitCodes[domain.ErrorCodeOf(err)]; ok {
+		return code
+	}
+
+	return 1
+}
+
+// JSONOutputError is the error of a command run with --output json. It is printed as a JSON object
+// by PrintError.
+type JSONOutputError struct {
+	Err error
+}
+
+func (e *JSONOutputError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *JSONOutputError) Unwrap() error {
+	return e.Err
+}
+
+// OutputError returns the error of a command to be printed in its output format
+func OutputError(err error, outputFormat string) error {
+	if err == nil || outputFormat != "json" {
+		return err
+	}
+
+	return &JSONOutputError{Err: err}
+}
+
+// PrintError prints the error of a command. The errors of the commands run with --output json are
+// printed with their code as a JSON object to stderr.
+func PrintError(err error) {
+	var jsonOutputErr *JSONOutputError
+	if !errors.As(err, &jsonOutputErr) {
+		logging.Error(err.Error())
+		return
+	}
+
+	errJSON, _ := json.Marshal(jsonError{Error: jsonOutputErr.Err.Error(), Code: string(domain.ErrorCodeOf(err))})
+	fmt.Fprintln(os.Stderr, string(errJSON))
+}
@@ -1,25 +1,29 @@
 package common
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/InditexTech/gh-sherpa/internal/config"
 	"github.com/InditexTech/gh-sherpa/internal/domain"
 	"github.com/InditexTech/gh-sherpa/internal/fork"
 	"github.com/InditexTech/gh-sherpa/internal/gh"
 	"github.com/InditexTech/gh-sherpa/internal/git"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
 )
 
-// SetupForkForCommand handles the common fork setup logic for both create-branch and create-pr commands
+// SetupForkForCommand handles the common fork setup logic for both create-branch and create-pr commands.
+// It adds the fork setup to the plan of the command, to be run before the rest of its steps. The
+// returned fork is the planned one, and it is updated with the result of the setup when it runs.
 func SetupForkForCommand(
+	ctx context.Context,
 	cfg config.Configuration,
 	forkNameValue string,
-	issueValue string,
-	ghCli *gh.Cli,
+	ghCli gh.Client,
 	userInteraction domain.UserInteractionProvider,
 	isInteractive bool,
-	messageType string, // "issue" or "pull request"
-) error {
-	forkName := forkNameValue
-
+	plan *use_cases.Plan,
+) (*use_cases.ForkResult, error) {
 	forkCfg := domain.ForkConfiguration{
 		DefaultOrganization: cfg.Github.ForkOrganization,
 		IsInteractive:       isInteractive,
@@ -28,19 +32,35 @@ func SetupForkForCommand(
 	forkManager := fork.NewManager(
 		forkCfg,
 		ghCli,
-		&git.Provider{},
+		git.NewProvider(cfg.Git.Backend),
 		userInteraction,
 		ghCli,
 	)
 
-	result, err := forkManager.SetupFork(forkName)
+	forkName, create, err := forkManager.PlanFork(ctx, forkNameValue)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	forkResult := &use_cases.ForkResult{Name: forkName, Created: create}
 
-	if result.WasAlreadyConfigured {
-		return nil
+	step := use_cases.PlanStep{Action: use_cases.PlanActionSetupFork, Fork: forkName}
+	switch {
+	case create && forkName == "":
+		step.Description = "Create a fork of the repository and set up its remotes"
+	case create:
+		step.Description = fmt.Sprintf("Create the fork %s and set up its remotes", forkName)
 	}
 
-	return nil
+	plan.Add(step, func() error {
+		setup, err := forkManager.SetupFork(ctx, forkNameValue)
+		if err != nil {
+			return err
+		}
+		forkResult.Name = setup.ForkName
+		forkResult.Upstream = setup.UpstreamName
+		forkResult.Created = setup.ForkCreated
+		return nil
+	})
+
+	return forkResult, nil
 }
@@ -0,0 +1,22 @@
+package common
+
+import (
+	"slices"
+
+	"github.com/InditexTech/gh-sherpa/internal/config"
+)
+
+// GetTypeLabels returns all the labels mapped to an issue type in the configuration
+func GetTypeLabels(cfg config.Configuration) []string {
+	labels := []string{}
+	for _, typeLabels := range cfg.Github.IssueLabels {
+		for _, label := range typeLabels {
+			if !slices.Contains(labels, label) {
+				labels = append(labels, label)
+			}
+		}
+	}
+	slices.Sort(labels)
+
+	return labels
+}
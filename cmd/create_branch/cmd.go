@@ -1,9 +1,7 @@
 package create_branch
 
 import (
-	"encoding/json"
 	"fmt"
-	"os"
 
 	"github.com/InditexTech/gh-sherpa/cmd/common"
 	"github.com/InditexTech/gh-sherpa/internal/branches"
@@ -31,6 +29,7 @@ var Command = &cobra.Command{
 
 type createBranchFlags struct {
 	IssueValue        string
+	QueryValue        string
 	BaseValue         string
 	NoFetchValue      bool
 	UseDefaultValues  bool
@@ -42,19 +41,20 @@ type createBranchFlags struct {
 	BranchName        string
 	DryRun            bool
 	OutputFormat      string
+	OnCollision       string
+	CarryChanges      bool
+	WorktreePath      string
 }
 
 var flags = createBranchFlags{}
 
-func init() {
-	Command.PersistentFlags().StringVarP(&flags.IssueValue, "issue", "i", "", "issue identifier")
-	err := Command.MarkPersistentFlagRequired("issue")
+// worktreeFromConfig is the value of --worktree when it is used without a path
+const worktreeFromConfig = "default"
 
-	if err != nil {
-		logging.Errorf("error while setting up the command: %s", err)
-		os.Exit(1)
-	}
+func init() {
+	Command.PersistentFlags().StringVarP(&flags.IssueValue, "issue", "i", "", "issue identifier. Choose one of the issues assigned to you if it is not set")
 
+	Command.PersistentFlags().StringVar(&flags.QueryValue, "query", "", "name of a saved query to choose the issue among its results when the issue is not set")
 	Command.PersistentFlags().StringVarP(&flags.BaseValue, "base", "b", "", "base branch for checkout. Use the default branch of the repository if it is not set")
 	Command.PersistentFlags().BoolVar(&flags.NoFetchValue, "no-fetch", false, "does not fetch the base branch")
 	Command.PersistentFlags().BoolVar(&flags.ForkValue, "fork", false, "automatically set up fork for external contributors")
@@ -63,11 +63,19 @@ func init() {
 	Command.PersistentFlags().StringVar(&flags.BranchType, "branch-type", "", "force a specific branch type prefix (e.g. feature, bugfix, hotfix)")
 	Command.PersistentFlags().StringVar(&flags.BranchDescription, "branch-description", "", "force a specific branch description slug instead of deriving it from the issue title")
 	Command.PersistentFlags().StringVar(&flags.BranchName, "branch-name", "", "use exactly this branch name instead of auto-generating one")
-	Command.PersistentFlags().BoolVar(&flags.DryRun, "dry-run", false, "print what would happen without actually creating the branch")
+	Command.PersistentFlags().BoolVar(&flags.DryRun, "dry-run", false, "print the steps that would be done, like the fetch and the branch creation, without doing them")
 	Command.PersistentFlags().StringVar(&flags.OutputFormat, "output", "", "output format: '' (default human-readable) or 'json'")
+	Command.PersistentFlags().StringVar(&flags.OnCollision, "on-collision", "", "what to do if the branch already exists: fail, reuse, suffix or ask. Uses the configured strategy if it is not set")
+	Command.PersistentFlags().BoolVar(&flags.CarryChanges, "carry-changes", false, "stash the uncommitted changes and apply them again on the new branch")
+	Command.PersistentFlags().StringVar(&flags.WorktreePath, "worktree", "", "create the branch in a new git worktree at this path (--worktree=<path>). Use the git.worktree_path setting if the path is not set")
+	Command.PersistentFlags().Lookup("worktree").NoOptDefVal = worktreeFromConfig
+	Command.MarkFlagsMutuallyExclusive("worktree", "carry-changes")
 }
 
 func runCommand(cmd *cobra.Command, _ []string) (err error) {
+	// The errors before running the use case, like the ones setting up the fork, are also printed as JSON
+	defer func() { err = common.OutputError(err, flags.OutputFormat) }()
+
 	if flags.OutputFormat != "json" {
 		logging.PrintCommandHeader(cmdName)
 	}
@@ -87,12 +95,19 @@ func runCommand(cmd *cobra.Command, _ []string) (err error) {
 		isInteractive = false
 	}
 
-	ghCli := &gh.Cli{}
+	ghCli := gh.NewClient(cmd.Context(), cfg.Github.Client)
 
+	plan := &use_cases.Plan{}
+	var fork *use_cases.ForkResult
 	if flags.ForkValue {
-		if err := common.SetupForkForCommand(cfg, flags.ForkNameValue, flags.IssueValue, ghCli, userInteraction, isInteractive, "issue"); err != nil {
+		if fork, err = common.SetupForkForCommand(cmd.Context(), cfg, flags.ForkNameValue, ghCli, userInteraction, isInteractive, plan); err != nil {
 			return err
 		}
+		if !flags.DryRun {
+			if err := plan.Run(); err != nil {
+				return err
+			}
+		}
 	}
 
 	branchProviderCfg := branches.Configuration{
@@ -109,37 +124,42 @@ func runCommand(cmd *cobra.Command, _ []string) (err error) {
 
 	createBranchConfig := use_cases.CreateBranchConfiguration{
 		IssueID:         flags.IssueValue,
+		Query:           flags.QueryValue,
 		BaseBranch:      flags.BaseValue,
 		FetchFromOrigin: !flags.NoFetchValue,
 		IsInteractive:   isInteractive,
 		BranchName:      flags.BranchName,
 		DryRun:          flags.DryRun,
 		OutputFormat:    flags.OutputFormat,
+		CarryChanges:    flags.CarryChanges,
+		Worktree:        flags.WorktreePath != "",
+		WorktreePath:    worktreePath(cfg),
+		OnCollision:     common.GetCollisionStrategy(cfg, flags.OnCollision),
 	}
 	createBranch := use_cases.CreateBranch{
 		Cfg:                     createBranchConfig,
-		Git:                     &git.Provider{},
+		Git:                     git.NewProvider(cfg.Git.Backend),
 		RepositoryProvider:      ghCli,
 		IssueTrackerProvider:    issueTrackers,
 		UserInteractionProvider: userInteraction,
 		BranchProvider:          branchProvider,
+		Plan:                    plan,
+		Fork:                    fork,
 	}
 
-	_, err = createBranch.Execute()
+	_, err = createBranch.Execute(cmd.Context())
+	return err
+}
 
-	if err != nil {
-		if flags.OutputFormat == "json" {
-			errJSON, _ := json.Marshal(map[string]string{"error": err.Error()})
-			fmt.Fprintln(os.Stderr, string(errJSON))
-			os.Exit(1)
-		}
+func preRunCommand(cmd *cobra.Command, _ []string) error {
+	if err := common.ValidateCollisionStrategy(flags.OnCollision); err != nil {
 		return err
 	}
 
-	return
-}
+	if flags.IssueValue != "" && flags.QueryValue != "" {
+		return fmt.Errorf("--issue and --query cannot be used together")
+	}
 
-func preRunCommand(cmd *cobra.Command, _ []string) error {
 	if cmd.Flags().Lookup("no-fetch").Changed {
 		if err := cmd.MarkFlagRequired("issue"); err != nil {
 			return err
@@ -153,3 +173,11 @@ func preRunCommand(cmd *cobra.Command, _ []string) error {
 
 	return nil
 }
+
+// worktreePath returns the path given with --worktree or, if it is not set, the configured one
+func worktreePath(cfg config.Configuration) string {
+	if flags.WorktreePath == worktreeFromConfig {
+		return cfg.Git.WorktreePath
+	}
+	return flags.WorktreePath
+}
@@ -1,9 +1,7 @@
 package create_pull_request
 
 import (
-	"encoding/json"
 	"fmt"
-	"os"
 
 	"github.com/InditexTech/gh-sherpa/cmd/common"
 	"github.com/InditexTech/gh-sherpa/internal/branches"
@@ -32,6 +30,7 @@ var Command = &cobra.Command{
 type createPullRequestFlags struct {
 	IssueID             string
 	BaseBranch          string
+	StackOn             string
 	NoFetch             bool
 	NoDraft             bool
 	NoCloseIssue        bool
@@ -52,13 +51,18 @@ type createPullRequestFlags struct {
 	ExtraLabels         []string
 	Reviewers           []string
 	Assignees           []string
+	UpdateExisting      bool
+	OnCollision         string
+	RollbackOnFailure   bool
+	CarryChanges        bool
 }
 
 var flags createPullRequestFlags
 
 func init() {
-	Command.PersistentFlags().StringVarP(&flags.IssueID, "issue", "i", "", "issue identifier")
+	Command.PersistentFlags().StringVarP(&flags.IssueID, "issue", "i", "", "issue identifier. Use the issue of the current branch or choose one of the issues assigned to you if it is not set")
 	Command.PersistentFlags().StringVarP(&flags.BaseBranch, "base", "b", "", "base branch for checkout. Use the default branch of the repository if it is not set")
+	Command.PersistentFlags().StringVar(&flags.StackOn, "stack-on", "", "create the branch from this branch and use it as base of the pull request, recording it as its parent")
 	Command.PersistentFlags().BoolVar(&flags.NoFetch, "no-fetch", false, "does not fetch the base branch")
 	Command.PersistentFlags().BoolVar(&flags.NoDraft, "no-draft", false, "create the pull request in ready for review mode")
 	Command.PersistentFlags().BoolVarP(&flags.NoCloseIssue, "no-close-issue", "n", false, "do not close the GitHub issue after merging the pull request")
@@ -69,7 +73,7 @@ func init() {
 	Command.PersistentFlags().StringVar(&flags.BranchType, "branch-type", "", "force a specific branch type prefix (e.g. feature, bugfix, hotfix)")
 	Command.PersistentFlags().StringVar(&flags.BranchDescription, "branch-description", "", "force a specific branch description slug instead of deriving it from the issue title")
 	Command.PersistentFlags().StringVar(&flags.BranchName, "branch-name", "", "use exactly this branch name instead of auto-generating one")
-	Command.PersistentFlags().BoolVar(&flags.DryRun, "dry-run", false, "print what would happen without actually creating the PR")
+	Command.PersistentFlags().BoolVar(&flags.DryRun, "dry-run", false, "print the steps that would be done, like the push and the pull request creation, without doing them")
 	Command.PersistentFlags().StringVar(&flags.OutputFormat, "output", "", "output format: '' (default human-readable) or 'json'")
 	Command.PersistentFlags().StringVar(&flags.PRTitle, "pr-title", "", "override the auto-generated PR title")
 	Command.PersistentFlags().StringVar(&flags.PRBody, "pr-body", "", "override the auto-generated PR body")
@@ -78,9 +82,16 @@ func init() {
 	Command.PersistentFlags().StringArrayVar(&flags.ExtraLabels, "label", []string{}, "additional label to apply to the PR (can be repeated)")
 	Command.PersistentFlags().StringArrayVar(&flags.Reviewers, "reviewer", []string{}, "request a review from this user or team (can be repeated)")
 	Command.PersistentFlags().StringArrayVar(&flags.Assignees, "assignee", []string{}, "assign this user to the PR (can be repeated)")
+	Command.PersistentFlags().BoolVar(&flags.UpdateExisting, "update-existing", false, "update the title, body and type label of the open pull request of the branch instead of failing")
+	Command.PersistentFlags().BoolVar(&flags.RollbackOnFailure, "rollback-on-failure", false, "undo the changes made in the repository without asking if the pull request cannot be created")
+	Command.PersistentFlags().BoolVar(&flags.CarryChanges, "carry-changes", false, "stash the uncommitted changes and apply them again on the branch of the pull request")
+	Command.PersistentFlags().StringVar(&flags.OnCollision, "on-collision", "", "what to do if the new branch already exists: fail, reuse, suffix or ask. Uses the configured strategy if it is not set")
 }
 
-func runCommand(cmd *cobra.Command, _ []string) error {
+func runCommand(cmd *cobra.Command, _ []string) (err error) {
+	// The errors before running the use case, like the ones setting up the fork, are also printed as JSON
+	defer func() { err = common.OutputError(err, flags.OutputFormat) }()
+
 	isIssueIDFlagUsed := cmd.Flags().Lookup("issue").Changed
 
 	if isIssueIDFlagUsed && flags.IssueID == "" {
@@ -118,17 +129,25 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	ghCliProvider := &gh.Cli{}
+	ghCliProvider := gh.NewClient(cmd.Context(), cfg.Github.Client)
 
+	plan := &use_cases.Plan{}
+	var fork *use_cases.ForkResult
 	if flags.ForkValue {
-		if err := common.SetupForkForCommand(cfg, flags.ForkNameValue, flags.IssueID, ghCliProvider, userInteraction, isInteractive, "pull request"); err != nil {
+		if fork, err = common.SetupForkForCommand(cmd.Context(), cfg, flags.ForkNameValue, ghCliProvider, userInteraction, isInteractive, plan); err != nil {
 			return err
 		}
+		if !flags.DryRun {
+			if err := plan.Run(); err != nil {
+				return err
+			}
+		}
 	}
 
 	createPullRequestConfig := use_cases.CreatePullRequestConfiguration{
 		IssueID:             flags.IssueID,
 		BaseBranch:          flags.BaseBranch,
+		StackOn:             flags.StackOn,
 		FetchFromOrigin:     !flags.NoFetch,
 		IsInteractive:       isInteractive,
 		DraftPR:             !flags.NoDraft,
@@ -144,27 +163,42 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 		ExtraLabels:         flags.ExtraLabels,
 		Reviewers:           flags.Reviewers,
 		Assignees:           flags.Assignees,
+		UpdateExisting:      flags.UpdateExisting,
+		CarryChanges:        flags.CarryChanges,
+		RollbackOnFailure:   flags.RollbackOnFailure,
+		TypeLabels:          common.GetTypeLabels(cfg),
+		OnCollision:         common.GetCollisionStrategy(cfg, flags.OnCollision),
+		InitialCommit: use_cases.InitialCommitConfiguration{
+			Message: cfg.PullRequests.InitialCommit.Message,
+			Skip:    cfg.PullRequests.InitialCommit.Skip,
+			Signoff: cfg.PullRequests.InitialCommit.Signoff,
+		},
 	}
 	createPullRequestUseCase := use_cases.CreatePullRequest{
 		Cfg:                     createPullRequestConfig,
-		Git:                     &git.Provider{},
+		Git:                     git.NewProvider(cfg.Git.Backend),
 		RepositoryProvider:      ghCliProvider,
 		IssueTrackerProvider:    issueTrackers,
 		UserInteractionProvider: userInteraction,
 		PullRequestProvider:     ghCliProvider,
 		BranchProvider:          branchProvider,
+		Plan:                    plan,
+		Fork:                    fork,
 	}
 
-	_, err = createPullRequestUseCase.Execute()
-	if err != nil && flags.OutputFormat == "json" {
-		errJSON, _ := json.Marshal(map[string]string{"error": err.Error()})
-		fmt.Fprintln(os.Stderr, string(errJSON))
-		os.Exit(1)
-	}
+	_, err = createPullRequestUseCase.Execute(cmd.Context())
 	return err
 }
 
 func preRunCommand(cmd *cobra.Command, _ []string) error {
+	if err := common.ValidateCollisionStrategy(flags.OnCollision); err != nil {
+		return err
+	}
+
+	if flags.StackOn != "" && flags.BaseBranch != "" {
+		return fmt.Errorf("--stack-on and --base cannot be used together")
+	}
+
 	if cmd.Flags().Lookup("no-fetch").Changed {
 		logging.Debug("Flag no-fetch used found, marking issue flag as required...")
 		if err := cmd.MarkFlagRequired("issue"); err != nil {
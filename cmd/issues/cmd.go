@@ -0,0 +1,56 @@
+package issues
+
+import (
+	"github.com/InditexTech/gh-sherpa/cmd/common"
+	"github.com/InditexTech/gh-sherpa/internal/config"
+	"github.com/InditexTech/gh-sherpa/internal/issue_trackers"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/spf13/cobra"
+)
+
+const cmdName = "issues"
+
+var Command = &cobra.Command{
+	Use:     cmdName,
+	Short:   "List the open issues assigned to you",
+	Long:    "List the open issues assigned to you in the GitHub repository and, if it is configured, in Jira, or the issues matching a saved query",
+	RunE:    runCommand,
+	Example: "`gh sherpa " + cmdName + "`, `gh sherpa " + cmdName + " --query sprint` or `gh sherpa " + cmdName + " --output json`",
+}
+
+type issuesFlags struct {
+	Query        string
+	OutputFormat string
+}
+
+var flags issuesFlags
+
+func init() {
+	Command.PersistentFlags().StringVar(&flags.Query, "query", "", "name of the saved query to run, as configured in jira.queries or github.queries")
+	Command.PersistentFlags().StringVar(&flags.OutputFormat, "output", "", "output format: '' (default human-readable) or 'json'")
+}
+
+func runCommand(cmd *cobra.Command, _ []string) error {
+	if flags.OutputFormat != "json" {
+		logging.PrintCommandHeader(cmdName)
+	}
+
+	cfg := config.GetConfig()
+
+	issueTrackers, err := issue_trackers.NewFromConfiguration(cfg)
+	if err != nil {
+		return err
+	}
+
+	issues := use_cases.Issues{
+		Cfg: use_cases.IssuesConfiguration{
+			Query:        flags.Query,
+			OutputFormat: flags.OutputFormat,
+		},
+		IssueTrackerProvider: issueTrackers,
+	}
+
+	_, err = issues.Execute(cmd.Context())
+	return common.OutputError(err, flags.OutputFormat)
+}
@@ -0,0 +1,97 @@
+package list
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/InditexTech/gh-sherpa/cmd/common"
+	"github.com/InditexTech/gh-sherpa/internal/config"
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/gh"
+	"github.com/InditexTech/gh-sherpa/internal/git"
+	"github.com/InditexTech/gh-sherpa/internal/issue_trackers"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/spf13/cobra"
+)
+
+const cmdName = "list"
+
+var Command = &cobra.Command{
+	Use:     cmdName,
+	Short:   "List the local and remote branches linked to an issue",
+	Long:    "List the local and remote branches linked to an issue, showing the issue title and status and the pull request of each branch",
+	PreRunE: preRunCommand,
+	RunE:    runCommand,
+	Example: "`gh sherpa " + cmdName + "`, `gh sherpa " + cmdName + " --tracker jira --stale 30d` or `gh sherpa " + cmdName + " --merged --output json`",
+	Aliases: []string{"ls"},
+}
+
+type listFlags struct {
+	Tracker      string
+	Merged       bool
+	Stale        string
+	Concurrency  int
+	OutputFormat string
+}
+
+var flags listFlags
+
+func init() {
+	Command.PersistentFlags().StringVar(&flags.Tracker, "tracker", "", "only list branches of issues from this issue tracker: 'github' or 'jira'")
+	Command.PersistentFlags().BoolVar(&flags.Merged, "merged", false, "only list branches whose pull request has been merged")
+	Command.PersistentFlags().StringVar(&flags.Stale, "stale", "", "only list branches without commits for at least this time (e.g. 30d, 2w, 12h)")
+	Command.PersistentFlags().IntVar(&flags.Concurrency, "concurrency", use_cases.DefaultListConcurrency, "maximum number of branches fetched at the same time")
+	Command.PersistentFlags().StringVar(&flags.OutputFormat, "output", "", "output format: '' (default human-readable) or 'json'")
+}
+
+func preRunCommand(cmd *cobra.Command, _ []string) error {
+	validTrackers := []string{domain.IssueTrackerTypeGithub.String(), domain.IssueTrackerTypeJira.String()}
+	if flags.Tracker != "" && !slices.Contains(validTrackers, flags.Tracker) {
+		return fmt.Errorf("invalid value %q for --tracker, valid values are %v", flags.Tracker, validTrackers)
+	}
+
+	if flags.Stale != "" {
+		if _, err := use_cases.ParseAge(flags.Stale); err != nil {
+			return fmt.Errorf("invalid value for --stale: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func runCommand(cmd *cobra.Command, _ []string) error {
+	if flags.OutputFormat != "json" {
+		logging.PrintCommandHeader(cmdName)
+	}
+
+	cfg := config.GetConfig()
+
+	issueTrackers, err := issue_trackers.NewFromConfiguration(cfg)
+	if err != nil {
+		return err
+	}
+
+	var stale time.Duration
+	if flags.Stale != "" {
+		// Already validated in preRunCommand
+		stale, _ = use_cases.ParseAge(flags.Stale)
+	}
+
+	list := use_cases.List{
+		Cfg: use_cases.ListConfiguration{
+			Tracker:      flags.Tracker,
+			Merged:       flags.Merged,
+			Stale:        stale,
+			OutputFormat: flags.OutputFormat,
+			Concurrency:  flags.Concurrency,
+		},
+		Git:                  git.NewProvider(cfg.Git.Backend),
+		IssueTrackerProvider: issueTrackers,
+		PullRequestProvider:  gh.NewClient(cmd.Context(), cfg.Github.Client),
+	}
+
+	_, err = list.Execute(cmd.Context())
+	return common.OutputError(err, flags.OutputFormat)
+}
@@ -0,0 +1,88 @@
+package pull_request
+
+import (
+	"github.com/InditexTech/gh-sherpa/cmd/common"
+	"github.com/InditexTech/gh-sherpa/internal/config"
+	"github.com/InditexTech/gh-sherpa/internal/gh"
+	"github.com/InditexTech/gh-sherpa/internal/git"
+	"github.com/InditexTech/gh-sherpa/internal/interactive"
+	"github.com/InditexTech/gh-sherpa/internal/issue_trackers"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/spf13/cobra"
+)
+
+const cmdName = "pr"
+
+const refreshCmdName = "refresh"
+
+var Command = &cobra.Command{
+	Use:   cmdName,
+	Short: "Manage the pull request of an issue branch",
+	Long:  "Manage the pull request created for an issue branch",
+}
+
+var refreshCommand = &cobra.Command{
+	Use:     refreshCmdName,
+	Short:   "Update the pull request title, body and type label from its issue",
+	Long:    "Render again the title, body and type label of the pull request of the branch from its issue and apply them, keeping the content written below the sherpa marker of the body",
+	RunE:    runRefreshCommand,
+	Example: "`gh sherpa " + cmdName + " " + refreshCmdName + "` or `gh sherpa " + cmdName + " " + refreshCmdName + " --template .github/pull_request_template.md`",
+}
+
+type refreshFlags struct {
+	BranchName       string
+	NoCloseIssue     bool
+	TemplatePath     string
+	UseDefaultValues bool
+	OutputFormat     string
+}
+
+var flags refreshFlags
+
+func init() {
+	refreshCommand.PersistentFlags().StringVar(&flags.BranchName, "branch", "", "branch of the pull request. Use the current branch if it is not set")
+	refreshCommand.PersistentFlags().BoolVarP(&flags.NoCloseIssue, "no-close-issue", "n", false, "do not close the GitHub issue after merging the pull request")
+	refreshCommand.PersistentFlags().StringVar(&flags.TemplatePath, "template", "", "path to a pull request template file")
+	refreshCommand.PersistentFlags().StringVar(&flags.OutputFormat, "output", "", "output format: '' (default human-readable) or 'json'")
+
+	Command.AddCommand(refreshCommand)
+}
+
+func runRefreshCommand(cmd *cobra.Command, _ []string) error {
+	if flags.OutputFormat != "json" {
+		logging.PrintCommandHeader(cmdName + " " + refreshCmdName)
+	}
+
+	yesFlag := cmd.Flags().Lookup("yes")
+	if yesFlag != nil {
+		flags.UseDefaultValues = yesFlag.Changed
+	}
+
+	cfg := config.GetConfig()
+
+	issueTrackers, err := issue_trackers.NewFromConfiguration(cfg)
+	if err != nil {
+		return err
+	}
+
+	ghCli := gh.NewClient(cmd.Context(), cfg.Github.Client)
+
+	refresh := use_cases.RefreshPullRequest{
+		Cfg: use_cases.RefreshPullRequestConfiguration{
+			BranchName:    flags.BranchName,
+			CloseIssue:    !flags.NoCloseIssue,
+			TemplatePath:  flags.TemplatePath,
+			TypeLabels:    common.GetTypeLabels(cfg),
+			IsInteractive: !flags.UseDefaultValues,
+			OutputFormat:  flags.OutputFormat,
+		},
+		Git:                     git.NewProvider(cfg.Git.Backend),
+		IssueTrackerProvider:    issueTrackers,
+		UserInteractionProvider: &interactive.UserInteractionProvider{},
+		PullRequestProvider:     ghCli,
+	}
+
+	_, err = refresh.Execute(cmd.Context())
+	return common.OutputError(err, flags.OutputFormat)
+}
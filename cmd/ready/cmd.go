@@ -0,0 +1,82 @@
+package ready
+
+import (
+	"time"
+
+	"github.com/InditexTech/gh-sherpa/cmd/common"
+	"github.com/InditexTech/gh-sherpa/internal/config"
+	"github.com/InditexTech/gh-sherpa/internal/gh"
+	"github.com/InditexTech/gh-sherpa/internal/git"
+	"github.com/InditexTech/gh-sherpa/internal/issue_trackers"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/spf13/cobra"
+)
+
+const cmdName = "ready"
+
+var Command = &cobra.Command{
+	Use:     cmdName,
+	Short:   "Mark the draft pull request of the current branch as ready for review",
+	Long:    "Check the branch is pushed, optionally wait for the required checks to pass, request the reviewers from the configuration or the CODEOWNERS file, mark the pull request as ready for review and transition its Jira issue if it is configured",
+	RunE:    runCommand,
+	Example: "`gh sherpa " + cmdName + "` or `gh sherpa " + cmdName + " --wait-checks --checks-timeout 20m --reviewer octocat`",
+}
+
+type commandFlags struct {
+	WaitForChecks bool
+	ChecksTimeout time.Duration
+	Reviewers     []string
+	NoTransition  bool
+	OutputFormat  string
+}
+
+var flags commandFlags
+
+func init() {
+	Command.PersistentFlags().BoolVar(&flags.WaitForChecks, "wait-checks", false, "wait for the required checks of the pull request to pass")
+	Command.PersistentFlags().DurationVar(&flags.ChecksTimeout, "checks-timeout", 10*time.Minute, "maximum time to wait for the required checks")
+	Command.PersistentFlags().StringSliceVar(&flags.Reviewers, "reviewer", nil, "reviewer to request, it can be repeated. Overrides the pull_requests.reviewers setting")
+	Command.PersistentFlags().BoolVar(&flags.NoTransition, "no-transition", false, "do not transition the Jira issue of the branch")
+	Command.PersistentFlags().StringVar(&flags.OutputFormat, "output", "", "output format: '' (default human-readable) or 'json'")
+}
+
+func runCommand(cmd *cobra.Command, _ []string) error {
+	if flags.OutputFormat != "json" {
+		logging.PrintCommandHeader(cmdName)
+	}
+
+	cfg := config.GetConfig()
+
+	issueTrackers, err := issue_trackers.NewFromConfiguration(cfg)
+	if err != nil {
+		return err
+	}
+
+	reviewers := cfg.PullRequests.Reviewers
+	if cmd.Flags().Changed("reviewer") {
+		reviewers = flags.Reviewers
+	}
+
+	jiraTransition := cfg.Jira.Transitions.ReadyForReview
+	if flags.NoTransition {
+		jiraTransition = ""
+	}
+
+	ready := use_cases.Ready{
+		Cfg: use_cases.ReadyConfiguration{
+			WaitForChecks:     flags.WaitForChecks,
+			ChecksTimeout:     flags.ChecksTimeout,
+			Reviewers:         reviewers,
+			RequestCodeOwners: cfg.PullRequests.RequestCodeOwners,
+			JiraTransition:    jiraTransition,
+			OutputFormat:      flags.OutputFormat,
+		},
+		Git:                  git.NewProvider(cfg.Git.Backend),
+		IssueTrackerProvider: issueTrackers,
+		PullRequestProvider:  gh.NewClient(cmd.Context(), cfg.Github.Client),
+	}
+
+	_, err = ready.Execute(cmd.Context())
+	return common.OutputError(err, flags.OutputFormat)
+}
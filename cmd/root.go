@@ -1,12 +1,27 @@
 package cmd
 
 import (
+	"context"
 	"os"
+	"os/signal"
 	"strings"
+	"time"
 
+	"github.com/InditexTech/gh-sherpa/cmd/cleanup"
+	"github.com/InditexTech/gh-sherpa/cmd/common"
 	"github.com/InditexTech/gh-sherpa/cmd/create_branch"
 	"github.com/InditexTech/gh-sherpa/cmd/create_pull_request"
+	"github.com/InditexTech/gh-sherpa/cmd/issues"
+	"github.com/InditexTech/gh-sherpa/cmd/list"
+	"github.com/InditexTech/gh-sherpa/cmd/pull_request"
+	"github.com/InditexTech/gh-sherpa/cmd/ready"
+	"github.com/InditexTech/gh-sherpa/cmd/stack"
+	"github.com/InditexTech/gh-sherpa/cmd/status"
+	"github.com/InditexTech/gh-sherpa/cmd/switch_branch"
+	"github.com/InditexTech/gh-sherpa/cmd/worktree"
 	"github.com/InditexTech/gh-sherpa/internal/config"
+	"github.com/InditexTech/gh-sherpa/internal/gh"
+	"github.com/InditexTech/gh-sherpa/internal/git"
 	"github.com/InditexTech/gh-sherpa/internal/logging"
 
 	"github.com/spf13/cobra"
@@ -20,13 +35,49 @@ var rootCmd = &cobra.Command{
 	SilenceUsage:  true,
 	SilenceErrors: true,
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// The messages for the user go to stderr so the JSON result is the only standard output
+		if output := cmd.Flags().Lookup("output"); output != nil && output.Value.String() == "json" {
+			logging.SetOutput(os.Stderr)
+		}
+
+		logOptions := logging.Options{Level: logLevel, Format: logFormat}
+		if err := logging.Configure(logOptions); err != nil {
+			return err
+		}
+
 		// Ignore initialization when the command is help
 		if strings.HasPrefix(cmd.Use, "help") {
 			return nil
 		}
 
 		isInteractive := !useDefaultValues
-		return config.Initialize(isInteractive)
+		if err := config.Initialize(isInteractive); err != nil {
+			return err
+		}
+
+		cfg := config.GetConfig()
+		if cfg.Log.File.Enabled {
+			fileOptions, err := logFileOptions(cfg.Log.File)
+			if err != nil {
+				return err
+			}
+			logOptions.File = fileOptions
+			if err := logging.Configure(logOptions); err != nil {
+				return err
+			}
+		}
+		logging.Logger().Debug("Running command", "command", cmd.CommandPath(), "args", args)
+
+		if timeout > 0 {
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			cancelTimeout = cancel
+			cmd.SetContext(ctx)
+		}
+
+		git.SetRemotes(cfg.Remotes.Push, cfg.Remotes.Base)
+		gh.ConfigureHost(cmd.Context(), cfg.Github.Host)
+		gh.SetGitProtocol(cfg.Github.GitProtocol)
+		return nil
 	},
 }
 
@@ -34,25 +85,78 @@ const versionTemplate string = `GitHub CLI Sherpa Extension - Version: {{ printf
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
+// The context of the commands is cancelled with Ctrl-C, and a second Ctrl-C stops the process.
 func Execute() {
-	err := rootCmd.Execute()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
 
+	err := rootCmd.ExecuteContext(ctx)
+	stop()
+	if cancelTimeout != nil {
+		cancelTimeout()
+	}
 	if err != nil {
-		logging.Error(err.Error())
-		os.Exit(1)
+		common.PrintError(err)
+	}
+	logging.Close()
+
+	if err != nil {
+		os.Exit(common.ExitCode(err))
 	}
 }
 
 var useDefaultValues bool
 
+var (
+	logLevel  string
+	logFormat string
+)
+
+var (
+	// timeout is the maximum duration of the command, 0 to not limit it
+	timeout time.Duration
+	// cancelTimeout releases the context with the timeout of the command
+	cancelTimeout context.CancelFunc
+)
+
+// logFileOptions returns the options of the log file configured in the log.file settings
+func logFileOptions(cfg config.LogFile) (*logging.FileOptions, error) {
+	cfgFile, err := config.GetConfigFile()
+	if err != nil {
+		return nil, err
+	}
+
+	return &logging.FileOptions{
+		Path:       cfgFile.LogFilePath(),
+		Level:      cfg.Level,
+		MaxSize:    int64(cfg.MaxSize) * 1024 * 1024,
+		MaxBackups: cfg.MaxBackups,
+	}, nil
+}
+
 func init() {
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
 	rootCmd.SetVersionTemplate(versionTemplate)
 
 	rootCmd.PersistentFlags().BoolVarP(&useDefaultValues, "yes", "y", false, "use the default proposed fields")
+	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "", "level of the logs printed to stderr: debug, info, warn or error (default info, or debug if SHERPA_DEBUG is set)")
+	rootCmd.PersistentFlags().StringVar(&logFormat, "log-format", logging.FormatText, "format of the logs printed to stderr: text or json")
+	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 0, "maximum duration of the command, e.g. 2m (default 0, no limit)")
 
 	rootCmd.AddCommand(create_branch.Command)
 	rootCmd.AddCommand(create_pull_request.Command)
+	rootCmd.AddCommand(status.Command)
+	rootCmd.AddCommand(list.Command)
+	rootCmd.AddCommand(cleanup.Command)
+	rootCmd.AddCommand(switch_branch.Command)
+	rootCmd.AddCommand(issues.Command)
+	rootCmd.AddCommand(stack.Command)
+	rootCmd.AddCommand(pull_request.Command)
+	rootCmd.AddCommand(ready.Command)
+	rootCmd.AddCommand(worktree.Command)
 }
 
 func SetVersion(version string) {
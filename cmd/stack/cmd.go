@@ -0,0 +1,68 @@
+package stack
+
+import (
+	"github.com/InditexTech/gh-sherpa/cmd/common"
+	"github.com/InditexTech/gh-sherpa/internal/config"
+	"github.com/InditexTech/gh-sherpa/internal/gh"
+	"github.com/InditexTech/gh-sherpa/internal/git"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/spf13/cobra"
+)
+
+const cmdName = "stack"
+
+const syncCmdName = "sync"
+
+var Command = &cobra.Command{
+	Use:   cmdName,
+	Short: "Manage stacked pull requests",
+	Long:  "Manage the pull requests stacked on other branches with `gh sherpa create-pr --stack-on <branch>`",
+}
+
+var syncCommand = &cobra.Command{
+	Use:     syncCmdName,
+	Short:   "Rebase the stacked branches after their parent merges",
+	Long:    "Rebase the stacked branches whose parent has been merged or rebased onto their new parent, push them and retarget their pull requests to the new base branch",
+	RunE:    runSyncCommand,
+	Example: "`gh sherpa " + cmdName + " " + syncCmdName + "` or `gh sherpa " + cmdName + " " + syncCmdName + " --no-push`",
+}
+
+type syncFlags struct {
+	NoFetch      bool
+	NoPush       bool
+	OutputFormat string
+}
+
+var flags syncFlags
+
+func init() {
+	syncCommand.PersistentFlags().BoolVar(&flags.NoFetch, "no-fetch", false, "does not fetch the default branch")
+	syncCommand.PersistentFlags().BoolVar(&flags.NoPush, "no-push", false, "does not push the rebased branches")
+	syncCommand.PersistentFlags().StringVar(&flags.OutputFormat, "output", "", "output format: '' (default human-readable) or 'json'")
+
+	Command.AddCommand(syncCommand)
+}
+
+func runSyncCommand(cmd *cobra.Command, _ []string) error {
+	if flags.OutputFormat != "json" {
+		logging.PrintCommandHeader(cmdName + " " + syncCmdName)
+	}
+
+	cfg := config.GetConfig()
+	ghCli := gh.NewClient(cmd.Context(), cfg.Github.Client)
+
+	stackSync := use_cases.StackSync{
+		Cfg: use_cases.StackSyncConfiguration{
+			FetchFromOrigin: !flags.NoFetch,
+			NoPush:          flags.NoPush,
+			OutputFormat:    flags.OutputFormat,
+		},
+		Git:                 git.NewProvider(cfg.Git.Backend),
+		RepositoryProvider:  ghCli,
+		PullRequestProvider: ghCli,
+	}
+
+	_, err := stackSync.Execute(cmd.Context())
+	return common.OutputError(err, flags.OutputFormat)
+}
@@ -0,0 +1,64 @@
+package status
+
+import (
+	"github.com/InditexTech/gh-sherpa/cmd/common"
+	"github.com/InditexTech/gh-sherpa/internal/config"
+	"github.com/InditexTech/gh-sherpa/internal/gh"
+	"github.com/InditexTech/gh-sherpa/internal/git"
+	"github.com/InditexTech/gh-sherpa/internal/issue_trackers"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/spf13/cobra"
+)
+
+const cmdName = "status"
+
+var Command = &cobra.Command{
+	Use:     cmdName,
+	Short:   "Show the issue, branch and pull request you are working on",
+	Long:    "Show the issue linked to the current branch, its pull request state, checks and review decision, the unpushed commits and how far the branch is from its base branch",
+	RunE:    runCommand,
+	Example: "`gh sherpa " + cmdName + "` or `gh sherpa " + cmdName + " --output json`",
+	Aliases: []string{"st"},
+}
+
+type statusFlags struct {
+	BaseBranch   string
+	OutputFormat string
+}
+
+var flags statusFlags
+
+func init() {
+	Command.PersistentFlags().StringVarP(&flags.BaseBranch, "base", "b", "", "base branch to compare with. Use the pull request base or the default branch of the repository if it is not set")
+	Command.PersistentFlags().StringVar(&flags.OutputFormat, "output", "", "output format: '' (default human-readable) or 'json'")
+}
+
+func runCommand(cmd *cobra.Command, _ []string) error {
+	if flags.OutputFormat != "json" {
+		logging.PrintCommandHeader(cmdName)
+	}
+
+	cfg := config.GetConfig()
+
+	issueTrackers, err := issue_trackers.NewFromConfiguration(cfg)
+	if err != nil {
+		return err
+	}
+
+	ghCli := gh.NewClient(cmd.Context(), cfg.Github.Client)
+
+	status := use_cases.Status{
+		Cfg: use_cases.StatusConfiguration{
+			BaseBranch:   flags.BaseBranch,
+			OutputFormat: flags.OutputFormat,
+		},
+		Git:                  git.NewProvider(cfg.Git.Backend),
+		RepositoryProvider:   ghCli,
+		IssueTrackerProvider: issueTrackers,
+		PullRequestProvider:  ghCli,
+	}
+
+	_, err = status.Execute(cmd.Context())
+	return common.OutputError(err, flags.OutputFormat)
+}
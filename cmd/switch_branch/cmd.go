@@ -0,0 +1,123 @@
+package switch_branch
+
+import (
+	"os"
+
+	"github.com/InditexTech/gh-sherpa/cmd/common"
+	"github.com/InditexTech/gh-sherpa/internal/branches"
+	"github.com/InditexTech/gh-sherpa/internal/config"
+	"github.com/InditexTech/gh-sherpa/internal/gh"
+	"github.com/InditexTech/gh-sherpa/internal/git"
+	"github.com/InditexTech/gh-sherpa/internal/interactive"
+	"github.com/InditexTech/gh-sherpa/internal/issue_trackers"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/spf13/cobra"
+)
+
+const cmdName = "switch"
+
+var Command = &cobra.Command{
+	Use:     cmdName,
+	Short:   "Switch to the branch of an issue",
+	Long:    "Switch to the local branch of a GitHub or Jira issue, tracking the remote branch if there is no local one or offering to create it if there is none",
+	PreRunE: preRunCommand,
+	RunE:    runCommand,
+	Example: "`gh sherpa " + cmdName + " --issue 1` for GH or `gh sherpa " + cmdName + " --issue PROJECTKEY-1 --stash` for Jira",
+	Aliases: []string{"sw"},
+}
+
+type switchFlags struct {
+	IssueValue       string
+	BaseValue        string
+	NoFetchValue     bool
+	Stash            bool
+	CarryChanges     bool
+	UseDefaultValues bool
+	OutputFormat     string
+}
+
+var flags switchFlags
+
+func init() {
+	Command.PersistentFlags().StringVarP(&flags.IssueValue, "issue", "i", "", "issue identifier")
+	if err := Command.MarkPersistentFlagRequired("issue"); err != nil {
+		logging.Errorf("error while setting up the command: %s", err)
+		os.Exit(1)
+	}
+
+	Command.PersistentFlags().BoolVar(&flags.Stash, "stash", false, "stash the changes of the current branch and restore the ones previously stashed for the target branch")
+	Command.PersistentFlags().BoolVar(&flags.CarryChanges, "carry-changes", false, "take the uncommitted changes of the current branch to the target branch")
+	Command.MarkFlagsMutuallyExclusive("stash", "carry-changes")
+	Command.PersistentFlags().StringVarP(&flags.BaseValue, "base", "b", "", "base branch for checkout when the branch has to be created. Use the default branch of the repository if it is not set")
+	Command.PersistentFlags().BoolVar(&flags.NoFetchValue, "no-fetch", false, "does not fetch the base branch when the branch has to be created")
+	Command.PersistentFlags().StringVar(&flags.OutputFormat, "output", "", "output format: '' (default human-readable) or 'json'")
+}
+
+func preRunCommand(cmd *cobra.Command, _ []string) error {
+	yesFlag := cmd.Flags().Lookup("yes")
+	if yesFlag != nil {
+		flags.UseDefaultValues = yesFlag.Changed
+	}
+
+	return nil
+}
+
+func runCommand(cmd *cobra.Command, _ []string) error {
+	if flags.OutputFormat != "json" {
+		logging.PrintCommandHeader(cmdName)
+	}
+
+	cfg := config.GetConfig()
+
+	issueTrackers, err := issue_trackers.NewFromConfiguration(cfg)
+	if err != nil {
+		return err
+	}
+
+	userInteraction := &interactive.UserInteractionProvider{}
+
+	isInteractive := !flags.UseDefaultValues
+	// --output json implies non-interactive to prevent stdin prompts from corrupting JSON output.
+	if flags.OutputFormat == "json" {
+		isInteractive = false
+	}
+
+	branchProvider, err := branches.New(branches.Configuration{
+		Branches:      cfg.Branches,
+		IsInteractive: isInteractive,
+	}, userInteraction)
+	if err != nil {
+		return err
+	}
+
+	gitProvider := git.NewProvider(cfg.Git.Backend)
+
+	switchBranch := use_cases.Switch{
+		Cfg: use_cases.SwitchConfiguration{
+			IssueID:       flags.IssueValue,
+			Stash:         flags.Stash,
+			CarryChanges:  flags.CarryChanges,
+			IsInteractive: isInteractive,
+			OutputFormat:  flags.OutputFormat,
+		},
+		Git:                     gitProvider,
+		IssueTrackerProvider:    issueTrackers,
+		UserInteractionProvider: userInteraction,
+		CreateBranch: use_cases.CreateBranch{
+			Cfg: use_cases.CreateBranchConfiguration{
+				BaseBranch:      flags.BaseValue,
+				FetchFromOrigin: !flags.NoFetchValue,
+				OnCollision:     common.GetCollisionStrategy(cfg, ""),
+			},
+			Git:                     gitProvider,
+			RepositoryProvider:      gh.NewClient(cmd.Context(), cfg.Github.Client),
+			IssueTrackerProvider:    issueTrackers,
+			UserInteractionProvider: userInteraction,
+			BranchProvider:          branchProvider,
+		},
+	}
+
+	_, err = switchBranch.Execute(cmd.Context())
+	return common.OutputError(err, flags.OutputFormat)
+}
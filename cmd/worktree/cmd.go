@@ -0,0 +1,122 @@
+package worktree
+
+import (
+	"github.com/InditexTech/gh-sherpa/cmd/common"
+	"github.com/InditexTech/gh-sherpa/internal/config"
+	"github.com/InditexTech/gh-sherpa/internal/git"
+	"github.com/InditexTech/gh-sherpa/internal/interactive"
+	"github.com/InditexTech/gh-sherpa/internal/issue_trackers"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/spf13/cobra"
+)
+
+const cmdName = "worktree"
+
+const (
+	listCmdName   = "list"
+	removeCmdName = "remove"
+)
+
+var Command = &cobra.Command{
+	Use:     cmdName,
+	Short:   "Manage the worktrees of the issue branches",
+	Long:    "Manage the git worktrees created with `gh sherpa create-branch --worktree` to work on several issues at the same time",
+	Aliases: []string{"wt"},
+}
+
+var listCommand = &cobra.Command{
+	Use:     listCmdName,
+	Short:   "List the worktrees and the issue of their branch",
+	Long:    "List the worktrees of the repository with their branch and the issue it belongs to",
+	Args:    cobra.NoArgs,
+	RunE:    runListCommand,
+	Example: "`gh sherpa " + cmdName + " " + listCmdName + "` or `gh sherpa " + cmdName + " " + listCmdName + " --output json`",
+	Aliases: []string{"ls"},
+}
+
+var removeCommand = &cobra.Command{
+	Use:     removeCmdName + " <issue|path>",
+	Short:   "Remove the worktree of an issue",
+	Long:    "Remove the worktree of an issue, given its identifier or the path of the worktree. The branch is kept",
+	Args:    cobra.ExactArgs(1),
+	RunE:    runRemoveCommand,
+	Example: "`gh sherpa " + cmdName + " " + removeCmdName + " 1` for GH or `gh sherpa " + cmdName + " " + removeCmdName + " PROJECTKEY-1 --force` for Jira",
+	Aliases: []string{"rm"},
+}
+
+type listFlags struct {
+	OutputFormat string
+}
+
+type removeFlags struct {
+	Force            bool
+	UseDefaultValues bool
+	OutputFormat     string
+}
+
+var (
+	listOpts   listFlags
+	removeOpts removeFlags
+)
+
+func init() {
+	listCommand.PersistentFlags().StringVar(&listOpts.OutputFormat, "output", "", "output format: '' (default human-readable) or 'json'")
+
+	removeCommand.PersistentFlags().BoolVar(&removeOpts.Force, "force", false, "remove the worktree even if it has uncommitted changes")
+	removeCommand.PersistentFlags().StringVar(&removeOpts.OutputFormat, "output", "", "output format: '' (default human-readable) or 'json'")
+
+	Command.AddCommand(listCommand)
+	Command.AddCommand(removeCommand)
+}
+
+func runListCommand(cmd *cobra.Command, _ []string) error {
+	if listOpts.OutputFormat != "json" {
+		logging.PrintCommandHeader(cmdName + " " + listCmdName)
+	}
+
+	cfg := config.GetConfig()
+
+	worktreeList := use_cases.WorktreeList{
+		Cfg: use_cases.WorktreeListConfiguration{
+			OutputFormat: listOpts.OutputFormat,
+		},
+		Git: git.NewProvider(cfg.Git.Backend),
+	}
+
+	_, err := worktreeList.Execute(cmd.Context())
+	return common.OutputError(err, listOpts.OutputFormat)
+}
+
+func runRemoveCommand(cmd *cobra.Command, args []string) error {
+	if removeOpts.OutputFormat != "json" {
+		logging.PrintCommandHeader(cmdName + " " + removeCmdName)
+	}
+
+	yesFlag := cmd.Flags().Lookup("yes")
+	if yesFlag != nil {
+		removeOpts.UseDefaultValues = yesFlag.Changed
+	}
+
+	cfg := config.GetConfig()
+
+	issueTrackers, err := issue_trackers.NewFromConfiguration(cfg)
+	if err != nil {
+		return err
+	}
+
+	worktreeRemove := use_cases.WorktreeRemove{
+		Cfg: use_cases.WorktreeRemoveConfiguration{
+			Target:        args[0],
+			Force:         removeOpts.Force,
+			IsInteractive: !removeOpts.UseDefaultValues && removeOpts.OutputFormat != "json",
+			OutputFormat:  removeOpts.OutputFormat,
+		},
+		Git:                     git.NewProvider(cfg.Git.Backend),
+		IssueTrackerProvider:    issueTrackers,
+		UserInteractionProvider: &interactive.UserInteractionProvider{},
+	}
+
+	_, err = worktreeRemove.Execute(cmd.Context())
+	return common.OutputError(err, removeOpts.OutputFormat)
+}
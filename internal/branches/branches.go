@@ -1,6 +1,8 @@
 package branches
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
 	"regexp"
 	"strings"
@@ -101,6 +103,8 @@ func normalizeBranch(branchSlug string) string {
 // formatBranchName formats a branch name based on the branch type and the issue identifier.
 // It overrides the branch prefix if the issue type is present in the branchPrefixOverride map.
 // If the prefix is empty, it uses the branch type as the prefix.
+// When the name exceeds the configured max length only the issue context is truncated,
+// so the branch type and the issue identifier are always kept intact.
 func (b BranchProvider) formatBranchName(repoNameWithOwner string, branchType string, issueId string, issueContext string) (branchName string) {
 	branchPrefix := branchType
 
@@ -114,25 +118,86 @@ func (b BranchProvider) formatBranchName(repoNameWithOwner string, branchType st
 	branchName = fmt.Sprintf("%s/%s", branchPrefix, issueId)
 
 	if issueContext != "" {
-		branchName = fmt.Sprintf("%s-%s", branchName, issueContext)
-	}
+		maxBranchNameLength := b.cfg.MaxLength - len([]rune(repoNameWithOwner))
+		if maxBranchNameLength > 0 {
+			// The context is preceded by a dash, so it is not counted as available space
+			issueContext = truncateIssueContext(issueContext, maxBranchNameLength-len([]rune(branchName))-1, b.cfg.HashSuffix)
+		}
 
-	maxBranchNameLength := b.cfg.MaxLength - len([]rune(repoNameWithOwner))
-	if maxBranchNameLength > 0 {
-		branchNameLength := len([]rune(branchName))
-		branchName = branchName[:min(maxBranchNameLength, branchNameLength)]
+		if issueContext != "" {
+			branchName = fmt.Sprintf("%s-%s", branchName, issueContext)
+		}
 	}
 
 	// Remove all trailing dashes to ensure branches never end with a dash
-	trailingDashPattern := regexp.MustCompile(`-+$`)
 	return trailingDashPattern.ReplaceAllString(branchName, "")
 }
 
-// min returns the smallest of x or y.
-func min(x, y int) int {
-	if x > y {
-		return y
+var trailingDashPattern = regexp.MustCompile(`-+$`)
+
+// hashSuffixLength is the number of hexadecimal characters of the hash appended
+// to truncated issue contexts.
+const hashSuffixLength = 6
+
+// truncateIssueContext crops the issue context so it fits in maxLength runes.
+// It cuts at the last word boundary (dash or underscore) that fits and falls back to
+// a rune-safe cut when no boundary is available. When withHash is enabled, a short
+// hash of the original context is appended so truncated names remain unique.
+func truncateIssueContext(issueContext string, maxLength int, withHash bool) string {
+	contextRunes := []rune(issueContext)
+	if len(contextRunes) <= maxLength {
+		return issueContext
+	}
+
+	if withHash {
+		hashSuffix := shortHash(issueContext)
+		// Not enough room for the hash and at least one character of context
+		if maxLength < len(hashSuffix)+2 {
+			return truncateAtWordBoundary(contextRunes, maxLength)
+		}
+
+		truncated := truncateAtWordBoundary(contextRunes, maxLength-len(hashSuffix)-1)
+		if truncated == "" {
+			return hashSuffix
+		}
+
+		return fmt.Sprintf("%s-%s", truncated, hashSuffix)
+	}
+
+	return truncateAtWordBoundary(contextRunes, maxLength)
+}
+
+// truncateAtWordBoundary returns at most maxLength runes of the given context,
+// cutting at the last word separator when possible.
+func truncateAtWordBoundary(contextRunes []rune, maxLength int) string {
+	if maxLength <= 0 {
+		return ""
+	}
+
+	if len(contextRunes) <= maxLength {
+		return string(contextRunes)
 	}
 
-	return x
+	// The rune right after the cut being a separator means the cut is already at a word boundary
+	if isWordSeparator(contextRunes[maxLength]) {
+		return strings.TrimRight(string(contextRunes[:maxLength]), "-_")
+	}
+
+	for i := maxLength - 1; i > 0; i-- {
+		if isWordSeparator(contextRunes[i]) {
+			return strings.TrimRight(string(contextRunes[:i]), "-_")
+		}
+	}
+
+	return string(contextRunes[:maxLength])
+}
+
+func isWordSeparator(r rune) bool {
+	return r == '-' || r == '_'
+}
+
+// shortHash returns a short and stable hexadecimal hash of the given value.
+func shortHash(value string) string {
+	sum := sha1.Sum([]byte(value))
+	return hex.EncodeToString(sum[:])[:hashSuffixLength]
 }
@@ -64,7 +64,7 @@ func (s *BranchTestSuite) TestGetBranchName() {
 	})
 
 	s.Run("should return cropped branch", func() {
-		expectedBrachName := "bugfix/GH-1-my-title-is-too-long-and-it-sho"
+		expectedBrachName := "bugfix/GH-1-my-title-is-too-long-and-it"
 
 		s.fakeIssue.SetTitle("my title is too long and it should not matter")
 
@@ -364,6 +364,7 @@ func TestFormatBranchName(t *testing.T) {
 		issueContext         string
 		branchPrefixOverride map[issue_types.IssueType]string
 		maxLength            int
+		hashSuffix           bool
 	}
 	tests := []struct {
 		name string
@@ -403,7 +404,7 @@ func TestFormatBranchName(t *testing.T) {
 				issueContext: "my-title-is-too-long-and-it-should-be-truncated",
 				maxLength:    63,
 			},
-			want: "feature/GH-1-my-title-is-too-long-and-it-s",
+			want: "feature/GH-1-my-title-is-too-long-and-it",
 		},
 		{
 			name: "Does format long branch name with override",
@@ -415,7 +416,7 @@ func TestFormatBranchName(t *testing.T) {
 				branchPrefixOverride: map[issue_types.IssueType]string{issue_types.Feature: "feat"},
 				maxLength:            63,
 			},
-			want: "feat/GH-1-my-title-is-too-long-and-it-shou",
+			want: "feat/GH-1-my-title-is-too-long-and-it",
 		},
 		{
 			name: "Does format branch with empty override",
@@ -484,6 +485,52 @@ func TestFormatBranchName(t *testing.T) {
 			},
 			want: "feature/JIRA-123-back",
 		},
+		{
+			name: "Does not cut multibyte characters",
+			args: args{
+				repository:   repositoryName,
+				branchType:   "feature",
+				issueId:      "GH-1",
+				issueContext: "ñññññññññññññññññññññññññññññññññññññ",
+				maxLength:    63,
+			},
+			want: "feature/GH-1-ñññññññññññññññññññññññññññññ",
+		},
+		{
+			name: "Does not cut into the issue identifier",
+			args: args{
+				repository:   repositoryName,
+				branchType:   "feature",
+				issueId:      "PROJECTKEY-12345",
+				issueContext: "my-title",
+				maxLength:    40,
+			},
+			want: "feature/PROJECTKEY-12345",
+		},
+		{
+			name: "Does append a stable hash when truncating",
+			args: args{
+				repository:   repositoryName,
+				branchType:   "feature",
+				issueId:      "GH-1",
+				issueContext: "my-title-is-too-long-and-it-should-be-truncated",
+				maxLength:    63,
+				hashSuffix:   true,
+			},
+			want: "feature/GH-1-my-title-is-too-long-" + shortHash("my-title-is-too-long-and-it-should-be-truncated"),
+		},
+		{
+			name: "Does not append a hash when not truncating",
+			args: args{
+				repository:   repositoryName,
+				branchType:   "feature",
+				issueId:      "GH-1",
+				issueContext: "my-title",
+				maxLength:    63,
+				hashSuffix:   true,
+			},
+			want: "feature/GH-1-my-title",
+		},
 	}
 
 	for _, tt := range tests {
@@ -492,8 +539,9 @@ func TestFormatBranchName(t *testing.T) {
 			b := BranchProvider{
 				cfg: Configuration{
 					Branches: config.Branches{
-						Prefixes:  tt.args.branchPrefixOverride,
-						MaxLength: tt.args.maxLength,
+						Prefixes:   tt.args.branchPrefixOverride,
+						MaxLength:  tt.args.maxLength,
+						HashSuffix: tt.args.hashSuffix,
 					},
 				},
 			}
@@ -504,6 +552,24 @@ func TestFormatBranchName(t *testing.T) {
 	}
 }
 
+func TestTruncateIssueContext(t *testing.T) {
+	t.Run("Cuts at the last word boundary", func(t *testing.T) {
+		assert.Equal(t, "first-second", truncateIssueContext("first-second-third", 15, false))
+	})
+
+	t.Run("Cuts runes when there is no word boundary", func(t *testing.T) {
+		assert.Equal(t, "abcde", truncateIssueContext("abcdefghij", 5, false))
+	})
+
+	t.Run("Keeps the hash stable for the same context", func(t *testing.T) {
+		first := truncateIssueContext("first-second-third", 15, true)
+		second := truncateIssueContext("first-second-third", 15, true)
+
+		assert.Equal(t, first, second)
+		assert.Equal(t, "first-"+shortHash("first-second-third"), first)
+	})
+}
+
 func TestParseBranchName(t *testing.T) {
 	for _, tc := range []struct {
 		name       string
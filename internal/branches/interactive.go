@@ -1,7 +1,6 @@
 package branches
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/InditexTech/gh-sherpa/internal/domain"
@@ -11,7 +10,7 @@ import (
 )
 
 // ErrUndeterminedIssueType is returned when the issue type can't be determined
-var ErrUndeterminedIssueType = errors.New("undetermined issue type")
+var ErrUndeterminedIssueType = domain.ErrUndeterminedType
 
 // GetBranchName asks the user for a branch name in an interactive way
 func (b BranchProvider) GetBranchName(issue domain.Issue, repo domain.Repository) (branchName string, err error) {
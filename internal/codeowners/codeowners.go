@@ -0,0 +1,121 @@
+package codeowners
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Locations are the paths, relative to the repository root, where GitHub looks for the CODEOWNERS file
+var Locations = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+type rule struct {
+	pattern *regexp.Regexp
+	owners  []string
+}
+
+// CodeOwners holds the rules of a CODEOWNERS file
+type CodeOwners struct {
+	rules []rule
+}
+
+// Find returns the path of the CODEOWNERS file of the repository with the given root
+func Find(root string) (path string, found bool) {
+	for _, location := range Locations {
+		path = filepath.Join(root, location)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path, true
+		}
+	}
+
+	return "", false
+}
+
+// Parse reads the rules of a CODEOWNERS file
+func Parse(r io.Reader) (CodeOwners, error) {
+	codeOwners := CodeOwners{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if comment := strings.Index(line, "#"); comment >= 0 {
+			line = line[:comment]
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		codeOwners.rules = append(codeOwners.rules, rule{
+			pattern: compilePattern(fields[0]),
+			owners:  fields[1:],
+		})
+	}
+
+	return codeOwners, scanner.Err()
+}
+
+// Owners returns the owners of the given file, which are the ones of the last rule matching it
+func (c CodeOwners) Owners(file string) []string {
+	file = strings.TrimPrefix(filepath.ToSlash(file), "/")
+
+	for i := len(c.rules) - 1; i >= 0; i-- {
+		if c.rules[i].pattern.MatchString(file) {
+			return c.rules[i].owners
+		}
+	}
+
+	return nil
+}
+
+// compilePattern converts a CODEOWNERS pattern, which follows most of the gitignore rules, to a regular expression
+func compilePattern(pattern string) *regexp.Regexp {
+	directory := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+
+	// Patterns with a slash at the beginning or in the middle are relative to the repository root
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	var expr strings.Builder
+	if anchored {
+		expr.WriteString("^")
+	} else {
+		expr.WriteString("(^|/)")
+	}
+
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			expr.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			expr.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			expr.WriteString("[^/]*")
+		case pattern[i] == '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(pattern[i])))
+		}
+	}
+
+	lastSegment := pattern[strings.LastIndex(pattern, "/")+1:]
+	switch {
+	case directory:
+		expr.WriteString("/.*$")
+	case strings.ContainsAny(lastSegment, "*?"):
+		// A wildcard in the last segment only matches files, e.g. `docs/*` does not match `docs/a/b.md`
+		expr.WriteString("$")
+	default:
+		// The pattern can match a file or a directory and everything inside it
+		expr.WriteString("(/.*)?$")
+	}
+
+	return regexp.MustCompile(expr.String())
+}
@@ -0,0 +1,77 @@
+package codeowners
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleCodeOwners = `# Default owners
+*       @global-owner
+
+*.js    @js-owner # JavaScript files
+/build/logs/ @doctocat
+docs/*  docs@example.com
+apps/   @octocat
+/scripts/ @doctocat @octocat
+**/logs @octo-org/logs
+/internal/config
+`
+
+func TestOwners(t *testing.T) {
+	codeOwners, err := Parse(strings.NewReader(sampleCodeOwners))
+	require.NoError(t, err)
+
+	tests := []struct {
+		file string
+		want []string
+	}{
+		{file: "README.md", want: []string{"@global-owner"}},
+		{file: "web/app.js", want: []string{"@js-owner"}},
+		{file: "build/logs/out.txt", want: []string{"@octo-org/logs"}},
+		{file: "build/logs.txt", want: []string{"@global-owner"}},
+		{file: "docs/getting-started.md", want: []string{"docs@example.com"}},
+		{file: "docs/build-app/troubleshooting.md", want: []string{"@global-owner"}},
+		{file: "apps/api/main.go", want: []string{"@octocat"}},
+		{file: "services/apps/main.go", want: []string{"@octocat"}},
+		{file: "scripts/release.sh", want: []string{"@doctocat", "@octocat"}},
+		{file: "deep/nested/logs/app.log", want: []string{"@octo-org/logs"}},
+		{file: "internal/config/config.go", want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			assert.Equal(t, tt.want, codeOwners.Owners(tt.file))
+		})
+	}
+}
+
+func TestOwnersWithoutMatchingRule(t *testing.T) {
+	codeOwners, err := Parse(strings.NewReader("/docs/ @doctocat\n"))
+	require.NoError(t, err)
+
+	assert.Nil(t, codeOwners.Owners("main.go"))
+}
+
+func TestFind(t *testing.T) {
+	t.Run("should find the file in the .github directory", func(t *testing.T) {
+		root := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(root, ".github"), os.ModePerm))
+		require.NoError(t, os.WriteFile(filepath.Join(root, ".github", "CODEOWNERS"), []byte("* @octocat\n"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(root, "CODEOWNERS"), []byte("* @doctocat\n"), 0o644))
+
+		path, found := Find(root)
+
+		assert.True(t, found)
+		assert.Equal(t, filepath.Join(root, ".github", "CODEOWNERS"), path)
+	})
+
+	t.Run("should not find the file if the repository has none", func(t *testing.T) {
+		_, found := Find(t.TempDir())
+
+		assert.False(t, found)
+	})
+}
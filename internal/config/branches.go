@@ -3,8 +3,10 @@ package config
 import "github.com/InditexTech/gh-sherpa/internal/domain/issue_types"
 
 type Branches struct {
-	Prefixes  BranchesPrefixes `validate:"validIssueTypeKeys"`
-	MaxLength int              `mapstructure:"max_length" validate:"gte=0"`
+	Prefixes          BranchesPrefixes `validate:"validIssueTypeKeys"`
+	MaxLength         int              `mapstructure:"max_length" validate:"gte=0"`
+	HashSuffix        bool             `mapstructure:"hash_suffix"`
+	CollisionStrategy string           `mapstructure:"collision_strategy" validate:"omitempty,oneof=fail reuse suffix ask"`
 }
 
 type BranchesPrefixes map[issue_types.IssueType]string
@@ -19,6 +19,8 @@ const (
 	configPath = ".config/sherpa"
 	configName = "config"
 	configType = "yml"
+
+	logFileName = "sherpa.log"
 )
 
 //go:embed default-config.yml
@@ -30,9 +32,13 @@ var (
 )
 
 type Configuration struct {
-	Jira     Jira
-	Github   Github `validate:"required"`
-	Branches Branches
+	Jira         Jira
+	Github       Github `validate:"required"`
+	Branches     Branches
+	PullRequests PullRequests `mapstructure:"pull_requests"`
+	Remotes      Remotes
+	Git          Git
+	Log          Log
 }
 
 // Validates the configuration
@@ -63,6 +69,11 @@ func (cf ConfigFile) getFilePath() string {
 	return filepath.Join(cf.Path, fmt.Sprintf("%s.%s", cf.Name, cf.Type))
 }
 
+// LogFilePath returns the path of the log file, in the same directory as the configuration file
+func (cf ConfigFile) LogFilePath() string {
+	return filepath.Join(cf.Path, logFileName)
+}
+
 var GetConfigFile = func() (cfgFile ConfigFile, err error) {
 	var homeDir string
 	homeDir, err = os.UserHomeDir()
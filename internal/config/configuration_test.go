@@ -59,7 +59,16 @@ func (s *ConfigurationTestSuite) TestGetConfiguration() {
 			return
 		}
 
-		if err = mapstructure.Decode(loadedConfigMap, &loadedConfig); err != nil {
+		// The durations are decoded from strings as viper does
+		decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+			DecodeHook: mapstructure.StringToTimeDurationHookFunc(),
+			Result:     &loadedConfig,
+		})
+		if err != nil {
+			return
+		}
+
+		if err = decoder.Decode(loadedConfigMap); err != nil {
 			return
 		}
 
@@ -279,6 +288,15 @@ func (s *ValidateConfigTestSuite) TestConfigurationValidations() {
 		s.Error(err)
 	})
 
+	s.Run("Should return error if github client is not valid", func() {
+		tCfg := s.getValidConfig()
+		tCfg.Github.Client = "rest"
+
+		err := tCfg.Validate()
+
+		s.Error(err)
+	})
+
 	s.Run("Should return error if branches prefixes keys are not valid", func() {
 		tCfg := s.getValidConfig()
 		tCfg.Branches.Prefixes = BranchesPrefixes{
@@ -291,6 +309,15 @@ func (s *ValidateConfigTestSuite) TestConfigurationValidations() {
 		s.Error(err)
 	})
 
+	s.Run("Should return error if branches collision strategy is not valid", func() {
+		tCfg := s.getValidConfig()
+		tCfg.Branches.CollisionStrategy = "overwrite"
+
+		err := tCfg.Validate()
+
+		s.Error(err)
+	})
+
 	s.Run("Should return error if branches max length is negative", func() {
 		tCfg := s.getValidConfig()
 		tCfg.Branches.MaxLength = -1
@@ -0,0 +1,6 @@
+package config
+
+type Git struct {
+	Backend      string `mapstructure:"backend" validate:"omitempty,oneof=cli go-git"`
+	WorktreePath string `mapstructure:"worktree_path"`
+}
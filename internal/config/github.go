@@ -1,10 +1,19 @@
 package config
 
-import "github.com/InditexTech/gh-sherpa/internal/domain/issue_types"
+import (
+	"time"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain/issue_types"
+)
 
 type Github struct {
 	IssueLabels      GithubIssueLabels `mapstructure:"issue_labels" validate:"required,validIssueTypeKeys,uniqueMapValues"`
 	ForkOrganization string            `mapstructure:"fork_organization"`
+	Queries          map[string]string `mapstructure:"queries" validate:"dive,required"`
+	Client           string            `mapstructure:"client" validate:"omitempty,oneof=cli api"`
+	Host             string            `mapstructure:"host" validate:"omitempty,hostname"`
+	GitProtocol      string            `mapstructure:"git_protocol" validate:"omitempty,oneof=https ssh"`
+	Timeout          time.Duration     `mapstructure:"timeout"`
 }
 
 type GithubIssueLabels map[issue_types.IssueType][]string
@@ -4,6 +4,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/InditexTech/gh-sherpa/internal/domain/issue_types"
 	"github.com/InditexTech/gh-sherpa/internal/interactive"
@@ -12,8 +13,16 @@ import (
 
 // Jira configuration
 type Jira struct {
-	Auth       JiraAuth
-	IssueTypes JiraIssueTypes `mapstructure:"issue_types" validate:"required,validIssueTypeKeys,uniqueMapValues"`
+	Auth        JiraAuth
+	IssueTypes  JiraIssueTypes    `mapstructure:"issue_types" validate:"required,validIssueTypeKeys,uniqueMapValues"`
+	Queries     map[string]string `mapstructure:"queries" validate:"dive,required"`
+	Transitions JiraTransitions   `mapstructure:"transitions"`
+	Timeout     time.Duration     `mapstructure:"timeout"`
+}
+
+// JiraTransitions Jira workflow transitions applied by the commands
+type JiraTransitions struct {
+	ReadyForReview string `mapstructure:"ready_for_review"`
 }
 
 // JiraAuth Jira authentication configuration
@@ -0,0 +1,14 @@
+package config
+
+// Log configures the log file of sherpa
+type Log struct {
+	File LogFile `mapstructure:"file"`
+}
+
+// LogFile configures the log file written in the configuration directory
+type LogFile struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	Level      string `mapstructure:"level" validate:"omitempty,oneof=debug info warn error"`
+	MaxSize    int    `mapstructure:"max_size" validate:"gte=0"`    // megabytes before rotating the file, 0 to never rotate it
+	MaxBackups int    `mapstructure:"max_backups" validate:"gte=0"` // rotated files that are kept
+}
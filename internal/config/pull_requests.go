@@ -0,0 +1,13 @@
+package config
+
+type PullRequests struct {
+	Reviewers         []string      `mapstructure:"reviewers" validate:"dive,required"`
+	RequestCodeOwners bool          `mapstructure:"request_codeowners"`
+	InitialCommit     InitialCommit `mapstructure:"initial_commit"`
+}
+
+type InitialCommit struct {
+	Message string `mapstructure:"message"`
+	Skip    bool   `mapstructure:"skip"`
+	Signoff bool   `mapstructure:"signoff"`
+}
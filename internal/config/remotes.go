@@ -0,0 +1,6 @@
+package config
+
+type Remotes struct {
+	Push string `mapstructure:"push"`
+	Base string `mapstructure:"base"`
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "time"
+
+// Branch is a git branch that exists locally, in the remote or in both
+type Branch struct {
+	Name           string
+	Local          bool
+	Remote         bool
+	LastCommitDate time.Time
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+// ErrorCode is the stable identifier of a kind of error, reported in the JSON errors of the
+// commands and mapped to their exit codes. The codes must not change between versions.
+type ErrorCode string
+
+const (
+	ErrorCodeUnknown           ErrorCode = "unknown"
+	ErrorCodeIssueNotFound     ErrorCode = "issue-not-found"
+	ErrorCodeBranchExists      ErrorCode = "branch-exists"
+	ErrorCodePullRequestExists ErrorCode = "pr-exists"
+	ErrorCodeAuthFailed        ErrorCode = "auth-failed"
+	ErrorCodeUndeterminedType  ErrorCode = "undetermined-type"
+	ErrorCodeNetwork           ErrorCode = "network"
+	ErrorCodeRateLimited       ErrorCode = "rate-limited"
+	ErrorCodeDirtyWorkingTree  ErrorCode = "dirty-working-tree"
+	ErrorCodeCancelled         ErrorCode = "cancelled"
+	ErrorCodeTimeout           ErrorCode = "timeout"
+)
+
+// Error is an error of a known kind, identified by its code. Any error with the same code matches
+// it with errors.Is, so the Err* errors of this file can be used to check the kind of an error.
+type Error struct {
+	Code ErrorCode
+	Err  error
+}
+
+// NewError returns the error with the given code. The message of the error is kept.
+func NewError(code ErrorCode, err error) error {
+	return &Error{Code: code, Err: err}
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+var (
+	// ErrIssueNotFound is returned when the issue does not exist in the issue tracker
+	ErrIssueNotFound = NewError(ErrorCodeIssueNotFound, errors.New("the issue was not found"))
+	// ErrBranchExists matches the errors returned when the branch to create already exists
+	ErrBranchExists = NewError(ErrorCodeBranchExists, errors.New("the branch already exists"))
+	// ErrPullRequestExists matches the errors returned when the branch already has an open pull request
+	ErrPullRequestExists = NewError(ErrorCodePullRequestExists, errors.New("the pull request already exists"))
+	// ErrAuthFailed matches the errors returned when the credentials are not valid or have not enough permissions
+	ErrAuthFailed = NewError(ErrorCodeAuthFailed, errors.New("authentication failed"))
+	// ErrUndeterminedType matches the errors returned when the type of the issue cannot be determined
+	ErrUndeterminedType = NewError(ErrorCodeUndeterminedType, errors.New("undetermined issue type"))
+	// ErrNetwork matches the errors returned when GitHub or Jira cannot be reached
+	ErrNetwork = NewError(ErrorCodeNetwork, errors.New("network error"))
+	// ErrRateLimited matches the errors returned when the API rate limit has been exceeded
+	ErrRateLimited = NewError(ErrorCodeRateLimited, errors.New("rate limit exceeded"))
+)
+
+// ErrorCodeOf returns the code of the error. The errors of a cancelled context without a code are
+// reported as ErrorCodeCancelled, the ones of a context whose deadline was exceeded as
+// ErrorCodeTimeout, network errors as ErrorCodeNetwork and the rest of them as ErrorCodeUnknown.
+func ErrorCodeOf(err error) ErrorCode {
+	var codedErr *Error
+	if errors.As(err, &codedErr) {
+		return codedErr.Code
+	}
+
+	// The HTTP requests fail with network errors wrapping the error of their context
+	switch {
+	case errors.Is(err, context.Canceled):
+		return ErrorCodeCancelled
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorCodeTimeout
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return ErrorCodeNetwork
+	}
+
+	return ErrorCodeUnknown
+}
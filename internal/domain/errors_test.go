@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorCodeOf(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want ErrorCode
+	}{
+		{name: "coded error", err: ErrIssueNotFound, want: ErrorCodeIssueNotFound},
+		{name: "wrapped coded error", err: fmt.Errorf("error getting the issue: %w", ErrIssueNotFound), want: ErrorCodeIssueNotFound},
+		{name: "network error", err: fmt.Errorf("error getting the issue: %w", &net.OpError{Op: "dial", Err: errors.New("connection refused")}), want: ErrorCodeNetwork},
+		{name: "cancelled context", err: fmt.Errorf("git fetch: %w", context.Canceled), want: ErrorCodeCancelled},
+		{name: "exceeded deadline", err: fmt.Errorf("git fetch: %w", context.DeadlineExceeded), want: ErrorCodeTimeout},
+		{name: "request with an exceeded deadline", err: &url.Error{Op: "Get", URL: "https://jira.example.com", Err: context.DeadlineExceeded}, want: ErrorCodeTimeout},
+		{name: "other errors", err: errors.New("unexpected error"), want: ErrorCodeUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ErrorCodeOf(tt.err))
+		})
+	}
+}
+
+func TestError_Is(t *testing.T) {
+	err := NewError(ErrorCodeBranchExists, errors.New("the branch feature/GH-1 already exists"))
+
+	assert.ErrorIs(t, err, ErrBranchExists)
+	assert.NotErrorIs(t, err, ErrPullRequestExists)
+	assert.EqualError(t, err, "the branch feature/GH-1 already exists")
+}
@@ -1,5 +1,7 @@
 package domain
 
+import "context"
+
 type ForkConfiguration struct {
 	DefaultOrganization string
 	IsInteractive       bool
@@ -21,10 +23,12 @@ type ForkStatus struct {
 }
 
 type ForkProvider interface {
-	IsRepositoryFork() (bool, error)
-	CreateFork(forkName string) error
-	ForkExists(forkName string) (bool, error)
-	SetDefaultRepository(repo string) error
-	GetRemoteConfiguration() (map[string]string, error)
-	ConfigureRemotesForExistingFork(forkName string) error
+	IsRepositoryFork(ctx context.Context) (bool, error)
+	CreateFork(ctx context.Context, forkName string) error
+	ForkExists(ctx context.Context, forkName string) (bool, error)
+	SetDefaultRepository(ctx context.Context, repo string) error
+	// GetRemoteConfiguration returns the URL of the push remote as "origin" and, in a fork setup,
+	// the URL of the base remote as "upstream", whatever their names are
+	GetRemoteConfiguration(ctx context.Context) (map[string]string, error)
+	ConfigureRemotesForExistingFork(ctx context.Context, forkName string) error
 }
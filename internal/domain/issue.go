@@ -12,4 +12,6 @@ type Issue interface {
 	TrackerType() IssueTrackerType
 	Type() issue_types.IssueType
 	HasLabel(labelName string) bool
+	State() string
+	IsClosed() bool
 }
@@ -1,5 +1,7 @@
 package domain
 
+import "context"
+
 type IssueTrackerType string
 
 func (i IssueTrackerType) String() string {
@@ -12,6 +14,12 @@ const (
 )
 
 type IssueTrackerProvider interface {
-	GetIssue(identifier string) (issue Issue, err error)
+	GetIssue(ctx context.Context, identifier string) (issue Issue, err error)
 	ParseIssueId(identifier string) (issueId string)
+	// GetAssignedIssues returns the open issues assigned to the current user
+	GetAssignedIssues(ctx context.Context) (issues []Issue, err error)
+	// SearchIssues returns the issues matching the saved query with the given name
+	SearchIssues(ctx context.Context, queryName string) (issues []Issue, err error)
+	// TransitionIssue moves the issue through the workflow transition with the given name
+	TransitionIssue(ctx context.Context, identifier string, transition string) (err error)
 }
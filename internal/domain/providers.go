@@ -1,32 +1,58 @@
 package domain
 
+import "context"
+
 type RepositoryProvider interface {
-	GetRepository() (repo *Repository, err error)
+	GetRepository(ctx context.Context) (repo *Repository, err error)
 }
 
 type PullRequestProvider interface {
-	GetPullRequestForBranch(branch string) (pullRequest *PullRequest, err error)
-	CreatePullRequest(title string, body string, baseBranch string, headBranch string, draft bool, labels []string, reviewers []string, assignees []string) (prUrl string, err error)
+	GetPullRequestForBranch(ctx context.Context, branch string) (pullRequest *PullRequest, err error)
+	CreatePullRequest(ctx context.Context, title string, body string, baseBranch string, headBranch string, draft bool, labels []string, reviewers []string, assignees []string) (prUrl string, err error)
+	UpdatePullRequestBase(ctx context.Context, headBranch string, baseBranch string) (err error)
+	EditPullRequest(ctx context.Context, headBranch string, edit PullRequestEdit) (err error)
+	GetRequiredChecksStatus(ctx context.Context, headBranch string) (status ChecksStatus, err error)
+	MarkPullRequestReady(ctx context.Context, headBranch string) (err error)
 }
 
 type UserInteractionProvider interface {
 	AskUserForConfirmation(msg string, defaultAnswer bool) (answer bool, err error)
 	SelectOrInputPrompt(message string, validValues []string, variable *string, required bool) error
 	SelectOrInput(name string, validValues []string, variable *string, required bool) error
+	SelectWithFilter(message string, options []string, selectedIndex *int) error
 }
 
 type GitProvider interface {
-	BranchExists(branch string) bool
-	FetchBranchFromOrigin(branch string) (err error)
-	CheckoutNewBranchFromOrigin(branch string, base string) (err error)
-	GetCurrentBranch() (branchName string, err error)
-	FindBranch(substring string) (branch string, exists bool)
-	CheckoutBranch(branch string) (err error)
-	GetCommitsToPush(branch string) (commits []string, err error)
-	RemoteBranchExists(branch string) (exists bool)
-	CommitEmpty(message string) (err error)
-	PushBranch(branch string) (err error)
-	GetRepositoryRoot() (rootPath string, err error)
+	BranchExists(ctx context.Context, branch string) bool
+	FetchBranchFromOrigin(ctx context.Context, branch string) (err error)
+	CheckoutNewBranchFromOrigin(ctx context.Context, branch string, base string) (err error)
+	GetCurrentBranch(ctx context.Context) (branchName string, err error)
+	FindBranch(ctx context.Context, substring string) (branch string, exists bool)
+	CheckoutBranch(ctx context.Context, branch string) (err error)
+	GetCommitsToPush(ctx context.Context, branch string) (commits []string, err error)
+	RemoteBranchExists(ctx context.Context, branch string) (exists bool)
+	CommitEmpty(ctx context.Context, message string, signoff bool) (err error)
+	ResetLastCommit(ctx context.Context) (err error)
+	PushBranch(ctx context.Context, branch string) (err error)
+	GetRepositoryRoot(ctx context.Context) (rootPath string, err error)
+	GetAheadBehind(ctx context.Context, branch string, base string) (ahead int, behind int, err error)
+	ListBranches(ctx context.Context) (branches []Branch, err error)
+	DeleteBranch(ctx context.Context, branch string, force bool) (err error)
+	DeleteRemoteBranch(ctx context.Context, branch string) (err error)
+	CheckoutRemoteBranch(ctx context.Context, branch string) (err error)
+	Stash(ctx context.Context, message string) (stashed bool, err error)
+	StashPop(ctx context.Context, message string) (popped bool, err error)
+	HasUncommittedChanges(ctx context.Context) (dirty bool, err error)
+	GetBranchConfig(ctx context.Context, branch string, key string) (value string, err error)
+	SetBranchConfig(ctx context.Context, branch string, key string, value string) (err error)
+	ResolveRef(ctx context.Context, ref string) (sha string, err error)
+	RebaseOnto(ctx context.Context, branch string, newBase string, upstream string) (err error)
+	ForcePushBranch(ctx context.Context, branch string) (err error)
+	GetChangedFiles(ctx context.Context, branch string, base string) (files []string, err error)
+	GetRemotes(ctx context.Context) (remotes Remotes)
+	AddWorktree(ctx context.Context, path string, branch string, base string) (err error)
+	ListWorktrees(ctx context.Context) (worktrees []Worktree, err error)
+	RemoveWorktree(ctx context.Context, path string, force bool) (err error)
 }
 
 type BranchProvider interface {
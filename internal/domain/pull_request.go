@@ -1,13 +1,56 @@
 package domain
 
+import "fmt"
+
 type PullRequest struct {
 	Title       string
 	Number      int64
 	State       string
 	Closed      bool
+	IsDraft     bool
 	Url         string
 	HeadRefName string
-	BaseRefName string
-	Labels      []Label
-	Body        string
+	// HeadRefOid is the last commit of the head branch
+	HeadRefOid     string
+	BaseRefName    string
+	Labels         []Label
+	Body           string
+	ReviewDecision string
+	ChecksStatus   ChecksStatus
+	// Author is the login of the user that opened the pull request
+	Author string `json:"-"`
+}
+
+// ChecksStatus is the summarized status of all the checks of a pull request
+type ChecksStatus string
+
+const (
+	ChecksStatusNone    ChecksStatus = ""
+	ChecksStatusPending ChecksStatus = "PENDING"
+	ChecksStatusSuccess ChecksStatus = "SUCCESS"
+	ChecksStatusFailure ChecksStatus = "FAILURE"
+)
+
+// PullRequestEdit contains the changes to apply to a pull request. Empty fields are left unchanged.
+type PullRequestEdit struct {
+	Title        string
+	Body         string
+	AddLabels    []string
+	RemoveLabels []string
+	AddReviewers []string
+}
+
+// PullRequestMetadataError is returned when the pull request was created but its labels, reviewers
+// or assignees could not be set. The pull request exists, so it must not be undone.
+type PullRequestMetadataError struct {
+	URL string
+	Err error
+}
+
+func (e *PullRequestMetadataError) Error() string {
+	return fmt.Sprintf("the pull request %s was created, but %s", e.URL, e.Err)
+}
+
+func (e *PullRequestMetadataError) Unwrap() error {
+	return e.Err
 }
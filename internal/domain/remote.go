@@ -0,0 +1,14 @@
+package domain
+
+// Remotes are the names of the git remotes of the repository
+type Remotes struct {
+	// Push is the remote where the branches are pushed, the fork in a fork setup
+	Push string
+	// Base is the remote of the base branches, the original repository in a fork setup
+	Base string
+}
+
+// IsFork reports whether the branches are pushed to a different remote than the base branches
+func (r Remotes) IsFork() bool {
+	return r.Push != r.Base
+}
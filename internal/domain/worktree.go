@@ -0,0 +1,10 @@
+package domain
+
+// Worktree is a working tree linked to the repository
+type Worktree struct {
+	Path string
+	// Branch is the branch checked out in the worktree, empty if the HEAD is detached
+	Branch string
+	// Main is set for the main working tree of the repository, which cannot be removed
+	Main bool
+}
@@ -1,11 +1,13 @@
 package domain
 
+import "context"
+
 type FakeGhCli struct{}
 
 func NewFakeGhCli() FakeGhCli {
 	return FakeGhCli{}
 }
 
-func (f FakeGhCli) Execute(result any, args []string) (err error) {
+func (f FakeGhCli) Execute(_ context.Context, result any, args []string) (err error) {
 	return nil
 }
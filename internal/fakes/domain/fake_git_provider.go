@@ -1,11 +1,13 @@
 package domain
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/InditexTech/gh-sherpa/internal/domain"
 )
@@ -17,6 +19,33 @@ type FakeGitProvider struct {
 	CommitsToPush         map[string][]string
 	BranchWithCommitError []string
 	BranchWithPushError   []string
+	AheadBehind           map[string][2]int
+	LastCommitDates       map[string]time.Time
+	UncommittedChanges    bool
+	Stashes               []string
+	// BranchWithCheckoutConflict holds the branches that cannot be checked out while there are uncommitted changes
+	BranchWithCheckoutConflict []string
+	// BranchWithStashConflict holds the branches where applying a stash fails because of conflicts
+	BranchWithStashConflict []string
+	// BranchWithLostStash holds the branches where the stashes are not found when they are applied
+	BranchWithLostStash []string
+	BranchConfig        map[string]string
+	// Commits holds the commit each branch points to, a new one is generated when a branch is rebased
+	Commits               map[string]string
+	Rebases               []string
+	BranchWithRebaseError []string
+	ForcePushed           []string
+	// ChangedFiles holds the files changed in each branch
+	ChangedFiles map[string][]string
+	// RepositoryRoot overrides the root of the repository, which is the working directory by default
+	RepositoryRoot string
+	Remotes        domain.Remotes
+	// Worktrees holds the linked worktrees, the main one is built from RepositoryRoot and CurrentBranch
+	Worktrees []domain.Worktree
+	// SignedOffCommits holds the messages of the commits made with the Signed-off-by trailer
+	SignedOffCommits []string
+	// DirtyWorktrees holds the paths of the worktrees that cannot be removed without force
+	DirtyWorktrees []string
 }
 
 var _ domain.GitProvider = (*FakeGitProvider)(nil)
@@ -34,6 +63,12 @@ func NewFakeGitProvider() *FakeGitProvider {
 		},
 		CommitsToPush:         map[string][]string{},
 		BranchWithCommitError: []string{},
+		AheadBehind:           map[string][2]int{},
+		LastCommitDates:       map[string]time.Time{},
+		BranchConfig:          map[string]string{},
+		Commits:               map[string]string{},
+		ChangedFiles:          map[string][]string{},
+		Remotes:               domain.Remotes{Push: "origin", Base: "origin"},
 	}
 }
 
@@ -53,11 +88,11 @@ func (f *FakeGitProvider) AddRemoteBranches(branches ...string) {
 	f.RemoteBranches = append(f.RemoteBranches, branches...)
 }
 
-func (f *FakeGitProvider) BranchExists(branch string) bool {
+func (f *FakeGitProvider) BranchExists(_ context.Context, branch string) bool {
 	return slices.Contains(f.LocalBranches, branch)
 }
 
-func (f *FakeGitProvider) FetchBranchFromOrigin(branch string) (err error) {
+func (f *FakeGitProvider) FetchBranchFromOrigin(_ context.Context, branch string) (err error) {
 	idx := slices.Index(f.RemoteBranches, branch)
 	if idx == -1 {
 		return fmt.Errorf("remote branch %s not found", branch)
@@ -65,19 +100,24 @@ func (f *FakeGitProvider) FetchBranchFromOrigin(branch string) (err error) {
 	return nil
 }
 
-func (f *FakeGitProvider) CheckoutNewBranchFromOrigin(branch string, base string) (err error) {
+func (f *FakeGitProvider) CheckoutNewBranchFromOrigin(_ context.Context, branch string, base string) (err error) {
 	idx := slices.Index(f.RemoteBranches, base)
 	if idx == -1 {
 		return fmt.Errorf("remote branch %s not found", base)
 	}
+	if f.UncommittedChanges && slices.Contains(f.BranchWithCheckoutConflict, base) {
+		return ErrCheckoutConflict
+	}
 	f.LocalBranches = append(f.LocalBranches, branch)
 	f.CurrentBranch = branch
 	return nil
 }
 
+var ErrCheckoutConflict = errors.New("your local changes would be overwritten by checkout")
+
 var ErrGetCurrentBranch = errors.New("no current branch")
 
-func (f *FakeGitProvider) GetCurrentBranch() (branch string, err error) {
+func (f *FakeGitProvider) GetCurrentBranch(_ context.Context) (branch string, err error) {
 	if f.CurrentBranch != "" {
 		return f.CurrentBranch, nil
 	}
@@ -85,31 +125,29 @@ func (f *FakeGitProvider) GetCurrentBranch() (branch string, err error) {
 	return "", ErrGetCurrentBranch
 }
 
-func (f *FakeGitProvider) FindBranch(substring string) (branch string, exists bool) {
+func (f *FakeGitProvider) FindBranch(_ context.Context, substring string) (branch string, exists bool) {
 	for _, b := range f.LocalBranches {
 		if strings.Contains(b, substring) {
 			return b, true
 		}
 	}
-	for _, b := range f.RemoteBranches {
-		if strings.Contains(b, substring) {
-			return b, true
-		}
-	}
 	return "", false
 }
 
-func (f *FakeGitProvider) CheckoutBranch(branch string) (err error) {
+func (f *FakeGitProvider) CheckoutBranch(_ context.Context, branch string) (err error) {
 	if !slices.Contains(f.LocalBranches, branch) {
 		return fmt.Errorf("local branch %s not found", branch)
 	}
+	if f.UncommittedChanges && slices.Contains(f.BranchWithCheckoutConflict, branch) {
+		return ErrCheckoutConflict
+	}
 	f.CurrentBranch = branch
 	return nil
 }
 
 var ErrGetCommitsToPush = errors.New("error getting commits to push")
 
-func (f *FakeGitProvider) GetCommitsToPush(branch string) (commits []string, err error) {
+func (f *FakeGitProvider) GetCommitsToPush(_ context.Context, branch string) (commits []string, err error) {
 	if slices.Contains(f.BranchWithCommitError, branch) {
 		return commits, ErrGetCommitsToPush
 	}
@@ -122,23 +160,35 @@ func (f *FakeGitProvider) GetCommitsToPush(branch string) (commits []string, err
 	return commits, nil
 }
 
-func (f *FakeGitProvider) RemoteBranchExists(branch string) (exists bool) {
+func (f *FakeGitProvider) RemoteBranchExists(_ context.Context, branch string) (exists bool) {
 	return slices.Contains(f.RemoteBranches, branch)
 }
 
-func (f *FakeGitProvider) CommitEmpty(message string) (err error) {
+func (f *FakeGitProvider) CommitEmpty(_ context.Context, message string, signoff bool) (err error) {
 	currentCommits, ok := f.CommitsToPush[f.CurrentBranch]
 	if !ok {
 		currentCommits = []string{}
 	}
 	currentCommits = append(currentCommits, message)
 	f.CommitsToPush[f.CurrentBranch] = currentCommits
+	if signoff {
+		f.SignedOffCommits = append(f.SignedOffCommits, message)
+	}
+	return nil
+}
+
+func (f *FakeGitProvider) ResetLastCommit(_ context.Context) (err error) {
+	currentCommits := f.CommitsToPush[f.CurrentBranch]
+	if len(currentCommits) == 0 {
+		return fmt.Errorf("the branch %s has no commits to reset", f.CurrentBranch)
+	}
+	f.CommitsToPush[f.CurrentBranch] = currentCommits[:len(currentCommits)-1]
 	return nil
 }
 
 var ErrPushBranch = errors.New("error pushing branch")
 
-func (f *FakeGitProvider) PushBranch(branch string) (err error) {
+func (f *FakeGitProvider) PushBranch(_ context.Context, branch string) (err error) {
 	if slices.Contains(f.BranchWithPushError, branch) {
 		return ErrPushBranch
 	}
@@ -156,7 +206,10 @@ func (f *FakeGitProvider) IsCurrentBranch(branch string) bool {
 	return f.CurrentBranch == branch
 }
 
-func (f *FakeGitProvider) GetRepositoryRoot() (rootPath string, err error) {
+func (f *FakeGitProvider) GetRepositoryRoot(_ context.Context) (rootPath string, err error) {
+	if f.RepositoryRoot != "" {
+		return f.RepositoryRoot, nil
+	}
 	// In the tests, we want it to return the current working directory so that relative paths
 	// are resolved correctly to the real files in testdata/
 	dir, err := os.Getwd()
@@ -165,3 +218,197 @@ func (f *FakeGitProvider) GetRepositoryRoot() (rootPath string, err error) {
 	}
 	return dir, nil
 }
+
+func (f *FakeGitProvider) GetAheadBehind(_ context.Context, branch string, base string) (ahead int, behind int, err error) {
+	if !slices.Contains(f.RemoteBranches, base) {
+		return 0, 0, fmt.Errorf("remote branch %s not found", base)
+	}
+
+	counts := f.AheadBehind[branch]
+	return counts[0], counts[1], nil
+}
+
+func (f *FakeGitProvider) ListBranches(_ context.Context) (branches []domain.Branch, err error) {
+	for _, name := range f.LocalBranches {
+		branches = append(branches, domain.Branch{
+			Name:           name,
+			Local:          true,
+			Remote:         slices.Contains(f.RemoteBranches, name),
+			LastCommitDate: f.LastCommitDates[name],
+		})
+	}
+
+	for _, name := range f.RemoteBranches {
+		if slices.Contains(f.LocalBranches, name) {
+			continue
+		}
+		branches = append(branches, domain.Branch{
+			Name:           name,
+			Remote:         true,
+			LastCommitDate: f.LastCommitDates[name],
+		})
+	}
+
+	return branches, nil
+}
+
+func (f *FakeGitProvider) DeleteBranch(_ context.Context, branch string, force bool) (err error) {
+	idx := slices.Index(f.LocalBranches, branch)
+	if idx == -1 {
+		return fmt.Errorf("local branch %s not found", branch)
+	}
+	if !force && len(f.CommitsToPush[branch]) > 0 {
+		return fmt.Errorf("the branch %s is not fully merged", branch)
+	}
+	f.LocalBranches = slices.Delete(f.LocalBranches, idx, idx+1)
+	return nil
+}
+
+func (f *FakeGitProvider) DeleteRemoteBranch(_ context.Context, branch string) (err error) {
+	idx := slices.Index(f.RemoteBranches, branch)
+	if idx == -1 {
+		return fmt.Errorf("remote branch %s not found", branch)
+	}
+	f.RemoteBranches = slices.Delete(f.RemoteBranches, idx, idx+1)
+	return nil
+}
+
+func (f *FakeGitProvider) CheckoutRemoteBranch(_ context.Context, branch string) (err error) {
+	if !slices.Contains(f.RemoteBranches, branch) {
+		return fmt.Errorf("remote branch %s not found", branch)
+	}
+	if slices.Contains(f.LocalBranches, branch) {
+		return fmt.Errorf("local branch %s already exists", branch)
+	}
+	f.LocalBranches = append(f.LocalBranches, branch)
+	f.CurrentBranch = branch
+	return nil
+}
+
+func (f *FakeGitProvider) Stash(_ context.Context, message string) (stashed bool, err error) {
+	if !f.UncommittedChanges {
+		return false, nil
+	}
+	f.Stashes = append(f.Stashes, message)
+	f.UncommittedChanges = false
+	return true, nil
+}
+
+func (f *FakeGitProvider) StashPop(_ context.Context, message string) (popped bool, err error) {
+	if slices.Contains(f.BranchWithLostStash, f.CurrentBranch) {
+		return false, nil
+	}
+	for i := len(f.Stashes) - 1; i >= 0; i-- {
+		if f.Stashes[i] == message {
+			if slices.Contains(f.BranchWithStashConflict, f.CurrentBranch) {
+				// The stash is kept when applying it fails
+				f.UncommittedChanges = true
+				return false, fmt.Errorf("conflict applying the stash %s", message)
+			}
+			f.Stashes = slices.Delete(f.Stashes, i, i+1)
+			f.UncommittedChanges = true
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *FakeGitProvider) HasUncommittedChanges(_ context.Context) (dirty bool, err error) {
+	return f.UncommittedChanges, nil
+}
+
+func (f *FakeGitProvider) GetBranchConfig(_ context.Context, branch string, key string) (value string, err error) {
+	return f.BranchConfig[branch+"."+key], nil
+}
+
+func (f *FakeGitProvider) SetBranchConfig(_ context.Context, branch string, key string, value string) (err error) {
+	f.BranchConfig[branch+"."+key] = value
+	return nil
+}
+
+func (f *FakeGitProvider) ResolveRef(_ context.Context, ref string) (sha string, err error) {
+	branch := strings.TrimPrefix(strings.TrimPrefix(ref, f.Remotes.Push+"/"), f.Remotes.Base+"/")
+	if !slices.Contains(f.LocalBranches, branch) && !slices.Contains(f.RemoteBranches, branch) {
+		return "", fmt.Errorf("unknown reference %s", ref)
+	}
+	if sha, ok := f.Commits[ref]; ok {
+		return sha, nil
+	}
+	// The remote branches point to the same commit as the local ones unless Commits says otherwise
+	return "sha-" + branch, nil
+}
+
+var ErrRebase = errors.New("error rebasing branch")
+
+func (f *FakeGitProvider) RebaseOnto(_ context.Context, branch string, newBase string, upstream string) (err error) {
+	if slices.Contains(f.BranchWithRebaseError, branch) {
+		return ErrRebase
+	}
+	if !slices.Contains(f.LocalBranches, branch) {
+		return fmt.Errorf("local branch %s not found", branch)
+	}
+	f.Rebases = append(f.Rebases, fmt.Sprintf("%s onto %s from %s", branch, newBase, upstream))
+	f.Commits[branch] = fmt.Sprintf("sha-%s-%d", branch, len(f.Rebases))
+	f.CurrentBranch = branch
+	return nil
+}
+
+func (f *FakeGitProvider) ForcePushBranch(_ context.Context, branch string) (err error) {
+	if slices.Contains(f.BranchWithPushError, branch) {
+		return ErrPushBranch
+	}
+	f.ForcePushed = append(f.ForcePushed, branch)
+	return nil
+}
+
+func (f *FakeGitProvider) GetChangedFiles(_ context.Context, branch string, base string) (files []string, err error) {
+	return f.ChangedFiles[branch], nil
+}
+
+func (f *FakeGitProvider) GetRemotes(_ context.Context) (remotes domain.Remotes) {
+	return f.Remotes
+}
+
+func (f *FakeGitProvider) AddWorktree(ctx context.Context, path string, branch string, base string) (err error) {
+	if base != "" {
+		if !slices.Contains(f.RemoteBranches, base) {
+			return fmt.Errorf("remote branch %s not found", base)
+		}
+		if slices.Contains(f.LocalBranches, branch) {
+			return fmt.Errorf("local branch %s already exists", branch)
+		}
+		f.LocalBranches = append(f.LocalBranches, branch)
+	} else if !slices.Contains(f.LocalBranches, branch) {
+		return fmt.Errorf("local branch %s not found", branch)
+	}
+
+	worktrees, _ := f.ListWorktrees(ctx)
+	for _, worktree := range worktrees {
+		if worktree.Branch == branch {
+			return fmt.Errorf("branch %s is already checked out at %s", branch, worktree.Path)
+		}
+	}
+
+	f.Worktrees = append(f.Worktrees, domain.Worktree{Path: path, Branch: branch})
+	return nil
+}
+
+func (f *FakeGitProvider) ListWorktrees(ctx context.Context) (worktrees []domain.Worktree, err error) {
+	root, err := f.GetRepositoryRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return append([]domain.Worktree{{Path: root, Branch: f.CurrentBranch, Main: true}}, f.Worktrees...), nil
+}
+
+func (f *FakeGitProvider) RemoveWorktree(_ context.Context, path string, force bool) (err error) {
+	idx := slices.IndexFunc(f.Worktrees, func(worktree domain.Worktree) bool { return worktree.Path == path })
+	if idx == -1 {
+		return fmt.Errorf("%s is not a working tree", path)
+	}
+	if !force && slices.Contains(f.DirtyWorktrees, path) {
+		return fmt.Errorf("%s contains modified or untracked files, use --force to delete it", path)
+	}
+	f.Worktrees = slices.Delete(f.Worktrees, idx, idx+1)
+	return nil
+}
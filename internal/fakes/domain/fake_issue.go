@@ -16,6 +16,8 @@ type FakeIssue struct {
 	issueTrackerType domain.IssueTrackerType
 	typeLabel        string
 	labels           []domain.Label
+	state            string
+	closed           bool
 }
 
 var _ domain.Issue = (*FakeIssue)(nil)
@@ -32,6 +34,11 @@ func (f *FakeIssue) SetTypeLabel(label string) {
 	f.typeLabel = label
 }
 
+func (f *FakeIssue) SetState(state string, closed bool) {
+	f.state = state
+	f.closed = closed
+}
+
 func (f *FakeIssue) AddLabel(label domain.Label) {
 	f.labels = append(f.labels, label)
 }
@@ -45,6 +52,7 @@ func NewFakeIssue(id string, issueType issue_types.IssueType, issueTrackerType d
 		issueType:        issueType,
 		issueTrackerType: issueTrackerType,
 		typeLabel:        fmt.Sprintf("kind/%s", issueType),
+		state:            "open",
 	}
 }
 
@@ -92,3 +100,11 @@ func (f *FakeIssue) HasLabel(labelName string) bool {
 	}
 	return false
 }
+
+func (f *FakeIssue) State() string {
+	return f.state
+}
+
+func (f *FakeIssue) IsClosed() bool {
+	return f.closed
+}
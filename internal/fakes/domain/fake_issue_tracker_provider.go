@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"context"
 	"errors"
 	"strings"
 
@@ -8,24 +9,31 @@ import (
 )
 
 type FakeIssueTrackerProvider struct {
-	Issues []domain.Issue
+	Issues         []domain.Issue
+	AssignedIssues []domain.Issue
+	QueryIssues    map[string][]domain.Issue
+	// Transitions holds the last transition applied to each issue
+	Transitions map[string]string
 }
 
 var _ domain.IssueTrackerProvider = (*FakeIssueTrackerProvider)(nil)
 
 func NewFakeIssueTrackerProvider() *FakeIssueTrackerProvider {
 	return &FakeIssueTrackerProvider{
-		Issues: []domain.Issue{},
+		Issues:      []domain.Issue{},
+		Transitions: map[string]string{},
 	}
 }
 
 var ErrNoIssue = errors.New("no issue")
 
+var ErrNoQuery = errors.New("no query")
+
 func (f *FakeIssueTrackerProvider) AddIssue(issue domain.Issue) {
 	f.Issues = append(f.Issues, issue)
 }
 
-func (f *FakeIssueTrackerProvider) GetIssue(identifier string) (issue domain.Issue, err error) {
+func (f *FakeIssueTrackerProvider) GetIssue(_ context.Context, identifier string) (issue domain.Issue, err error) {
 	for _, i := range f.Issues {
 		if i.ID() == identifier {
 			return i, nil
@@ -38,3 +46,26 @@ func (f *FakeIssueTrackerProvider) GetIssue(identifier string) (issue domain.Iss
 func (f *FakeIssueTrackerProvider) ParseIssueId(identifier string) (issueId string) {
 	return strings.TrimPrefix(identifier, "GH-")
 }
+
+func (f *FakeIssueTrackerProvider) GetAssignedIssues(_ context.Context) (issues []domain.Issue, err error) {
+	return f.AssignedIssues, nil
+}
+
+func (f *FakeIssueTrackerProvider) SearchIssues(_ context.Context, queryName string) (issues []domain.Issue, err error) {
+	issues, ok := f.QueryIssues[queryName]
+	if !ok {
+		return nil, ErrNoQuery
+	}
+
+	return issues, nil
+}
+
+func (f *FakeIssueTrackerProvider) TransitionIssue(ctx context.Context, identifier string, transition string) (err error) {
+	if _, err := f.GetIssue(ctx, identifier); err != nil {
+		return err
+	}
+
+	f.Transitions[identifier] = transition
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"slices"
@@ -11,6 +12,14 @@ import (
 type FakePullRequestProvider struct {
 	PullRequests           map[string]*domain.PullRequest
 	PullRequestsWithErrors []string
+	// RequiredChecks holds the successive statuses returned for the required checks of each branch,
+	// the last one is kept once the others have been returned
+	RequiredChecks map[string][]domain.ChecksStatus
+	// Reviewers holds the reviewers requested for each branch
+	Reviewers map[string][]string
+	// MetadataErrors holds, for each branch, the error of setting the labels, reviewers or assignees
+	// of its pull request once it has been created
+	MetadataErrors map[string]error
 }
 
 var _ domain.PullRequestProvider = (*FakePullRequestProvider)(nil)
@@ -19,6 +28,8 @@ func NewFakePullRequestProvider() *FakePullRequestProvider {
 	return &FakePullRequestProvider{
 		PullRequests:           map[string]*domain.PullRequest{},
 		PullRequestsWithErrors: []string{},
+		RequiredChecks:         map[string][]domain.ChecksStatus{},
+		Reviewers:              map[string][]string{},
 	}
 }
 
@@ -41,7 +52,7 @@ func (f *FakePullRequestProvider) HasPullRequestForBranch(branch string) bool {
 	return pr != nil
 }
 
-func (f *FakePullRequestProvider) GetPullRequestForBranch(branch string) (pullRequest *domain.PullRequest, err error) {
+func (f *FakePullRequestProvider) GetPullRequestForBranch(_ context.Context, branch string) (pullRequest *domain.PullRequest, err error) {
 	pr := f.PullRequests[branch]
 
 	return pr, nil
@@ -53,7 +64,7 @@ func ErrPrAlreadyExists(branch string) error {
 
 var ErrPullRequestWithError = errors.New("pull request with error")
 
-func (f *FakePullRequestProvider) CreatePullRequest(title string, body string, baseBranch string, headBranch string, draft bool, labels []string, reviewers []string, assignees []string) (prUrl string, err error) {
+func (f *FakePullRequestProvider) CreatePullRequest(_ context.Context, title string, body string, baseBranch string, headBranch string, draft bool, labels []string, reviewers []string, assignees []string) (prUrl string, err error) {
 	if slices.Contains(f.PullRequestsWithErrors, headBranch) {
 		return "", ErrPullRequestWithError
 	}
@@ -84,5 +95,86 @@ func (f *FakePullRequestProvider) CreatePullRequest(title string, body string, b
 
 	f.PullRequests[headBranch] = pr
 
+	if err := f.MetadataErrors[headBranch]; err != nil {
+		return pr.Url, &domain.PullRequestMetadataError{URL: pr.Url, Err: err}
+	}
+
 	return pr.Url, nil
 }
+
+func (f *FakePullRequestProvider) UpdatePullRequestBase(_ context.Context, headBranch string, baseBranch string) (err error) {
+	if slices.Contains(f.PullRequestsWithErrors, headBranch) {
+		return ErrPullRequestWithError
+	}
+
+	pr := f.PullRequests[headBranch]
+	if pr == nil {
+		return fmt.Errorf("no pull request for branch %s", headBranch)
+	}
+	pr.BaseRefName = baseBranch
+
+	return nil
+}
+
+func (f *FakePullRequestProvider) EditPullRequest(_ context.Context, headBranch string, edit domain.PullRequestEdit) (err error) {
+	if slices.Contains(f.PullRequestsWithErrors, headBranch) {
+		return ErrPullRequestWithError
+	}
+
+	pr := f.PullRequests[headBranch]
+	if pr == nil {
+		return fmt.Errorf("no pull request for branch %s", headBranch)
+	}
+
+	if edit.Title != "" {
+		pr.Title = edit.Title
+	}
+	if edit.Body != "" {
+		pr.Body = edit.Body
+	}
+
+	labels := []domain.Label{}
+	for _, label := range pr.Labels {
+		if !slices.Contains(edit.RemoveLabels, label.Name) {
+			labels = append(labels, label)
+		}
+	}
+	for _, label := range edit.AddLabels {
+		labels = append(labels, domain.Label{Id: label, Name: label})
+	}
+	pr.Labels = labels
+
+	f.Reviewers[headBranch] = append(f.Reviewers[headBranch], edit.AddReviewers...)
+
+	return nil
+}
+
+func (f *FakePullRequestProvider) GetRequiredChecksStatus(_ context.Context, headBranch string) (status domain.ChecksStatus, err error) {
+	if slices.Contains(f.PullRequestsWithErrors, headBranch) {
+		return "", ErrPullRequestWithError
+	}
+
+	statuses := f.RequiredChecks[headBranch]
+	if len(statuses) == 0 {
+		return domain.ChecksStatusNone, nil
+	}
+	if len(statuses) > 1 {
+		f.RequiredChecks[headBranch] = statuses[1:]
+	}
+
+	return statuses[0], nil
+}
+
+func (f *FakePullRequestProvider) MarkPullRequestReady(_ context.Context, headBranch string) (err error) {
+	if slices.Contains(f.PullRequestsWithErrors, headBranch) {
+		return ErrPullRequestWithError
+	}
+
+	pr := f.PullRequests[headBranch]
+	if pr == nil {
+		return fmt.Errorf("no pull request for branch %s", headBranch)
+	}
+	pr.IsDraft = false
+
+	return nil
+}
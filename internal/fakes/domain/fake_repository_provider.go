@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"context"
 	"errors"
 
 	"github.com/InditexTech/gh-sherpa/internal/domain"
@@ -25,7 +26,7 @@ func NewRepositoryProvider() *FakeRepositoryProvider {
 
 var ErrRepositoryNotFound = errors.New("repository not found")
 
-func (f *FakeRepositoryProvider) GetRepository() (repo *domain.Repository, err error) {
+func (f *FakeRepositoryProvider) GetRepository(_ context.Context) (repo *domain.Repository, err error) {
 	if f.Repository != nil {
 		return f.Repository, nil
 	}
@@ -27,3 +27,7 @@ func (f *FakeUserInteractionProvider) SelectOrInputPrompt(message string, validV
 func (f *FakeUserInteractionProvider) SelectOrInput(name string, validValues []string, variable *string, required bool) error {
 	return errors.New("not implemented")
 }
+
+func (f *FakeUserInteractionProvider) SelectWithFilter(message string, options []string, selectedIndex *int) error {
+	return errors.New("not implemented")
+}
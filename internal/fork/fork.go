@@ -1,6 +1,7 @@
 package fork
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -33,15 +34,15 @@ func NewManager(
 	}
 }
 
-func (m *Manager) DetectForkStatus() (*domain.ForkStatus, error) {
+func (m *Manager) DetectForkStatus(ctx context.Context) (*domain.ForkStatus, error) {
 	logging.Debug("Detecting repository fork status...")
 
-	repo, err := m.repositoryProvider.GetRepository()
+	repo, err := m.repositoryProvider.GetRepository(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get repository information: %w", err)
 	}
 
-	remotes, err := m.ghCli.GetRemoteConfiguration()
+	remotes, err := m.ghCli.GetRemoteConfiguration(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get remote configuration: %w", err)
 	}
@@ -75,7 +76,7 @@ func (m *Manager) DetectForkStatus() (*domain.ForkStatus, error) {
 	}
 
 	// Fallback: check if repository itself is a fork via GitHub API
-	isInFork, err := m.ghCli.IsRepositoryFork()
+	isInFork, err := m.ghCli.IsRepositoryFork(ctx)
 	if err != nil {
 		logging.Debugf("Warning: failed to check if repository is a fork via API: %v", err)
 		// Don't return error here, continue with remote-based detection
@@ -98,10 +99,10 @@ func (m *Manager) DetectForkStatus() (*domain.ForkStatus, error) {
 	return status, nil
 }
 
-func (m *Manager) SetupFork(customForkName string) (*domain.ForkSetupResult, error) {
+func (m *Manager) SetupFork(ctx context.Context, customForkName string) (*domain.ForkSetupResult, error) {
 	logging.PrintInfo("Detecting repository setup...")
 
-	status, err := m.DetectForkStatus()
+	status, err := m.DetectForkStatus(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -115,16 +116,16 @@ func (m *Manager) SetupFork(customForkName string) (*domain.ForkSetupResult, err
 			return nil, fmt.Errorf("fork mismatch: repository is already configured with fork '%s', but you requested '%s'. Please use the existing fork or reconfigure the repository", status.ForkName, customForkName)
 		}
 
-		fmt.Printf("Fork already configured, creating branch...\n")
+		fmt.Fprintf(logging.Output(), "Fork already configured, creating branch...\n")
 		result.WasAlreadyConfigured = true
 		result.ForkName = status.ForkName
 		result.UpstreamName = status.UpstreamName
 
-		repo, err := m.repositoryProvider.GetRepository()
+		repo, err := m.repositoryProvider.GetRepository(ctx)
 		if err != nil {
 			logging.PrintWarn("Warning: Could not get repository info to set default repository")
 		} else {
-			if err := m.ghCli.SetDefaultRepository(repo.NameWithOwner); err != nil {
+			if err := m.ghCli.SetDefaultRepository(ctx, repo.NameWithOwner); err != nil {
 				logging.PrintWarn(fmt.Sprintf("Warning: Could not set default repository to upstream: %v", err))
 				logging.PrintWarn("You may need to run 'gh repo set-default " + repo.NameWithOwner + "' manually")
 			} else {
@@ -135,7 +136,7 @@ func (m *Manager) SetupFork(customForkName string) (*domain.ForkSetupResult, err
 		return result, nil
 	}
 
-	repo, err := m.repositoryProvider.GetRepository()
+	repo, err := m.repositoryProvider.GetRepository(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get repository information: %w", err)
 	}
@@ -145,14 +146,14 @@ func (m *Manager) SetupFork(customForkName string) (*domain.ForkSetupResult, err
 		forkName = fmt.Sprintf("%s/%s", m.cfg.DefaultOrganization, repo.Name)
 	}
 
-	if err := m.handleForkCreation(status, forkName, result); err != nil {
+	if err := m.handleForkCreation(ctx, status, forkName, result); err != nil {
 		return nil, err
 	}
 
-	fmt.Printf("Setting up remotes (origin: fork, upstream: original)...\n")
-	fmt.Printf("Fetching branches from fork...\n")
-	if err := m.gitProvider.FetchBranchFromOrigin("main"); err != nil {
-		if err := m.gitProvider.FetchBranchFromOrigin("master"); err != nil {
+	fmt.Fprintf(logging.Output(), "Setting up remotes (origin: fork, upstream: original)...\n")
+	fmt.Fprintf(logging.Output(), "Fetching branches from fork...\n")
+	if err := m.gitProvider.FetchBranchFromOrigin(ctx, "main"); err != nil {
+		if err := m.gitProvider.FetchBranchFromOrigin(ctx, "master"); err != nil {
 			logging.PrintWarn("Could not fetch main/master branch from fork")
 		}
 	}
@@ -162,7 +163,7 @@ func (m *Manager) SetupFork(customForkName string) (*domain.ForkSetupResult, err
 		result.ForkName = repo.NameWithOwner
 	}
 
-	if err := m.ghCli.SetDefaultRepository(repo.NameWithOwner); err != nil {
+	if err := m.ghCli.SetDefaultRepository(ctx, repo.NameWithOwner); err != nil {
 		logging.PrintWarn(fmt.Sprintf("Warning: Could not set default repository to upstream: %v", err))
 		logging.PrintWarn("You may need to run 'gh repo set-default " + repo.NameWithOwner + "' manually")
 	} else {
@@ -172,69 +173,109 @@ func (m *Manager) SetupFork(customForkName string) (*domain.ForkSetupResult, err
 	return result, nil
 }
 
-func (m *Manager) handleForkCreation(status *domain.ForkStatus, forkName string, result *domain.ForkSetupResult) error {
+// PlanFork returns the fork that SetupFork would use and whether it would be created, without
+// changing anything. The name is empty if the fork would be created with the default name.
+func (m *Manager) PlanFork(ctx context.Context, customForkName string) (forkName string, create bool, err error) {
+	status, err := m.DetectForkStatus(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	if status.IsInFork && status.HasCorrectRemotes {
+		if customForkName != "" && customForkName != status.ForkName {
+			return "", false, fmt.Errorf("fork mismatch: repository is already configured with fork '%s', but you requested '%s'. Please use the existing fork or reconfigure the repository", status.ForkName, customForkName)
+		}
+		return status.ForkName, false, nil
+	}
+
+	if status.IsInFork {
+		return status.ForkName, false, nil
+	}
+
+	repo, err := m.repositoryProvider.GetRepository(ctx)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get repository information: %w", err)
+	}
+
+	forkName = customForkName
+	if forkName == "" && m.cfg.DefaultOrganization != "" {
+		forkName = fmt.Sprintf("%s/%s", m.cfg.DefaultOrganization, repo.Name)
+	}
+	if forkName == "" {
+		return "", true, nil
+	}
+
+	exists, err := m.ghCli.ForkExists(ctx, forkName)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to check if fork exists: %w", err)
+	}
+
+	return forkName, !exists, nil
+}
+
+func (m *Manager) handleForkCreation(ctx context.Context, status *domain.ForkStatus, forkName string, result *domain.ForkSetupResult) error {
 	if !status.IsInFork {
-		return m.createNewFork(forkName, result)
+		return m.createNewFork(ctx, forkName, result)
 	}
 
 	result.ForkName = status.ForkName
 	if !status.HasCorrectRemotes {
-		fmt.Printf("Fork detected but remotes need configuration...\n")
+		fmt.Fprintf(logging.Output(), "Fork detected but remotes need configuration...\n")
 	}
 	return nil
 }
 
-func (m *Manager) createNewFork(forkName string, result *domain.ForkSetupResult) error {
+func (m *Manager) createNewFork(ctx context.Context, forkName string, result *domain.ForkSetupResult) error {
 	if forkName != "" {
-		return m.createNamedFork(forkName, result)
+		return m.createNamedFork(ctx, forkName, result)
 	}
-	return m.createDefaultFork(result)
+	return m.createDefaultFork(ctx, result)
 }
 
-func (m *Manager) createNamedFork(forkName string, result *domain.ForkSetupResult) error {
-	exists, err := m.ghCli.ForkExists(forkName)
+func (m *Manager) createNamedFork(ctx context.Context, forkName string, result *domain.ForkSetupResult) error {
+	exists, err := m.ghCli.ForkExists(ctx, forkName)
 	if err != nil {
 		return fmt.Errorf("failed to check if fork exists: %w", err)
 	}
 
 	if exists {
-		fmt.Printf("Fork %s already exists, configuring for use...\n", forkName)
+		fmt.Fprintf(logging.Output(), "Fork %s already exists, configuring for use...\n", forkName)
 		result.ForkCreated = false
 		result.ForkName = forkName
 
 		// Actually configure the remotes when fork exists
-		if err := m.ghCli.ConfigureRemotesForExistingFork(forkName); err != nil {
+		if err := m.ghCli.ConfigureRemotesForExistingFork(ctx, forkName); err != nil {
 			return fmt.Errorf("failed to configure remotes: %w", err)
 		}
 
 		return nil
 	}
 
-	return m.performForkCreation(forkName, result)
+	return m.performForkCreation(ctx, forkName, result)
 }
 
-func (m *Manager) createDefaultFork(result *domain.ForkSetupResult) error {
-	fmt.Printf("No fork detected. Creating fork...")
+func (m *Manager) createDefaultFork(ctx context.Context, result *domain.ForkSetupResult) error {
+	fmt.Fprintf(logging.Output(), "No fork detected. Creating fork...")
 
 	if err := m.requestUserConfirmation(); err != nil {
 		return err
 	}
 
-	return m.executeForkCreation("", result)
+	return m.executeForkCreation(ctx, "", result)
 }
 
-func (m *Manager) performForkCreation(forkName string, result *domain.ForkSetupResult) error {
-	fmt.Printf("No fork detected. Creating fork")
+func (m *Manager) performForkCreation(ctx context.Context, forkName string, result *domain.ForkSetupResult) error {
+	fmt.Fprintf(logging.Output(), "No fork detected. Creating fork")
 	if forkName != "" {
-		fmt.Printf(" %s", logging.PaintInfo(forkName))
+		fmt.Fprintf(logging.Output(), " %s", logging.PaintInfo(forkName))
 	}
-	fmt.Println("...")
+	fmt.Fprintln(logging.Output(), "...")
 
 	if err := m.requestUserConfirmation(); err != nil {
 		return err
 	}
 
-	return m.executeForkCreation(forkName, result)
+	return m.executeForkCreation(ctx, forkName, result)
 }
 
 func (m *Manager) requestUserConfirmation() error {
@@ -251,8 +292,8 @@ func (m *Manager) requestUserConfirmation() error {
 	return nil
 }
 
-func (m *Manager) executeForkCreation(forkName string, result *domain.ForkSetupResult) error {
-	if err := m.ghCli.CreateFork(forkName); err != nil {
+func (m *Manager) executeForkCreation(ctx context.Context, forkName string, result *domain.ForkSetupResult) error {
+	if err := m.ghCli.CreateFork(ctx, forkName); err != nil {
 		if strings.Contains(err.Error(), "already exists") {
 			logging.PrintWarn("Fork already exists, continuing with setup...")
 			result.ForkCreated = false
@@ -260,21 +301,21 @@ func (m *Manager) executeForkCreation(forkName string, result *domain.ForkSetupR
 			return fmt.Errorf("failed to create fork: %w", err)
 		}
 	} else {
-		fmt.Printf("✓ Fork created successfully\n")
+		fmt.Fprintf(logging.Output(), "✓ Fork created successfully\n")
 		result.ForkCreated = true
 	}
 
 	if forkName != "" {
 		result.ForkName = forkName
 	} else {
-		return m.updateForkNameFromStatus(result)
+		return m.updateForkNameFromStatus(ctx, result)
 	}
 
 	return nil
 }
 
-func (m *Manager) updateForkNameFromStatus(result *domain.ForkSetupResult) error {
-	updatedStatus, err := m.DetectForkStatus()
+func (m *Manager) updateForkNameFromStatus(ctx context.Context, result *domain.ForkSetupResult) error {
+	updatedStatus, err := m.DetectForkStatus(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to detect fork status after creation: %w", err)
 	}
@@ -1,6 +1,7 @@
 package fork
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
@@ -14,7 +15,7 @@ type mockRepositoryProvider struct {
 	err  error
 }
 
-func (m *mockRepositoryProvider) GetRepository() (*domain.Repository, error) {
+func (m *mockRepositoryProvider) GetRepository(_ context.Context) (*domain.Repository, error) {
 	if m.err != nil {
 		return nil, m.err
 	}
@@ -25,17 +26,64 @@ type mockGitProvider struct {
 	fetchError error
 }
 
-func (m *mockGitProvider) BranchExists(branch string) bool                       { return false }
-func (m *mockGitProvider) FetchBranchFromOrigin(branch string) error             { return m.fetchError }
-func (m *mockGitProvider) CheckoutNewBranchFromOrigin(branch, base string) error { return nil }
-func (m *mockGitProvider) GetCurrentBranch() (string, error)                     { return "main", nil }
-func (m *mockGitProvider) FindBranch(substring string) (string, bool)            { return "", false }
-func (m *mockGitProvider) CheckoutBranch(branch string) error                    { return nil }
-func (m *mockGitProvider) GetCommitsToPush(branch string) ([]string, error)      { return nil, nil }
-func (m *mockGitProvider) RemoteBranchExists(branch string) bool                 { return false }
-func (m *mockGitProvider) CommitEmpty(message string) error                      { return nil }
-func (m *mockGitProvider) PushBranch(branch string) error                        { return nil }
-func (m *mockGitProvider) GetRepositoryRoot() (string, error)                    { return "/tmp", nil }
+func (m *mockGitProvider) BranchExists(_ context.Context, branch string) bool { return false }
+func (m *mockGitProvider) FetchBranchFromOrigin(_ context.Context, branch string) error {
+	return m.fetchError
+}
+func (m *mockGitProvider) CheckoutNewBranchFromOrigin(_ context.Context, branch, base string) error {
+	return nil
+}
+func (m *mockGitProvider) GetCurrentBranch(_ context.Context) (string, error) { return "main", nil }
+func (m *mockGitProvider) FindBranch(_ context.Context, substring string) (string, bool) {
+	return "", false
+}
+func (m *mockGitProvider) CheckoutBranch(_ context.Context, branch string) error { return nil }
+func (m *mockGitProvider) GetCommitsToPush(_ context.Context, branch string) ([]string, error) {
+	return nil, nil
+}
+func (m *mockGitProvider) RemoteBranchExists(_ context.Context, branch string) bool { return false }
+func (m *mockGitProvider) CommitEmpty(_ context.Context, message string, signoff bool) error {
+	return nil
+}
+func (m *mockGitProvider) PushBranch(_ context.Context, branch string) error   { return nil }
+func (m *mockGitProvider) GetRepositoryRoot(_ context.Context) (string, error) { return "/tmp", nil }
+func (m *mockGitProvider) GetAheadBehind(_ context.Context, branch, base string) (int, int, error) {
+	return 0, 0, nil
+}
+func (m *mockGitProvider) ListBranches(_ context.Context) ([]domain.Branch, error) { return nil, nil }
+func (m *mockGitProvider) DeleteBranch(_ context.Context, branch string, force bool) error {
+	return nil
+}
+func (m *mockGitProvider) DeleteRemoteBranch(_ context.Context, branch string) error   { return nil }
+func (m *mockGitProvider) CheckoutRemoteBranch(_ context.Context, branch string) error { return nil }
+func (m *mockGitProvider) Stash(_ context.Context, message string) (bool, error)       { return false, nil }
+func (m *mockGitProvider) StashPop(_ context.Context, message string) (bool, error) {
+	return false, nil
+}
+func (m *mockGitProvider) HasUncommittedChanges(_ context.Context) (bool, error) { return false, nil }
+func (m *mockGitProvider) GetBranchConfig(_ context.Context, branch, key string) (string, error) {
+	return "", nil
+}
+func (m *mockGitProvider) SetBranchConfig(_ context.Context, branch, key, value string) error {
+	return nil
+}
+func (m *mockGitProvider) ResolveRef(_ context.Context, ref string) (string, error) { return "", nil }
+func (m *mockGitProvider) RebaseOnto(_ context.Context, branch, newBase, upstream string) error {
+	return nil
+}
+func (m *mockGitProvider) ForcePushBranch(_ context.Context, branch string) error { return nil }
+func (m *mockGitProvider) GetChangedFiles(_ context.Context, branch, base string) ([]string, error) {
+	return nil, nil
+}
+func (m *mockGitProvider) ResetLastCommit(_ context.Context) error                        { return nil }
+func (m *mockGitProvider) GetRemotes(_ context.Context) domain.Remotes                    { return domain.Remotes{} }
+func (m *mockGitProvider) AddWorktree(_ context.Context, path, branch, base string) error { return nil }
+func (m *mockGitProvider) ListWorktrees(_ context.Context) ([]domain.Worktree, error) {
+	return nil, nil
+}
+func (m *mockGitProvider) RemoveWorktree(_ context.Context, path string, force bool) error {
+	return nil
+}
 
 type mockUserInteractionProvider struct {
 	confirmationResult bool
@@ -57,6 +105,10 @@ func (m *mockUserInteractionProvider) SelectOrInput(name string, validValues []s
 	return nil
 }
 
+func (m *mockUserInteractionProvider) SelectWithFilter(message string, options []string, selectedIndex *int) error {
+	return nil
+}
+
 type mockForkProvider struct {
 	isRepositoryFork      bool
 	isRepositoryForkError error
@@ -69,27 +121,27 @@ type mockForkProvider struct {
 }
 
 // ConfigureRemotesForExistingFork implements ForkProvider.
-func (m *mockForkProvider) ConfigureRemotesForExistingFork(forkName string) error {
+func (m *mockForkProvider) ConfigureRemotesForExistingFork(_ context.Context, forkName string) error {
 	return nil
 }
 
-func (m *mockForkProvider) IsRepositoryFork() (bool, error) {
+func (m *mockForkProvider) IsRepositoryFork(_ context.Context) (bool, error) {
 	return m.isRepositoryFork, m.isRepositoryForkError
 }
 
-func (m *mockForkProvider) CreateFork(forkName string) error {
+func (m *mockForkProvider) CreateFork(_ context.Context, forkName string) error {
 	return m.createForkError
 }
 
-func (m *mockForkProvider) ForkExists(forkName string) (bool, error) {
+func (m *mockForkProvider) ForkExists(_ context.Context, forkName string) (bool, error) {
 	return m.forkExists, m.forkExistsError
 }
 
-func (m *mockForkProvider) SetDefaultRepository(repo string) error {
+func (m *mockForkProvider) SetDefaultRepository(_ context.Context, repo string) error {
 	return m.setDefaultRepoError
 }
 
-func (m *mockForkProvider) GetRemoteConfiguration() (map[string]string, error) {
+func (m *mockForkProvider) GetRemoteConfiguration(_ context.Context) (map[string]string, error) {
 	if m.remoteConfigError != nil {
 		return nil, m.remoteConfigError
 	}
@@ -115,7 +167,7 @@ func TestDetectForkStatus_NotAFork(t *testing.T) {
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	status, err := manager.DetectForkStatus()
+	status, err := manager.DetectForkStatus(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -152,7 +204,7 @@ func TestDetectForkStatus_IsFork(t *testing.T) {
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	status, err := manager.DetectForkStatus()
+	status, err := manager.DetectForkStatus(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -197,7 +249,7 @@ func TestSetupFork_AlreadyConfigured(t *testing.T) {
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	result, err := manager.SetupFork("")
+	result, err := manager.SetupFork(context.Background(), "")
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -231,7 +283,7 @@ func TestSetupFork_CreateNewFork(t *testing.T) {
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	result, err := manager.SetupFork("")
+	result, err := manager.SetupFork(context.Background(), "")
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -265,7 +317,7 @@ func TestSetupFork_UserDeclinesToCreateFork(t *testing.T) {
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	_, err := manager.SetupFork("")
+	_, err := manager.SetupFork(context.Background(), "")
 
 	if err == nil {
 		t.Error("Expected error when user declines to create fork")
@@ -296,7 +348,7 @@ func TestSetupFork_CreateForkError(t *testing.T) {
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	_, err := manager.SetupFork("")
+	_, err := manager.SetupFork(context.Background(), "")
 
 	if err == nil {
 		t.Error("Expected error when fork creation fails")
@@ -329,7 +381,7 @@ func TestDetectForkStatus_ForkViaAPI_WithCorrectRemotes(t *testing.T) {
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	status, err := manager.DetectForkStatus()
+	status, err := manager.DetectForkStatus(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -373,7 +425,7 @@ func TestDetectForkStatus_ForkViaAPI_WithoutCorrectRemotes(t *testing.T) {
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	status, err := manager.DetectForkStatus()
+	status, err := manager.DetectForkStatus(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -415,7 +467,7 @@ func TestDetectForkStatus_ForkViaAPI_NoRemotes(t *testing.T) {
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	status, err := manager.DetectForkStatus()
+	status, err := manager.DetectForkStatus(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -458,7 +510,7 @@ func TestDetectForkStatus_APIError_ContinuesWithRemoteDetection(t *testing.T) {
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	status, err := manager.DetectForkStatus()
+	status, err := manager.DetectForkStatus(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -494,7 +546,7 @@ func TestSetupFork_InForkButRemotesNotConfigured(t *testing.T) {
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	result, err := manager.SetupFork("")
+	result, err := manager.SetupFork(context.Background(), "")
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -539,7 +591,7 @@ func TestDetectForkStatus_ForkViaAPI_RemotesConfiguredInSecondCheck(t *testing.T
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	status, err := manager.DetectForkStatus()
+	status, err := manager.DetectForkStatus(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -584,7 +636,7 @@ func TestDetectForkStatus_ForkViaAPI_CorrectRemotesButWrongConfiguration(t *test
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	status, err := manager.DetectForkStatus()
+	status, err := manager.DetectForkStatus(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -627,7 +679,7 @@ func TestSetupFork_CreateForkAlreadyExists(t *testing.T) {
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	result, err := manager.SetupFork("")
+	result, err := manager.SetupFork(context.Background(), "")
 
 	if err != nil {
 		t.Errorf("Expected no error when fork already exists, got %v", err)
@@ -666,7 +718,7 @@ func TestSetupFork_CreateForkAlreadyExistsNonInteractive(t *testing.T) {
 	cfg := domain.ForkConfiguration{IsInteractive: false}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	result, err := manager.SetupFork("user/gh-sherpa")
+	result, err := manager.SetupFork(context.Background(), "user/gh-sherpa")
 
 	if err != nil {
 		t.Errorf("Expected no error when fork already exists, got %v", err)
@@ -705,7 +757,7 @@ func TestSetupFork_CreateForkOtherError(t *testing.T) {
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	_, err := manager.SetupFork("")
+	_, err := manager.SetupFork(context.Background(), "")
 
 	if err == nil {
 		t.Error("Expected error for network error when creating fork")
@@ -739,7 +791,7 @@ func TestDetectForkStatus_RemotesCorrectlyConfigured_ViaRemoteDetection(t *testi
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	status, err := manager.DetectForkStatus()
+	status, err := manager.DetectForkStatus(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -785,7 +837,7 @@ func TestDetectForkStatus_RemotesIncorrectlyConfigured_OriginSameAsRepo(t *testi
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	status, err := manager.DetectForkStatus()
+	status, err := manager.DetectForkStatus(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -823,7 +875,7 @@ func TestDetectForkStatus_RemotesIncorrectlyConfigured_UpstreamDifferentFromRepo
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	status, err := manager.DetectForkStatus()
+	status, err := manager.DetectForkStatus(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -862,7 +914,7 @@ func TestDetectForkStatus_ForkViaAPI_RemotesCorrectlyConfiguredInSecondaryCheck(
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	status, err := manager.DetectForkStatus()
+	status, err := manager.DetectForkStatus(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -905,7 +957,7 @@ func TestSetupFork_ForkMismatch_DifferentCustomForkName(t *testing.T) {
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
 	// Try to setup with a different fork name than the existing one
-	_, err := manager.SetupFork("different-user/gh-sherpa")
+	_, err := manager.SetupFork(context.Background(), "different-user/gh-sherpa")
 
 	if err == nil {
 		t.Error("Expected error when custom fork name doesn't match existing fork")
@@ -941,7 +993,7 @@ func TestSetupFork_ForkMatch_SameCustomForkName(t *testing.T) {
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
 	// Try to setup with the same fork name as the existing one
-	result, err := manager.SetupFork("existing-user/gh-sherpa")
+	result, err := manager.SetupFork(context.Background(), "existing-user/gh-sherpa")
 
 	if err != nil {
 		t.Errorf("Expected no error when custom fork name matches existing fork, got %v", err)
@@ -979,7 +1031,7 @@ func TestDetectForkStatus_FallbackBranch_APIFork_WithCorrectRemotesInSecondCheck
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	status, err := manager.DetectForkStatus()
+	status, err := manager.DetectForkStatus(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -1026,7 +1078,7 @@ func TestSetupFork_NoCustomForkName_ExistingFork(t *testing.T) {
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
 	// Setup without custom fork name
-	result, err := manager.SetupFork("")
+	result, err := manager.SetupFork(context.Background(), "")
 
 	if err != nil {
 		t.Errorf("Expected no error when no custom fork name provided, got %v", err)
@@ -1066,7 +1118,7 @@ func TestSetupFork_ForkExistsCheck_SkipsCreation(t *testing.T) {
 
 	// This simulates: gh sherpa create-pr --fork --fork-name=carlosmmesa-test-org/gh-sherpa
 	// where carlosmmesa-test-org/gh-sherpa already exists
-	result, err := manager.SetupFork("carlosmmesa-test-org/gh-sherpa")
+	result, err := manager.SetupFork(context.Background(), "carlosmmesa-test-org/gh-sherpa")
 
 	if err != nil {
 		t.Errorf("Expected no error when fork exists, got %v", err)
@@ -1113,7 +1165,7 @@ func TestSetupFork_ForkExistsCheck_ForkDoesNotExist(t *testing.T) {
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	result, err := manager.SetupFork("new-user/gh-sherpa")
+	result, err := manager.SetupFork(context.Background(), "new-user/gh-sherpa")
 
 	if err != nil {
 		t.Errorf("Expected no error when creating new fork, got %v", err)
@@ -1155,7 +1207,7 @@ func TestSetupFork_ForkExistsCheck_Error(t *testing.T) {
 	cfg := domain.ForkConfiguration{IsInteractive: true}
 	manager := NewManager(cfg, repoProvider, gitProvider, userProvider, forkProvider)
 
-	_, err := manager.SetupFork("user/gh-sherpa")
+	_, err := manager.SetupFork(context.Background(), "user/gh-sherpa")
 
 	// Should fail when fork existence check fails
 	if err == nil {
@@ -1166,3 +1218,67 @@ func TestSetupFork_ForkExistsCheck_Error(t *testing.T) {
 		t.Errorf("Expected error to contain fork existence check error, got %v", err)
 	}
 }
+
+func TestPlanFork(t *testing.T) {
+	repo := &domain.Repository{
+		Name:             "gh-sherpa",
+		Owner:            "InditexTech",
+		NameWithOwner:    "InditexTech/gh-sherpa",
+		DefaultBranchRef: "main",
+	}
+
+	t.Run("should plan to use the configured fork", func(t *testing.T) {
+		forkProvider := &mockForkProvider{
+			isRepositoryFork: true,
+			remoteConfiguration: map[string]string{
+				"origin":   "https://github.com/user/gh-sherpa.git",
+				"upstream": "https://github.com/InditexTech/gh-sherpa.git",
+			},
+		}
+		manager := NewManager(domain.ForkConfiguration{}, &mockRepositoryProvider{repo: repo}, &mockGitProvider{}, &mockUserInteractionProvider{}, forkProvider)
+
+		forkName, create, err := manager.PlanFork(context.Background(), "")
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if forkName != "user/gh-sherpa" || create {
+			t.Errorf("Expected to use the fork user/gh-sherpa, got %s (create: %v)", forkName, create)
+		}
+	})
+
+	t.Run("should plan to create the fork in the default organization without creating it", func(t *testing.T) {
+		forkProvider := &mockForkProvider{
+			remoteConfiguration: map[string]string{},
+			createForkError:     errors.New("the fork must not be created"),
+		}
+		cfg := domain.ForkConfiguration{DefaultOrganization: "MyOrg"}
+		manager := NewManager(cfg, &mockRepositoryProvider{repo: repo}, &mockGitProvider{}, &mockUserInteractionProvider{}, forkProvider)
+
+		forkName, create, err := manager.PlanFork(context.Background(), "")
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if forkName != "MyOrg/gh-sherpa" || !create {
+			t.Errorf("Expected to create the fork MyOrg/gh-sherpa, got %s (create: %v)", forkName, create)
+		}
+	})
+
+	t.Run("should plan to reuse the existing named fork", func(t *testing.T) {
+		forkProvider := &mockForkProvider{
+			remoteConfiguration: map[string]string{},
+			forkExists:          true,
+		}
+		manager := NewManager(domain.ForkConfiguration{}, &mockRepositoryProvider{repo: repo}, &mockGitProvider{}, &mockUserInteractionProvider{}, forkProvider)
+
+		forkName, create, err := manager.PlanFork(context.Background(), "MyOrg/gh-sherpa")
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if forkName != "MyOrg/gh-sherpa" || create {
+			t.Errorf("Expected to use the fork MyOrg/gh-sherpa, got %s (create: %v)", forkName, create)
+		}
+	})
+}
@@ -0,0 +1,660 @@
+package gh
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/git"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+	"github.com/InditexTech/gh-sherpa/internal/utils"
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/repository"
+)
+
+// API is a GitHub client that calls the REST and GraphQL APIs directly with the credentials
+// of gh, instead of running gh commands
+type API struct {
+	rest    restClient
+	graphQL graphQLClient
+}
+
+var (
+	_ domain.RepositoryProvider  = (*API)(nil)
+	_ domain.PullRequestProvider = (*API)(nil)
+	_ domain.ForkProvider        = (*API)(nil)
+)
+
+type restClient interface {
+	DoWithContext(ctx context.Context, method string, path string, body io.Reader, response interface{}) error
+}
+
+type graphQLClient interface {
+	DoWithContext(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error
+}
+
+// currentRepository returns the repository of the working directory, the one of the base remote
+// unless GH_REPO is set
+var currentRepository = func(ctx context.Context) (repository.Repository, error) {
+	if os.Getenv("GH_REPO") == "" {
+		names := remoteNames(ctx)
+		if baseURL, ok := remoteURLs(ctx, names)[names.Base]; ok {
+			if nameWithOwner := utils.ExtractRepoFromURL(baseURL); nameWithOwner != baseURL {
+				return repository.ParseWithHost(nameWithOwner, utils.GitHubHost())
+			}
+		}
+	}
+
+	return repository.Current()
+}
+
+// NewAPI returns a GitHub API client authenticated as the gh user in the host of the repository
+func NewAPI(ctx context.Context) (*API, error) {
+	host := utils.GitHubHost()
+	if repo, err := currentRepository(ctx); err == nil && repo.Host != "" {
+		host = repo.Host
+	}
+	opts := api.ClientOptions{Host: host, Transport: logging.NewTransport("github", http.DefaultTransport)}
+
+	rest, err := api.NewRESTClient(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create the GitHub REST client: %w", err)
+	}
+
+	graphQL, err := api.NewGraphQLClient(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create the GitHub GraphQL client: %w", err)
+	}
+
+	return &API{rest: rest, graphQL: graphQL}, nil
+}
+
+func (a *API) request(ctx context.Context, method string, path string, body any, response any) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	return wrapAPIError(a.rest.DoWithContext(ctx, method, path, reader, response))
+}
+
+func (a *API) query(ctx context.Context, query string, variables map[string]any, response any) error {
+	return wrapAPIError(a.graphQL.DoWithContext(ctx, query, variables, response))
+}
+
+func (a *API) repository(ctx context.Context) (repository.Repository, error) {
+	repo, err := currentRepository(ctx)
+	if err != nil {
+		return repo, fmt.Errorf("could not find the GitHub repository of the current directory: %w", err)
+	}
+
+	return repo, nil
+}
+
+type apiRepository struct {
+	Name     string
+	FullName string `json:"full_name"`
+	Owner    struct {
+		Login string
+	}
+	DefaultBranch string `json:"default_branch"`
+	Fork          bool
+}
+
+func (a *API) getRepository(ctx context.Context, nameWithOwner string) (*apiRepository, error) {
+	var repo apiRepository
+	if err := a.request(ctx, http.MethodGet, "repos/"+nameWithOwner, nil, &repo); err != nil {
+		return nil, err
+	}
+
+	return &repo, nil
+}
+
+func (a *API) GetRepository(ctx context.Context) (*domain.Repository, error) {
+	current, err := a.repository(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := a.getRepository(ctx, current.Owner+"/"+current.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the repository %s/%s: %w", current.Owner, current.Name, err)
+	}
+
+	return &domain.Repository{
+		Name:             repo.Name,
+		Owner:            repo.Owner.Login,
+		NameWithOwner:    repo.FullName,
+		DefaultBranchRef: repo.DefaultBranch,
+	}, nil
+}
+
+const pullRequestForBranchQuery = `query PullRequestForBranch($owner: String!, $name: String!, $head: String!) {
+	repository(owner: $owner, name: $name) {
+		pullRequests(headRefName: $head, first: 100, orderBy: {field: CREATED_AT, direction: DESC}) {
+			nodes {
+				id
+				number
+				title
+				body
+				state
+				closed
+				isDraft
+				url
+				headRefName
+				headRefOid
+				headRepositoryOwner { login }
+				baseRefName
+				reviewDecision
+				author { login }
+				labels(first: 100) { nodes { id name description color } }
+				commits(last: 1) {
+					nodes {
+						commit {
+							statusCheckRollup {
+								contexts(first: 100) {
+									nodes {
+										... on CheckRun { status conclusion }
+										... on StatusContext { state }
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}`
+
+type apiPullRequest struct {
+	ID             string
+	Number         int64
+	Title          string
+	Body           string
+	State          string
+	Closed         bool
+	IsDraft        bool
+	URL            string
+	HeadRefName    string
+	HeadRefOid     string
+	BaseRefName    string
+	ReviewDecision string
+	Author         struct {
+		Login string
+	}
+	HeadRepositoryOwner *struct {
+		Login string
+	}
+	Labels struct {
+		Nodes []domain.Label
+	}
+	Commits struct {
+		Nodes []struct {
+			Commit struct {
+				StatusCheckRollup *struct {
+					Contexts struct {
+						Nodes []statusCheck
+					}
+				}
+			}
+		}
+	}
+}
+
+func (pr apiPullRequest) toDomain() *domain.PullRequest {
+	var checks []statusCheck
+	for _, node := range pr.Commits.Nodes {
+		if node.Commit.StatusCheckRollup != nil {
+			checks = append(checks, node.Commit.StatusCheckRollup.Contexts.Nodes...)
+		}
+	}
+
+	return &domain.PullRequest{
+		Title:          pr.Title,
+		Number:         pr.Number,
+		State:          pr.State,
+		Closed:         pr.Closed,
+		IsDraft:        pr.IsDraft,
+		Url:            pr.URL,
+		HeadRefName:    pr.HeadRefName,
+		HeadRefOid:     pr.HeadRefOid,
+		BaseRefName:    pr.BaseRefName,
+		Labels:         pr.Labels.Nodes,
+		Body:           pr.Body,
+		ReviewDecision: pr.ReviewDecision,
+		ChecksStatus:   summarizeChecks(checks),
+		Author:         pr.Author.Login,
+	}
+}
+
+// pullRequestForBranch returns the pull request of the branch in the current repository, or nil if it
+// has none. Only the pull requests from the repository the branch is pushed to are taken into account,
+// as other forks may have branches with the same name, and the open one is preferred to the last one.
+func (a *API) pullRequestForBranch(ctx context.Context, branch string) (*apiPullRequest, repository.Repository, error) {
+	repo, err := a.repository(ctx)
+	if err != nil {
+		return nil, repo, err
+	}
+
+	var response struct {
+		Repository struct {
+			PullRequests struct {
+				Nodes []apiPullRequest
+			}
+		}
+	}
+	variables := map[string]any{"owner": repo.Owner, "name": repo.Name, "head": branch}
+	if err := a.query(ctx, pullRequestForBranchQuery, variables, &response); err != nil {
+		return nil, repo, err
+	}
+
+	headOwner := repo.Owner
+	if head, err := formatHeadBranchForFork(ctx, branch); err == nil {
+		if owner, _, found := strings.Cut(head, ":"); found {
+			headOwner = owner
+		}
+	}
+
+	var found *apiPullRequest
+	for i, pr := range response.Repository.PullRequests.Nodes {
+		// The owner is unknown when the repository of the head branch has been deleted
+		if pr.HeadRepositoryOwner == nil || !strings.EqualFold(pr.HeadRepositoryOwner.Login, headOwner) {
+			continue
+		}
+		if pr.State == "OPEN" {
+			return &response.Repository.PullRequests.Nodes[i], repo, nil
+		}
+		if found == nil {
+			found = &response.Repository.PullRequests.Nodes[i]
+		}
+	}
+
+	return found, repo, nil
+}
+
+// existingPullRequest returns the last pull request of the branch, failing if it has none
+func (a *API) existingPullRequest(ctx context.Context, branch string) (*apiPullRequest, repository.Repository, error) {
+	pr, repo, err := a.pullRequestForBranch(ctx, branch)
+	if err != nil {
+		return nil, repo, err
+	}
+	if pr == nil {
+		return nil, repo, &APIError{StatusCode: http.StatusNotFound, Kind: ErrNotFound, Err: fmt.Errorf("no pull requests found for branch %q", branch)}
+	}
+
+	return pr, repo, nil
+}
+
+func (a *API) GetPullRequestForBranch(ctx context.Context, branch string) (*domain.PullRequest, error) {
+	pr, _, err := a.pullRequestForBranch(ctx, branch)
+	if err != nil || pr == nil {
+		return nil, err
+	}
+
+	return pr.toDomain(), nil
+}
+
+// CreatePullRequest creates a pull request. As the API cannot fill the title from the commits
+// like `gh pr create --fill`, the branch name is used when there is no title. If the labels,
+// reviewers or assignees cannot be set, the URL is returned with a domain.PullRequestMetadataError.
+func (a *API) CreatePullRequest(ctx context.Context, title string, body string, baseBranch string, headBranch string, draft bool, labels []string, reviewers []string, assignees []string) (string, error) {
+	repo, err := a.repository(ctx)
+	if err != nil {
+		return "", err
+	}
+	nameWithOwner := repo.Owner + "/" + repo.Name
+
+	head, err := formatHeadBranchForFork(ctx, headBranch)
+	if err != nil {
+		return "", fmt.Errorf("failed to format head branch: %w", err)
+	}
+
+	if baseBranch == "" {
+		apiRepo, err := a.getRepository(ctx, nameWithOwner)
+		if err != nil {
+			return "", err
+		}
+		baseBranch = apiRepo.DefaultBranch
+	}
+
+	if title == "" {
+		title = headBranch
+	}
+
+	var created struct {
+		Number  int64
+		HTMLURL string `json:"html_url"`
+	}
+	request := map[string]any{"title": title, "body": body, "head": head, "base": baseBranch, "draft": draft}
+	if err := a.request(ctx, http.MethodPost, fmt.Sprintf("repos/%s/pulls", nameWithOwner), request, &created); err != nil {
+		return "", fmt.Errorf("could not create the pull request: %w", err)
+	}
+
+	// The pull request already exists, so the rest of the changes are tried even if one of them fails
+	var errs []error
+
+	// Users with only read access cannot set labels
+	if len(labels) > 0 && !isInForkContext(ctx) {
+		if err := a.addLabels(ctx, nameWithOwner, created.Number, labels); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := a.requestReviewers(ctx, nameWithOwner, created.Number, reviewers); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(assignees) > 0 {
+		path := fmt.Sprintf("repos/%s/issues/%d/assignees", nameWithOwner, created.Number)
+		if err := a.request(ctx, http.MethodPost, path, map[string]any{"assignees": assignees}, nil); err != nil {
+			errs = append(errs, fmt.Errorf("could not assign the pull request: %w", err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return created.HTMLURL, &domain.PullRequestMetadataError{URL: created.HTMLURL, Err: errors.Join(errs...)}
+	}
+
+	return created.HTMLURL, nil
+}
+
+func (a *API) addLabels(ctx context.Context, nameWithOwner string, number int64, labels []string) error {
+	path := fmt.Sprintf("repos/%s/issues/%d/labels", nameWithOwner, number)
+	if err := a.request(ctx, http.MethodPost, path, map[string]any{"labels": labels}, nil); err != nil {
+		return fmt.Errorf("could not add the labels to the pull request: %w", err)
+	}
+
+	return nil
+}
+
+// requestReviewers requests the review of the given users and teams, the teams are given as `org/team`
+func (a *API) requestReviewers(ctx context.Context, nameWithOwner string, number int64, reviewers []string) error {
+	if len(reviewers) == 0 {
+		return nil
+	}
+
+	users, teams := []string{}, []string{}
+	for _, reviewer := range reviewers {
+		if _, team, isTeam := strings.Cut(reviewer, "/"); isTeam {
+			teams = append(teams, team)
+		} else {
+			users = append(users, reviewer)
+		}
+	}
+
+	path := fmt.Sprintf("repos/%s/pulls/%d/requested_reviewers", nameWithOwner, number)
+	if err := a.request(ctx, http.MethodPost, path, map[string]any{"reviewers": users, "team_reviewers": teams}, nil); err != nil {
+		return fmt.Errorf("could not request the reviewers of the pull request: %w", err)
+	}
+
+	return nil
+}
+
+// UpdatePullRequestBase changes the base branch of the pull request of the given head branch
+func (a *API) UpdatePullRequestBase(ctx context.Context, headBranch string, baseBranch string) error {
+	pr, repo, err := a.existingPullRequest(ctx, headBranch)
+	if err == nil {
+		path := fmt.Sprintf("repos/%s/%s/pulls/%d", repo.Owner, repo.Name, pr.Number)
+		err = a.request(ctx, http.MethodPatch, path, map[string]any{"base": baseBranch}, nil)
+	}
+	if err != nil {
+		return fmt.Errorf("could not change the base branch of the pull request to %s: %w", baseBranch, err)
+	}
+
+	return nil
+}
+
+// EditPullRequest changes the title, body, labels and reviewers of the pull request of the given head branch.
+// The labels are not changed in fork context, as users with only read access cannot set them.
+func (a *API) EditPullRequest(ctx context.Context, headBranch string, edit domain.PullRequestEdit) error {
+	changeLabels := !isInForkContext(ctx) && (len(edit.AddLabels) > 0 || len(edit.RemoveLabels) > 0)
+	if edit.Title == "" && edit.Body == "" && !changeLabels && len(edit.AddReviewers) == 0 {
+		return nil
+	}
+
+	pr, repo, err := a.existingPullRequest(ctx, headBranch)
+	if err != nil {
+		return fmt.Errorf("could not edit the pull request: %w", err)
+	}
+	nameWithOwner := repo.Owner + "/" + repo.Name
+
+	update := map[string]any{}
+	if edit.Title != "" {
+		update["title"] = edit.Title
+	}
+	if edit.Body != "" {
+		update["body"] = edit.Body
+	}
+	if len(update) > 0 {
+		path := fmt.Sprintf("repos/%s/pulls/%d", nameWithOwner, pr.Number)
+		if err := a.request(ctx, http.MethodPatch, path, update, nil); err != nil {
+			return fmt.Errorf("could not edit the pull request: %w", err)
+		}
+	}
+
+	if changeLabels {
+		if len(edit.AddLabels) > 0 {
+			if err := a.addLabels(ctx, nameWithOwner, pr.Number, edit.AddLabels); err != nil {
+				return err
+			}
+		}
+		for _, label := range edit.RemoveLabels {
+			path := fmt.Sprintf("repos/%s/issues/%d/labels/%s", nameWithOwner, pr.Number, url.PathEscape(label))
+			if err := a.request(ctx, http.MethodDelete, path, nil, nil); err != nil && !errors.Is(err, ErrNotFound) {
+				return fmt.Errorf("could not remove the label %s from the pull request: %w", label, err)
+			}
+		}
+	}
+
+	return a.requestReviewers(ctx, nameWithOwner, pr.Number, edit.AddReviewers)
+}
+
+const requiredChecksQuery = `query RequiredChecks($owner: String!, $name: String!, $number: Int!) {
+	repository(owner: $owner, name: $name) {
+		pullRequest(number: $number) {
+			commits(last: 1) {
+				nodes {
+					commit {
+						statusCheckRollup {
+							contexts(first: 100) {
+								nodes {
+									... on CheckRun { status conclusion isRequired(pullRequestNumber: $number) }
+									... on StatusContext { state isRequired(pullRequestNumber: $number) }
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}`
+
+// GetRequiredChecksStatus returns the summarized status of the checks that are required
+// to merge the pull request of the given head branch
+func (a *API) GetRequiredChecksStatus(ctx context.Context, headBranch string) (domain.ChecksStatus, error) {
+	pr, repo, err := a.existingPullRequest(ctx, headBranch)
+	if err != nil {
+		return "", fmt.Errorf("could not get the required checks of the pull request: %w", err)
+	}
+
+	type requiredStatusCheck struct {
+		statusCheck
+		IsRequired bool
+	}
+	var response struct {
+		Repository struct {
+			PullRequest struct {
+				Commits struct {
+					Nodes []struct {
+						Commit struct {
+							StatusCheckRollup *struct {
+								Contexts struct {
+									Nodes []requiredStatusCheck
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	variables := map[string]any{"owner": repo.Owner, "name": repo.Name, "number": pr.Number}
+	if err := a.query(ctx, requiredChecksQuery, variables, &response); err != nil {
+		return "", fmt.Errorf("could not get the required checks of the pull request: %w", err)
+	}
+
+	checks := []statusCheck{}
+	for _, node := range response.Repository.PullRequest.Commits.Nodes {
+		if node.Commit.StatusCheckRollup == nil {
+			continue
+		}
+		for _, check := range node.Commit.StatusCheckRollup.Contexts.Nodes {
+			if check.IsRequired {
+				checks = append(checks, check.statusCheck)
+			}
+		}
+	}
+
+	return summarizeChecks(checks), nil
+}
+
+const markPullRequestReadyMutation = `mutation MarkPullRequestReady($id: ID!) {
+	markPullRequestReadyForReview(input: {pullRequestId: $id}) { clientMutationId }
+}`
+
+// MarkPullRequestReady marks the draft pull request of the given head branch as ready for review
+func (a *API) MarkPullRequestReady(ctx context.Context, headBranch string) error {
+	pr, _, err := a.existingPullRequest(ctx, headBranch)
+	if err == nil {
+		err = a.query(ctx, markPullRequestReadyMutation, map[string]any{"id": pr.ID}, nil)
+	}
+	if err != nil {
+		return fmt.Errorf("could not mark the pull request as ready for review: %w", err)
+	}
+
+	return nil
+}
+
+func (a *API) IsRepositoryFork(ctx context.Context) (bool, error) {
+	current, err := a.repository(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	repo, err := a.getRepository(ctx, current.Owner+"/"+current.Name)
+	if err != nil {
+		return false, err
+	}
+
+	return repo.Fork, nil
+}
+
+func (a *API) CreateFork(ctx context.Context, forkName string) error {
+	if forkName != "" {
+		exists, err := a.ForkExists(ctx, forkName)
+		if err != nil {
+			fmt.Fprintf(logging.Output(), "Warning: Could not verify if fork exists: %v\n", err)
+		} else if exists {
+			fmt.Fprintf(logging.Output(), "Fork %s already exists, configuring remotes...\n", forkName)
+			if err := a.ConfigureRemotesForExistingFork(ctx, forkName); err != nil {
+				return fmt.Errorf("failed to configure remotes for existing fork: %w", err)
+			}
+			return ForkAlreadyExistsError{ForkName: forkName}
+		}
+	}
+
+	repo, err := a.GetRepository(ctx)
+	if err != nil {
+		return fmt.Errorf("error creating fork: %w", err)
+	}
+
+	request := map[string]any{}
+	if forkName != "" {
+		// The first part of the fork name is always the organization
+		organization, _, _ := strings.Cut(forkName, "/")
+		request["organization"] = organization
+	}
+
+	var fork apiRepository
+	if err := a.request(ctx, http.MethodPost, fmt.Sprintf("repos/%s/forks", repo.NameWithOwner), request, &fork); err != nil {
+		return fmt.Errorf("error creating fork: %w", err)
+	}
+
+	return configureForkRemotes(ctx, fork.FullName, repo.NameWithOwner, a.SetDefaultRepository)
+}
+
+func (a *API) ForkExists(ctx context.Context, forkName string) (bool, error) {
+	if forkName == "" {
+		return false, fmt.Errorf("fork name cannot be empty")
+	}
+
+	if _, err := a.getRepository(ctx, forkName); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// SetDefaultRepository makes the remote of the given repository the default one of the GitHub
+// commands, in the same way as `gh repo set-default`
+func (a *API) SetDefaultRepository(ctx context.Context, repo string) error {
+	remotes := remoteURLs(ctx, remoteNames(ctx))
+
+	defaultRemote := ""
+	for name, remoteURL := range remotes {
+		if strings.EqualFold(utils.ExtractRepoFromURL(remoteURL), repo) {
+			defaultRemote = name
+		}
+	}
+	if defaultRemote == "" {
+		return fmt.Errorf("error setting default repository: there is no remote for the repository %s", repo)
+	}
+
+	for name := range remotes {
+		if name != defaultRemote {
+			// It fails if the remote was not the default one
+			_, _ = executeGitCommand(ctx, "config", "--unset", "remote."+name+".gh-resolved")
+		}
+	}
+
+	if _, err := executeGitCommand(ctx, "config", "remote."+defaultRemote+".gh-resolved", "base"); err != nil {
+		return fmt.Errorf("error setting default repository: %w", err)
+	}
+	git.ForgetRemotes()
+
+	return nil
+}
+
+func (a *API) GetRemoteConfiguration(ctx context.Context) (map[string]string, error) {
+	return remoteConfiguration(ctx)
+}
+
+func (a *API) ConfigureRemotesForExistingFork(ctx context.Context, forkName string) error {
+	repo, err := a.GetRepository(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get repository info: %w", err)
+	}
+
+	if err := configureForkRemotes(ctx, forkName, repo.NameWithOwner, a.SetDefaultRepository); err != nil {
+		return fmt.Errorf("failed to configure remotes for existing fork: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,326 @@
+package gh
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/git"
+	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRequest struct {
+	Method string
+	Path   string
+	Body   string
+}
+
+type fakeRESTClient struct {
+	requests  []fakeRequest
+	responses map[string]string
+	errs      map[string]error
+}
+
+func (f *fakeRESTClient) DoWithContext(_ context.Context, method string, path string, body io.Reader, response interface{}) error {
+	request := fakeRequest{Method: method, Path: path}
+	if body != nil {
+		payload, err := io.ReadAll(body)
+		if err != nil {
+			return err
+		}
+		request.Body = string(payload)
+	}
+	f.requests = append(f.requests, request)
+
+	key := method + " " + path
+	if err := f.errs[key]; err != nil {
+		return err
+	}
+	if payload, ok := f.responses[key]; ok && response != nil {
+		return json.Unmarshal([]byte(payload), response)
+	}
+	return nil
+}
+
+type fakeGraphQLClient struct {
+	variables []map[string]interface{}
+	// responses are returned in order, one for each query
+	responses []string
+	err       error
+}
+
+func (f *fakeGraphQLClient) DoWithContext(_ context.Context, query string, variables map[string]interface{}, response interface{}) error {
+	f.variables = append(f.variables, variables)
+	if f.err != nil {
+		return f.err
+	}
+	if len(f.responses) == 0 || response == nil {
+		return nil
+	}
+	payload := f.responses[0]
+	f.responses = f.responses[1:]
+	return json.Unmarshal([]byte(payload), response)
+}
+
+const apiPullRequestResponse = `{"repository":{"pullRequests":{"nodes":[{
+	"id":"PR_1","number":7,"title":"title","state":"OPEN","isDraft":true,"url":"https://github.com/owner/repo/pull/7",
+	"headRefName":"feature/GH-1","baseRefName":"main","author":{"login":"octocat"},"headRepositoryOwner":{"login":"owner"},
+	"labels":{"nodes":[{"id":"LA_1","name":"kind/bug"}]},
+	"commits":{"nodes":[{"commit":{"statusCheckRollup":{"contexts":{"nodes":[{"status":"COMPLETED","conclusion":"SUCCESS"},{"state":"PENDING"}]}}}}]}
+}]}}}`
+
+func newTestAPI(t *testing.T) (*API, *fakeRESTClient, *fakeGraphQLClient) {
+	originalCurrentRepository := currentRepository
+	originalExecuteGitCommand := executeGitCommand
+	t.Cleanup(func() {
+		currentRepository = originalCurrentRepository
+		executeGitCommand = originalExecuteGitCommand
+	})
+
+	currentRepository = func(_ context.Context) (repository.Repository, error) {
+		return repository.Repository{Host: "github.com", Owner: "owner", Name: "repo"}, nil
+	}
+	git.ForgetRemotes()
+	executeGitCommand = func(_ context.Context, args ...string) (string, error) {
+		if len(args) == 3 && args[2] == "origin" {
+			return "https://github.com/owner/repo.git", nil
+		}
+		return "", errors.New("remote not found")
+	}
+
+	rest := &fakeRESTClient{responses: map[string]string{}, errs: map[string]error{}}
+	graphQL := &fakeGraphQLClient{}
+
+	return &API{rest: rest, graphQL: graphQL}, rest, graphQL
+}
+
+func TestAPI_GetRepository(t *testing.T) {
+	a, rest, _ := newTestAPI(t)
+	rest.responses["GET repos/owner/repo"] = `{"name":"repo","full_name":"owner/repo","owner":{"login":"owner"},"default_branch":"main"}`
+
+	repo, err := a.GetRepository(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, &domain.Repository{Name: "repo", Owner: "owner", NameWithOwner: "owner/repo", DefaultBranchRef: "main"}, repo)
+}
+
+func TestAPI_GetPullRequestForBranch(t *testing.T) {
+	t.Run("Returns nil if there is no pull request", func(t *testing.T) {
+		a, _, graphQL := newTestAPI(t)
+		graphQL.responses = []string{`{"repository":{"pullRequests":{"nodes":[]}}}`}
+
+		pr, err := a.GetPullRequestForBranch(context.Background(), "feature/GH-1")
+
+		assert.NoError(t, err)
+		assert.Nil(t, pr)
+		assert.Equal(t, map[string]interface{}{"owner": "owner", "name": "repo", "head": "feature/GH-1"}, graphQL.variables[0])
+	})
+
+	t.Run("Returns the pull request with summarized checks", func(t *testing.T) {
+		a, _, graphQL := newTestAPI(t)
+		graphQL.responses = []string{apiPullRequestResponse}
+
+		pr, err := a.GetPullRequestForBranch(context.Background(), "feature/GH-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.PullRequest{
+			Title:        "title",
+			Number:       7,
+			State:        "OPEN",
+			IsDraft:      true,
+			Url:          "https://github.com/owner/repo/pull/7",
+			HeadRefName:  "feature/GH-1",
+			BaseRefName:  "main",
+			Labels:       []domain.Label{{Id: "LA_1", Name: "kind/bug"}},
+			ChecksStatus: domain.ChecksStatusPending,
+			Author:       "octocat",
+		}, pr)
+	})
+
+	t.Run("Returns a not found error if the repository does not exist", func(t *testing.T) {
+		a, _, graphQL := newTestAPI(t)
+		graphQL.err = &api.GraphQLError{Errors: []api.GraphQLErrorItem{{Type: "NOT_FOUND", Message: "Could not resolve to a Repository"}}}
+
+		_, err := a.GetPullRequestForBranch(context.Background(), "feature/GH-1")
+
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestAPI_GetPullRequestForBranch_InForkContext(t *testing.T) {
+	a, _, graphQL := newTestAPI(t)
+	git.ForgetRemotes()
+	executeGitCommand = func(_ context.Context, args ...string) (string, error) {
+		if args[0] == "config" && args[1] == "--get-regexp" {
+			return "remote.origin.url https://github.com/user/repo.git\nremote.upstream.url https://github.com/owner/repo.git\n", nil
+		}
+		if len(args) == 3 && args[1] == "get-url" {
+			return "https://github.com/" + map[string]string{"origin": "user", "upstream": "owner"}[args[2]] + "/repo.git", nil
+		}
+		return "", errors.New("unexpected command")
+	}
+	graphQL.responses = []string{`{"repository":{"pullRequests":{"nodes":[
+		{"number":9,"state":"OPEN","headRefName":"feature/GH-1","headRepositoryOwner":{"login":"other"}},
+		{"number":8,"state":"CLOSED","headRefName":"feature/GH-1","headRepositoryOwner":{"login":"user"}},
+		{"number":7,"state":"OPEN","headRefName":"feature/GH-1","headRepositoryOwner":{"login":"user"}},
+		{"number":6,"state":"OPEN","headRefName":"feature/GH-1","headRepositoryOwner":null}
+	]}}}`}
+
+	pr, err := a.GetPullRequestForBranch(context.Background(), "feature/GH-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), pr.Number)
+}
+
+func TestAPI_CreatePullRequest(t *testing.T) {
+	a, rest, _ := newTestAPI(t)
+	rest.responses["POST repos/owner/repo/pulls"] = `{"number":7,"html_url":"https://github.com/owner/repo/pull/7"}`
+
+	prURL, err := a.CreatePullRequest(context.Background(), "title", "body", "main", "feature/GH-1", true, []string{"kind/bug"}, []string{"octocat", "org/team"}, []string{"hubot"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/owner/repo/pull/7", prURL)
+	assert.Equal(t, []fakeRequest{
+		{Method: "POST", Path: "repos/owner/repo/pulls", Body: `{"base":"main","body":"body","draft":true,"head":"feature/GH-1","title":"title"}`},
+		{Method: "POST", Path: "repos/owner/repo/issues/7/labels", Body: `{"labels":["kind/bug"]}`},
+		{Method: "POST", Path: "repos/owner/repo/pulls/7/requested_reviewers", Body: `{"reviewers":["octocat"],"team_reviewers":["team"]}`},
+		{Method: "POST", Path: "repos/owner/repo/issues/7/assignees", Body: `{"assignees":["hubot"]}`},
+	}, rest.requests)
+}
+
+func TestAPI_CreatePullRequest_MetadataFails(t *testing.T) {
+	a, rest, _ := newTestAPI(t)
+	rest.responses["POST repos/owner/repo/pulls"] = `{"number":7,"html_url":"https://github.com/owner/repo/pull/7"}`
+	rest.errs["POST repos/owner/repo/pulls/7/requested_reviewers"] = &api.HTTPError{StatusCode: http.StatusUnprocessableEntity, Message: "Reviews may only be requested from collaborators"}
+
+	prURL, err := a.CreatePullRequest(context.Background(), "title", "body", "main", "feature/GH-1", true, nil, []string{"octocat"}, []string{"hubot"})
+
+	var metadataErr *domain.PullRequestMetadataError
+	require.ErrorAs(t, err, &metadataErr)
+	assert.Equal(t, "https://github.com/owner/repo/pull/7", metadataErr.URL)
+	assert.Equal(t, "https://github.com/owner/repo/pull/7", prURL)
+	assert.ErrorContains(t, err, "could not request the reviewers of the pull request")
+	assert.Equal(t, "repos/owner/repo/issues/7/assignees", rest.requests[len(rest.requests)-1].Path, "the assignees are set even if the reviewers fail")
+}
+
+func TestAPI_EditPullRequest(t *testing.T) {
+	t.Run("Edits the title and labels", func(t *testing.T) {
+		a, rest, graphQL := newTestAPI(t)
+		graphQL.responses = []string{apiPullRequestResponse}
+
+		err := a.EditPullRequest(context.Background(), "feature/GH-1", domain.PullRequestEdit{Title: "new title", RemoveLabels: []string{"kind/bug"}})
+
+		require.NoError(t, err)
+		assert.Equal(t, []fakeRequest{
+			{Method: "PATCH", Path: "repos/owner/repo/pulls/7", Body: `{"title":"new title"}`},
+			{Method: "DELETE", Path: "repos/owner/repo/issues/7/labels/kind%2Fbug"},
+		}, rest.requests)
+	})
+
+	t.Run("Does nothing if there are no changes", func(t *testing.T) {
+		a, rest, graphQL := newTestAPI(t)
+
+		err := a.EditPullRequest(context.Background(), "feature/GH-1", domain.PullRequestEdit{})
+
+		assert.NoError(t, err)
+		assert.Empty(t, rest.requests)
+		assert.Empty(t, graphQL.variables)
+	})
+
+	t.Run("Returns a not found error if the branch has no pull request", func(t *testing.T) {
+		a, _, graphQL := newTestAPI(t)
+		graphQL.responses = []string{`{"repository":{"pullRequests":{"nodes":[]}}}`}
+
+		err := a.EditPullRequest(context.Background(), "feature/GH-1", domain.PullRequestEdit{Title: "new title"})
+
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestAPI_GetRequiredChecksStatus(t *testing.T) {
+	a, _, graphQL := newTestAPI(t)
+	graphQL.responses = []string{
+		apiPullRequestResponse,
+		`{"repository":{"pullRequest":{"commits":{"nodes":[{"commit":{"statusCheckRollup":{"contexts":{"nodes":[
+			{"status":"COMPLETED","conclusion":"SUCCESS","isRequired":true},
+			{"status":"COMPLETED","conclusion":"FAILURE","isRequired":false}
+		]}}}}]}}}}`,
+	}
+
+	status, err := a.GetRequiredChecksStatus(context.Background(), "feature/GH-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.ChecksStatusSuccess, status)
+	assert.Equal(t, int64(7), graphQL.variables[1]["number"])
+}
+
+func TestAPI_ForkExists(t *testing.T) {
+	t.Run("Returns false if the fork does not exist", func(t *testing.T) {
+		a, rest, _ := newTestAPI(t)
+		rest.errs["GET repos/org/repo"] = &api.HTTPError{StatusCode: http.StatusNotFound, Message: "Not Found"}
+
+		exists, err := a.ForkExists(context.Background(), "org/repo")
+
+		assert.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("Returns the error if the request is forbidden", func(t *testing.T) {
+		a, rest, _ := newTestAPI(t)
+		rest.errs["GET repos/org/repo"] = &api.HTTPError{StatusCode: http.StatusForbidden, Message: "Forbidden"}
+
+		_, err := a.ForkExists(context.Background(), "org/repo")
+
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestWrapAPIError(t *testing.T) {
+	rateLimitHeaders := http.Header{}
+	rateLimitHeaders.Set("X-RateLimit-Remaining", "0")
+
+	tests := []struct {
+		name     string
+		err      error
+		wantKind error
+	}{
+		{name: "not found", err: &api.HTTPError{StatusCode: http.StatusNotFound}, wantKind: ErrNotFound},
+		{name: "unauthorized", err: &api.HTTPError{StatusCode: http.StatusUnauthorized}, wantKind: ErrForbidden},
+		{name: "forbidden", err: &api.HTTPError{StatusCode: http.StatusForbidden}, wantKind: ErrForbidden},
+		{name: "primary rate limit", err: &api.HTTPError{StatusCode: http.StatusForbidden, Headers: rateLimitHeaders}, wantKind: ErrRateLimited},
+		{name: "secondary rate limit", err: &api.HTTPError{StatusCode: http.StatusForbidden, Message: "You have exceeded a secondary rate limit"}, wantKind: ErrRateLimited},
+		{name: "too many requests", err: &api.HTTPError{StatusCode: http.StatusTooManyRequests}, wantKind: ErrRateLimited},
+		{name: "GraphQL rate limit", err: &api.GraphQLError{Errors: []api.GraphQLErrorItem{{Type: "RATE_LIMITED"}}}, wantKind: ErrRateLimited},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := wrapAPIError(tt.err)
+
+			assert.ErrorIs(t, err, tt.wantKind)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	t.Run("keeps the errors that are not from the API", func(t *testing.T) {
+		err := errors.New("connection refused")
+
+		assert.Equal(t, err, wrapAPIError(err))
+	})
+
+	t.Run("does not wrap any kind for other status codes", func(t *testing.T) {
+		err := wrapAPIError(&api.HTTPError{StatusCode: http.StatusUnprocessableEntity})
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Nil(t, apiErr.Kind)
+		assert.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode)
+	})
+}
@@ -2,26 +2,35 @@ package gh
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os/exec"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/InditexTech/gh-sherpa/internal/domain"
-	"github.com/InditexTech/gh-sherpa/internal/utils"
+	"github.com/InditexTech/gh-sherpa/internal/git"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
 	"github.com/cli/go-gh/v2"
 )
 
 type Cli struct{}
 
-func (c *Cli) GetRepository() (repo *domain.Repository, err error) {
+func (c *Cli) GetRepository(ctx context.Context) (repo *domain.Repository, err error) {
 	baseCommand := []string{"repo", "view", "--json", "name,owner,nameWithOwner,defaultBranchRef"}
 
-	stdout, stderr, err := gh.Exec(baseCommand...)
+	stdout, stderr, err := execGh(ctx, baseCommand...)
+
+	// The command may leave a message in stderr when it is killed, but the error is the one of the context
+	if err != nil && ctx.Err() != nil {
+		return nil, err
+	}
 
 	if stderr.String() != "" {
-		return nil, errors.New(stderr.String())
+		return nil, cliError(stderr.String())
 	}
 
 	if err != nil {
@@ -51,11 +60,16 @@ func (c *Cli) GetRepository() (repo *domain.Repository, err error) {
 	return
 }
 
-func (c *Cli) Execute(result any, args []string) (err error) {
-	stdout, stderr, err := Execute(args...)
+func (c *Cli) Execute(ctx context.Context, result any, args []string) (err error) {
+	stdout, stderr, err := Execute(ctx, args...)
+
+	// The command may leave a message in stderr when it is killed, but the error is the one of the context
+	if err != nil && ctx.Err() != nil {
+		return err
+	}
 
 	if stderr.String() != "" {
-		return errors.New(stderr.String())
+		return cliError(stderr.String())
 	}
 
 	if err != nil {
@@ -71,36 +85,58 @@ func (c *Cli) Execute(result any, args []string) (err error) {
 	return
 }
 
-var Execute = func(args ...string) (stdout, stderr bytes.Buffer, err error) {
-	return gh.Exec(args...)
+var Execute = func(ctx context.Context, args ...string) (stdout, stderr bytes.Buffer, err error) {
+	return execGh(ctx, args...)
+}
+
+// execGh runs the gh command, tracing it in the debug logs. The process is killed when the context
+// is done, and then the error of the context is returned.
+func execGh(ctx context.Context, args ...string) (stdout, stderr bytes.Buffer, err error) {
+	start := time.Now()
+	stdout, stderr, err = gh.ExecContext(ctx, args...)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		err = fmt.Errorf("gh %s: %w", strings.Join(args, " "), ctxErr)
+	}
+
+	attrs := []any{"args", strings.Join(args, " "), "duration", time.Since(start)}
+	if err != nil {
+		attrs = append(attrs, "error", err.Error(), "stderr", stderr.String())
+	}
+	logging.Logger().Debug("gh command", attrs...)
+
+	return
 }
 
-var ExecuteStringResult = func(args []string) (result string, err error) {
-	stdout, stderr, err := gh.Exec(args...)
+var ExecuteStringResult = func(ctx context.Context, args []string) (result string, err error) {
+	stdout, stderr, err := execGh(ctx, args...)
 	if err != nil {
 
-		err = fmt.Errorf("failed to run GitHub CLI command (%w)\n\nDetails:\n%s", err, stderr.String())
+		err = fmt.Errorf("failed to run GitHub CLI command (%w)\n\nDetails:\n%w", err, cliError(stderr.String()))
 	}
 
 	result = stdout.String()
 	return
 }
 
-// executeGitCommand executes git commands directly using exec.Command
-var executeGitCommand = func(args ...string) (string, error) {
+// executeGitCommand executes git commands directly using exec.CommandContext, so they are killed
+// when the context is done
+var executeGitCommand = func(ctx context.Context, args ...string) (string, error) {
 	// Find the full path to git executable for security
 	gitPath, err := exec.LookPath("git")
 	if err != nil {
 		return "", fmt.Errorf("git executable not found in PATH: %v", err)
 	}
 
-	cmd := exec.Command(gitPath, args...)
+	cmd := exec.CommandContext(ctx, gitPath, args...)
 
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
 	err = cmd.Run()
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return "", fmt.Errorf("failed to run git command 'git %s': %w", strings.Join(args, " "), ctxErr)
+	}
 	if err != nil {
 		return "", fmt.Errorf("failed to run git command 'git %s': %v\nDetails: %s", strings.Join(args, " "), err, stderr.String())
 	}
@@ -108,7 +144,7 @@ var executeGitCommand = func(args ...string) (string, error) {
 	return stdout.String(), nil
 }
 
-func (c *Cli) CreatePullRequest(title string, body string, baseBranch string, headBranch string, draft bool, labels []string, reviewers []string, assignees []string) (prURL string, err error) {
+func (c *Cli) CreatePullRequest(ctx context.Context, title string, body string, baseBranch string, headBranch string, draft bool, labels []string, reviewers []string, assignees []string) (prURL string, err error) {
 	args := []string{"pr", "create"}
 
 	if baseBranch != "" {
@@ -117,7 +153,7 @@ func (c *Cli) CreatePullRequest(title string, body string, baseBranch string, he
 
 	if headBranch != "" {
 		// Check if we're in a fork context and format head branch accordingly
-		formattedHeadBranch, err := c.formatHeadBranchForFork(headBranch)
+		formattedHeadBranch, err := c.formatHeadBranchForFork(ctx, headBranch)
 		if err != nil {
 			return "", fmt.Errorf("failed to format head branch: %w", err)
 		}
@@ -125,8 +161,8 @@ func (c *Cli) CreatePullRequest(title string, body string, baseBranch string, he
 	}
 
 	// In fork context, we need to specify the base repository explicitly
-	if c.isInForkContext() {
-		upstreamRepo, err := c.getUpstreamRepository()
+	if c.isInForkContext(ctx) {
+		upstreamRepo, err := c.getUpstreamRepository(ctx)
 		if err == nil && upstreamRepo != "" {
 			args = append(args, "--repo", upstreamRepo)
 		}
@@ -144,7 +180,7 @@ func (c *Cli) CreatePullRequest(title string, body string, baseBranch string, he
 	}
 
 	// Add labels if not in fork context
-	args = c.addLabelsToArgs(args, labels)
+	args = c.addLabelsToArgs(ctx, args, labels)
 
 	for _, reviewer := range reviewers {
 		args = append(args, "--reviewer", reviewer)
@@ -154,7 +190,7 @@ func (c *Cli) CreatePullRequest(title string, body string, baseBranch string, he
 		args = append(args, "--assignee", assignee)
 	}
 
-	result, err := ExecuteStringResult(args)
+	result, err := ExecuteStringResult(ctx, args)
 
 	if err != nil {
 		return
@@ -166,10 +202,69 @@ func (c *Cli) CreatePullRequest(title string, body string, baseBranch string, he
 	return
 }
 
-func (c *Cli) GetPullRequestForBranch(branchName string) (*domain.PullRequest, error) {
-	command := []string{"pr", "view", branchName, "--json", "closed,number,state,title,url"}
+// UpdatePullRequestBase changes the base branch of the pull request of the given head branch
+func (c *Cli) UpdatePullRequestBase(ctx context.Context, headBranch string, baseBranch string) error {
+	args := append([]string{"pr", "edit"}, c.pullRequestSelector(ctx, headBranch)...)
+	args = append(args, "--base", baseBranch)
+
+	if _, err := ExecuteStringResult(ctx, args); err != nil {
+		return fmt.Errorf("could not change the base branch of the pull request to %s: %w", baseBranch, err)
+	}
+
+	return nil
+}
+
+// EditPullRequest changes the title, body, labels and reviewers of the pull request of the given head branch.
+// The labels are not changed in fork context, as users with only read access cannot set them.
+func (c *Cli) EditPullRequest(ctx context.Context, headBranch string, edit domain.PullRequestEdit) error {
+	args := []string{}
+
+	if edit.Title != "" {
+		args = append(args, "--title", edit.Title)
+	}
+
+	if edit.Body != "" {
+		args = append(args, "--body", edit.Body)
+	}
+
+	if !c.isInForkContext(ctx) {
+		for _, label := range edit.AddLabels {
+			args = append(args, "--add-label", label)
+		}
+		for _, label := range edit.RemoveLabels {
+			args = append(args, "--remove-label", label)
+		}
+	}
+
+	for _, reviewer := range edit.AddReviewers {
+		args = append(args, "--add-reviewer", reviewer)
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	args = append(append([]string{"pr", "edit"}, c.pullRequestSelector(ctx, headBranch)...), args...)
+	if _, err := ExecuteStringResult(ctx, args); err != nil {
+		return fmt.Errorf("could not edit the pull request: %w", err)
+	}
+
+	return nil
+}
+
+// statusCheck is an item of the statusCheckRollup of a pull request. It can be
+// either a check run (status and conclusion) or a commit status context (state).
+type statusCheck struct {
+	Status     string
+	Conclusion string
+	State      string
+}
+
+func (c *Cli) GetPullRequestForBranch(ctx context.Context, branchName string) (*domain.PullRequest, error) {
+	command := append([]string{"pr", "view"}, c.pullRequestSelector(ctx, branchName)...)
+	command = append(command, "--json", "closed,number,state,title,body,labels,url,isDraft,headRefName,headRefOid,baseRefName,reviewDecision,statusCheckRollup,author")
 
-	stdout, stderr, err := gh.Exec(command...)
+	stdout, stderr, err := Execute(ctx, command...)
 	if strings.Contains(stderr.String(), "no pull requests found") {
 		return nil, nil
 	}
@@ -178,7 +273,7 @@ func (c *Cli) GetPullRequestForBranch(branchName string) (*domain.PullRequest, e
 	}
 
 	if stderr.String() != "" {
-		return nil, fmt.Errorf("error while executing the command: %s", stderr.String())
+		return nil, fmt.Errorf("error while executing the command: %w", cliError(stderr.String()))
 	}
 
 	var pr domain.PullRequest
@@ -186,13 +281,108 @@ func (c *Cli) GetPullRequestForBranch(branchName string) (*domain.PullRequest, e
 		return nil, err
 	}
 
+	var extra struct {
+		StatusCheckRollup []statusCheck
+		Author            struct {
+			Login string
+		}
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &extra); err != nil {
+		return nil, err
+	}
+	pr.ChecksStatus = summarizeChecks(extra.StatusCheckRollup)
+	pr.Author = extra.Author.Login
+
 	return &pr, nil
 }
 
-func (c *Cli) IsRepositoryFork() (bool, error) {
+// summarizeChecks reduces all the checks of a pull request to a single status.
+// Any failed check makes the whole status fail, and any unfinished check makes it pending.
+func summarizeChecks(checks []statusCheck) domain.ChecksStatus {
+	if len(checks) == 0 {
+		return domain.ChecksStatusNone
+	}
+
+	status := domain.ChecksStatusSuccess
+	for _, check := range checks {
+		switch {
+		case slices.Contains([]string{"FAILURE", "ERROR", "TIMED_OUT", "CANCELLED", "ACTION_REQUIRED", "STARTUP_FAILURE"}, check.Conclusion),
+			slices.Contains([]string{"FAILURE", "ERROR"}, check.State):
+			return domain.ChecksStatusFailure
+		case check.State == "PENDING" || check.State == "EXPECTED",
+			check.State == "" && check.Status != "COMPLETED":
+			status = domain.ChecksStatusPending
+		}
+	}
+
+	return status
+}
+
+// requiredCheck is an item of the output of `gh pr checks --json`
+type requiredCheck struct {
+	Name   string
+	Bucket string
+}
+
+// GetRequiredChecksStatus returns the summarized status of the checks that are required
+// to merge the pull request of the given head branch
+func (c *Cli) GetRequiredChecksStatus(ctx context.Context, headBranch string) (domain.ChecksStatus, error) {
+	args := append([]string{"pr", "checks"}, c.pullRequestSelector(ctx, headBranch)...)
+	stdout, stderr, err := Execute(ctx, append(args, "--required", "--json", "name,bucket")...)
+	if strings.Contains(stderr.String(), "no required checks reported") || strings.Contains(stderr.String(), "no checks reported") {
+		return domain.ChecksStatusNone, nil
+	}
+
+	// The command also fails when some checks are pending or failed, but it prints them anyway
+	if stdout.Len() == 0 {
+		if err == nil {
+			return domain.ChecksStatusNone, nil
+		}
+		return "", fmt.Errorf("could not get the required checks of the pull request: %w\n%s", err, stderr.String())
+	}
+
+	var checks []requiredCheck
+	if err := json.Unmarshal(stdout.Bytes(), &checks); err != nil {
+		return "", err
+	}
+
+	return summarizeRequiredChecks(checks), nil
+}
+
+// summarizeRequiredChecks reduces the required checks of a pull request to a single status
+func summarizeRequiredChecks(checks []requiredCheck) domain.ChecksStatus {
+	if len(checks) == 0 {
+		return domain.ChecksStatusNone
+	}
+
+	status := domain.ChecksStatusSuccess
+	for _, check := range checks {
+		switch check.Bucket {
+		case "fail", "cancel":
+			return domain.ChecksStatusFailure
+		case "pending":
+			status = domain.ChecksStatusPending
+		}
+	}
+
+	return status
+}
+
+// MarkPullRequestReady marks the draft pull request of the given head branch as ready for review
+func (c *Cli) MarkPullRequestReady(ctx context.Context, headBranch string) error {
+	args := append([]string{"pr", "ready"}, c.pullRequestSelector(ctx, headBranch)...)
+
+	if _, err := ExecuteStringResult(ctx, args); err != nil {
+		return fmt.Errorf("could not mark the pull request as ready for review: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Cli) IsRepositoryFork(ctx context.Context) (bool, error) {
 	command := []string{"repo", "view", "--json", "isFork"}
 
-	jsonResult, err := ExecuteStringResult(command)
+	jsonResult, err := ExecuteStringResult(ctx, command)
 	if err != nil {
 		return false, err
 	}
@@ -218,14 +408,14 @@ func (e ForkAlreadyExistsError) Error() string {
 	return fmt.Sprintf("fork %s already exists", e.ForkName)
 }
 
-func (c *Cli) CreateFork(forkName string) error {
+func (c *Cli) CreateFork(ctx context.Context, forkName string) error {
 	if forkName != "" {
-		exists, err := c.ForkExists(forkName)
+		exists, err := c.ForkExists(ctx, forkName)
 		if err != nil {
-			fmt.Printf("Warning: Could not verify if fork exists: %v\n", err)
+			fmt.Fprintf(logging.Output(), "Warning: Could not verify if fork exists: %v\n", err)
 		} else if exists {
-			fmt.Printf("Fork %s already exists, configuring remotes...\n", forkName)
-			if err := c.ConfigureRemotesForExistingFork(forkName); err != nil {
+			fmt.Fprintf(logging.Output(), "Fork %s already exists, configuring remotes...\n", forkName)
+			if err := c.ConfigureRemotesForExistingFork(ctx, forkName); err != nil {
 				return fmt.Errorf("failed to configure remotes for existing fork: %w", err)
 			}
 			return ForkAlreadyExistsError{ForkName: forkName}
@@ -244,7 +434,7 @@ func (c *Cli) CreateFork(forkName string) error {
 		}
 	}
 
-	_, err := ExecuteStringResult(args)
+	_, err := ExecuteStringResult(ctx, args)
 	if err != nil {
 		return fmt.Errorf("error creating fork: %s", err.Error())
 	}
@@ -252,51 +442,30 @@ func (c *Cli) CreateFork(forkName string) error {
 	return nil
 }
 
-func (c *Cli) ConfigureRemotesForExistingFork(forkName string) error {
-	repo, err := c.GetRepository()
+func (c *Cli) ConfigureRemotesForExistingFork(ctx context.Context, forkName string) error {
+	repo, err := c.GetRepository(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get repository info: %w", err)
 	}
 
-	if err := c.ConfigureRemotesForExistingForkAfterRepository(forkName, repo.NameWithOwner); err != nil {
+	if err := c.ConfigureRemotesForExistingForkAfterRepository(ctx, forkName, repo.NameWithOwner); err != nil {
 		return fmt.Errorf("failed to configure remotes for existing fork: %w", err)
 	}
 	return nil
 }
 
-func (c *Cli) ConfigureRemotesForExistingForkAfterRepository(forkName string, repoNameWithOwner string) error {
-	forkURL := fmt.Sprintf("https://github.com/%s.git", forkName)
-	originalURL := fmt.Sprintf("https://github.com/%s.git", repoNameWithOwner)
-
-	if _, err := executeGitCommand("remote", "set-url", "origin", forkURL); err != nil {
-		return fmt.Errorf("failed to set origin to fork: %w", err)
-	}
-
-	if _, err := executeGitCommand("remote", "add", "upstream", originalURL); err != nil {
-		if strings.Contains(err.Error(), "already exists") {
-			if _, err := executeGitCommand("remote", "set-url", "upstream", originalURL); err != nil {
-				return fmt.Errorf("failed to set upstream URL: %w", err)
-			}
-		} else {
-			return fmt.Errorf("failed to add upstream: %w", err)
-		}
-	}
-
-	if err := c.SetDefaultRepository(repoNameWithOwner); err != nil {
-		return fmt.Errorf("failed to set default repository: %w", err)
-	}
-
-	return nil
+func (c *Cli) ConfigureRemotesForExistingForkAfterRepository(ctx context.Context, forkName string, repoNameWithOwner string) error {
+	return configureForkRemotes(ctx, forkName, repoNameWithOwner, c.SetDefaultRepository)
 }
 
-func (c *Cli) ForkExists(forkName string) (bool, error) {
+func (c *Cli) ForkExists(ctx context.Context, forkName string) (bool, error) {
 	if forkName == "" {
 		return false, fmt.Errorf("fork name cannot be empty")
 	}
 
-	args := []string{"repo", "view", forkName, "--json", "name"}
+	args := []string{"repo", "view", qualifiedRepository(forkName), "--json", "name"}
 
-	_, err := ExecuteStringResult(args)
+	_, err := ExecuteStringResult(ctx, args)
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") ||
 			strings.Contains(err.Error(), "Could not resolve to a Repository") {
@@ -308,83 +477,58 @@ func (c *Cli) ForkExists(forkName string) (bool, error) {
 	return true, nil
 }
 
-func (c *Cli) SetDefaultRepository(repo string) error {
-	args := []string{"repo", "set-default", repo}
+func (c *Cli) SetDefaultRepository(ctx context.Context, repo string) error {
+	args := []string{"repo", "set-default", qualifiedRepository(repo)}
 
-	_, err := ExecuteStringResult(args)
+	_, err := ExecuteStringResult(ctx, args)
 	if err != nil {
 		return fmt.Errorf("error setting default repository: %s", err.Error())
 	}
+	git.ForgetRemotes()
 
 	return nil
 }
 
-func (c *Cli) GetRemoteConfiguration() (map[string]string, error) {
-	remotes := make(map[string]string)
-
-	originResult, err := executeGitCommand("remote", "get-url", "origin")
-	if err == nil {
-		remotes["origin"] = strings.TrimSpace(originResult)
-	}
-
-	upstreamResult, err := executeGitCommand("remote", "get-url", "upstream")
-	if err == nil {
-		remotes["upstream"] = strings.TrimSpace(upstreamResult)
-	}
+func (c *Cli) GetRemoteConfiguration(ctx context.Context) (map[string]string, error) {
+	return remoteConfiguration(ctx)
+}
 
-	return remotes, nil
+func (c *Cli) isInForkContext(ctx context.Context) bool {
+	return isInForkContext(ctx)
 }
 
-func (c *Cli) isInForkContext() bool {
-	remotes, err := c.GetRemoteConfiguration()
-	if err != nil {
-		return false
-	}
-	_, hasUpstream := remotes["upstream"]
-	return hasUpstream
+func (c *Cli) getUpstreamRepository(ctx context.Context) (string, error) {
+	return upstreamRepository(ctx)
 }
 
-func (c *Cli) getUpstreamRepository() (string, error) {
-	remotes, err := c.GetRemoteConfiguration()
-	if err != nil {
-		return "", err
-	}
+func (c *Cli) formatHeadBranchForFork(ctx context.Context, headBranch string) (string, error) {
+	return formatHeadBranchForFork(ctx, headBranch)
+}
 
-	upstream, hasUpstream := remotes["upstream"]
-	if !hasUpstream {
-		return "", fmt.Errorf("no upstream remote found")
+// pullRequestSelector returns the arguments that select the pull request of the head branch. In fork
+// context the pull request is in the upstream repository and its head branch in the fork, so they are
+// selected with `--repo <upstream>` and `<fork owner>:<branch>`, as in CreatePullRequest.
+func (c *Cli) pullRequestSelector(ctx context.Context, headBranch string) []string {
+	if !c.isInForkContext(ctx) {
+		return []string{headBranch}
 	}
 
-	return utils.ExtractRepoFromURL(upstream), nil
-}
-
-func (c *Cli) formatHeadBranchForFork(headBranch string) (string, error) {
-	remotes, err := c.GetRemoteConfiguration()
+	head, err := c.formatHeadBranchForFork(ctx, headBranch)
 	if err != nil {
-		return headBranch, nil
+		head = headBranch
 	}
-
-	if _, hasUpstream := remotes["upstream"]; hasUpstream {
-		origin, hasOrigin := remotes["origin"]
-		if !hasOrigin {
-			return headBranch, nil
-		}
-
-		forkRepoName := utils.ExtractRepoFromURL(origin)
-		parts := strings.Split(forkRepoName, "/")
-		if len(parts) >= 1 {
-			forkOwner := parts[0]
-			return fmt.Sprintf("%s:%s", forkOwner, headBranch), nil
-		}
+	args := []string{head}
+	if upstreamRepo, err := c.getUpstreamRepository(ctx); err == nil && upstreamRepo != "" {
+		args = append(args, "--repo", upstreamRepo)
 	}
 
-	return headBranch, nil
+	return args
 }
 
 // addLabelsToArgs adds labels to the command arguments if not in fork context
 // Users with only read access cannot set labels
-func (c *Cli) addLabelsToArgs(args []string, labels []string) []string {
-	if !c.isInForkContext() {
+func (c *Cli) addLabelsToArgs(ctx context.Context, args []string, labels []string) []string {
+	if !c.isInForkContext(ctx) {
 		for _, label := range labels {
 			args = append(args, "-l", label)
 		}
@@ -1,10 +1,14 @@
 package gh
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/git"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -54,18 +58,19 @@ func TestCli_CreatePullRequest(t *testing.T) {
 			originalExecuteGitCommand := executeGitCommand
 			defer func() { executeGitCommand = originalExecuteGitCommand }()
 
-			executeGitCommand = func(args ...string) (result string, err error) {
+			git.ForgetRemotes()
+			executeGitCommand = func(_ context.Context, args ...string) (result string, err error) {
 				// Return empty results to simulate no upstream remote (non-fork scenario)
 				return "", errors.New("remote not found")
 			}
 
 			var executeArgs []string
-			ExecuteStringResult = func(args []string) (result string, err error) {
+			ExecuteStringResult = func(_ context.Context, args []string) (result string, err error) {
 				executeArgs = args
 				return "https://github.com/InditexTech/gh-sherpa/pulls/1\n", nil
 			}
 
-			gotPrURL, err := c.CreatePullRequest(tt.args.title, tt.args.body, tt.args.baseBranch, tt.args.headBranch, tt.args.draft, tt.args.labels, nil, nil)
+			gotPrURL, err := c.CreatePullRequest(context.Background(), tt.args.title, tt.args.body, tt.args.baseBranch, tt.args.headBranch, tt.args.draft, tt.args.labels, nil, nil)
 			if tt.wantErr {
 				assert.Error(t, err)
 				return
@@ -182,7 +187,8 @@ func TestCli_CreatePullRequest_InForkContext(t *testing.T) {
 			originalExecuteGitCommand := executeGitCommand
 			defer func() { executeGitCommand = originalExecuteGitCommand }()
 
-			executeGitCommand = func(args ...string) (result string, err error) {
+			git.ForgetRemotes()
+			executeGitCommand = func(_ context.Context, args ...string) (result string, err error) {
 				// Check if this is a git remote get-url origin command
 				if len(args) >= 3 && args[0] == "remote" && args[1] == "get-url" && args[2] == "origin" {
 					return tt.originResponse, tt.originError
@@ -198,12 +204,12 @@ func TestCli_CreatePullRequest_InForkContext(t *testing.T) {
 			originalExecuteStringResult := ExecuteStringResult
 			defer func() { ExecuteStringResult = originalExecuteStringResult }()
 
-			ExecuteStringResult = func(args []string) (result string, err error) {
+			ExecuteStringResult = func(_ context.Context, args []string) (result string, err error) {
 				executeArgs = args
 				return "https://github.com/InditexTech/gh-sherpa/pulls/1\n", nil
 			}
 
-			gotPrURL, err := c.CreatePullRequest(tt.title, tt.body, tt.baseBranch, tt.headBranch, tt.draft, tt.labels, nil, nil)
+			gotPrURL, err := c.CreatePullRequest(context.Background(), tt.title, tt.body, tt.baseBranch, tt.headBranch, tt.draft, tt.labels, nil, nil)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -367,7 +373,8 @@ func TestCli_formatHeadBranchForFork(t *testing.T) {
 			originalExecuteGitCommand := executeGitCommand
 			defer func() { executeGitCommand = originalExecuteGitCommand }()
 
-			executeGitCommand = func(args ...string) (result string, err error) {
+			git.ForgetRemotes()
+			executeGitCommand = func(_ context.Context, args ...string) (result string, err error) {
 				// Check if this is a git remote get-url origin command
 				if len(args) >= 3 && args[0] == "remote" && args[1] == "get-url" && args[2] == "origin" {
 					return tt.originResponse, tt.originError
@@ -379,7 +386,7 @@ func TestCli_formatHeadBranchForFork(t *testing.T) {
 				return "", errors.New("unexpected command")
 			}
 
-			result, err := c.formatHeadBranchForFork(tt.headBranch)
+			result, err := c.formatHeadBranchForFork(context.Background(), tt.headBranch)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -459,7 +466,8 @@ func TestCli_GetRemoteConfiguration(t *testing.T) {
 			originalExecuteGitCommand := executeGitCommand
 			defer func() { executeGitCommand = originalExecuteGitCommand }()
 
-			executeGitCommand = func(args ...string) (result string, err error) {
+			git.ForgetRemotes()
+			executeGitCommand = func(_ context.Context, args ...string) (result string, err error) {
 				// Check if this is a git remote get-url origin command
 				if len(args) >= 3 && args[0] == "remote" && args[1] == "get-url" && args[2] == "origin" {
 					return tt.originResponse, tt.originError
@@ -471,7 +479,7 @@ func TestCli_GetRemoteConfiguration(t *testing.T) {
 				return "", errors.New("unexpected command")
 			}
 
-			result, err := c.GetRemoteConfiguration()
+			result, err := c.GetRemoteConfiguration(context.Background())
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.expected, result)
@@ -574,7 +582,8 @@ func TestCli_CreateFork(t *testing.T) {
 			originalExecuteGitCommand := executeGitCommand
 			defer func() { executeGitCommand = originalExecuteGitCommand }()
 
-			executeGitCommand = func(args ...string) (result string, err error) {
+			git.ForgetRemotes()
+			executeGitCommand = func(_ context.Context, args ...string) (result string, err error) {
 				// Mock git remote commands for ConfigureRemotesForExistingFork
 				if len(args) >= 2 && args[0] == "remote" && args[1] == "set-url" {
 					return "", nil
@@ -586,7 +595,7 @@ func TestCli_CreateFork(t *testing.T) {
 			}
 
 			callCount := 0
-			ExecuteStringResult = func(args []string) (result string, err error) {
+			ExecuteStringResult = func(_ context.Context, args []string) (result string, err error) {
 				callCount++
 				// Handle ForkExists check (repo view) - only when forkName is not empty
 				if tt.forkName != "" && len(args) >= 2 && args[0] == "repo" && args[1] == "view" {
@@ -600,7 +609,7 @@ func TestCli_CreateFork(t *testing.T) {
 				return "", fmt.Errorf("unexpected call: %v", args)
 			}
 
-			err := c.CreateFork(tt.forkName)
+			err := c.CreateFork(context.Background(), tt.forkName)
 
 			// Verify the arguments passed to ExecuteStringResult for fork creation
 			assert.Equal(t, tt.expectedArgs, capturedArgs)
@@ -666,12 +675,12 @@ func TestCli_SetDefaultRepository(t *testing.T) {
 			originalExecuteStringResult := ExecuteStringResult
 			defer func() { ExecuteStringResult = originalExecuteStringResult }()
 
-			ExecuteStringResult = func(args []string) (result string, err error) {
+			ExecuteStringResult = func(_ context.Context, args []string) (result string, err error) {
 				capturedArgs = args
 				return tt.mockResult, tt.mockError
 			}
 
-			err := c.SetDefaultRepository(tt.repo)
+			err := c.SetDefaultRepository(context.Background(), tt.repo)
 
 			// Verify the arguments passed to ExecuteStringResult
 			assert.Equal(t, tt.expectedArgs, capturedArgs)
@@ -753,12 +762,12 @@ func TestCli_IsRepositoryFork(t *testing.T) {
 			originalExecuteStringResult := ExecuteStringResult
 			defer func() { ExecuteStringResult = originalExecuteStringResult }()
 
-			ExecuteStringResult = func(args []string) (result string, err error) {
+			ExecuteStringResult = func(_ context.Context, args []string) (result string, err error) {
 				capturedArgs = args
 				return tt.mockResult, tt.mockError
 			}
 
-			isFork, err := c.IsRepositoryFork()
+			isFork, err := c.IsRepositoryFork(context.Background())
 
 			// Verify the arguments passed to ExecuteStringResult
 			assert.Equal(t, tt.expectedArgs, capturedArgs)
@@ -781,7 +790,8 @@ func TestGetRemoteConfiguration_Logic(t *testing.T) {
 	originalExecuteGitCommand := executeGitCommand
 	defer func() { executeGitCommand = originalExecuteGitCommand }()
 
-	executeGitCommand = func(args ...string) (result string, err error) {
+	git.ForgetRemotes()
+	executeGitCommand = func(_ context.Context, args ...string) (result string, err error) {
 		if len(args) >= 3 && args[0] == "remote" && args[1] == "get-url" && args[2] == "origin" {
 			return "https://github.com/user/repo.git\n", nil
 		}
@@ -791,7 +801,7 @@ func TestGetRemoteConfiguration_Logic(t *testing.T) {
 		return "", nil
 	}
 
-	result, err := c.GetRemoteConfiguration()
+	result, err := c.GetRemoteConfiguration(context.Background())
 
 	assert.NoError(t, err)
 	expected := map[string]string{
@@ -924,7 +934,8 @@ func TestCli_getUpstreamRepository(t *testing.T) {
 			originalExecuteGitCommand := executeGitCommand
 			defer func() { executeGitCommand = originalExecuteGitCommand }()
 
-			executeGitCommand = func(args ...string) (result string, err error) {
+			git.ForgetRemotes()
+			executeGitCommand = func(_ context.Context, args ...string) (result string, err error) {
 				// Check if this is a git remote get-url origin command
 				if len(args) >= 3 && args[0] == "remote" && args[1] == "get-url" && args[2] == "origin" {
 					return tt.originResponse, tt.originError
@@ -936,7 +947,7 @@ func TestCli_getUpstreamRepository(t *testing.T) {
 				return "", errors.New("unexpected command")
 			}
 
-			repo, err := c.getUpstreamRepository()
+			repo, err := c.getUpstreamRepository(context.Background())
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -975,7 +986,7 @@ func Test_executeGitCommand(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Execute the actual function (not mocked)
-			result, err := executeGitCommand(tt.args...)
+			result, err := executeGitCommand(context.Background(), tt.args...)
 
 			// Verify results
 			if tt.wantErr {
@@ -992,6 +1003,15 @@ func Test_executeGitCommand(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("Error - cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := executeGitCommand(ctx, "version")
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
 }
 
 func TestCli_ConfigureRemotesForExistingForkAfterRepository(t *testing.T) {
@@ -1087,7 +1107,8 @@ func TestCli_ConfigureRemotesForExistingForkAfterRepository(t *testing.T) {
 			originalExecuteGitCommand := executeGitCommand
 			defer func() { executeGitCommand = originalExecuteGitCommand }()
 
-			executeGitCommand = func(args ...string) (string, error) {
+			git.ForgetRemotes()
+			executeGitCommand = func(_ context.Context, args ...string) (string, error) {
 				// Check if this is setting origin URL
 				if len(args) >= 4 && args[0] == "remote" && args[1] == "set-url" && args[2] == "origin" {
 					return "", tt.originErr
@@ -1110,7 +1131,7 @@ func TestCli_ConfigureRemotesForExistingForkAfterRepository(t *testing.T) {
 			originalExecuteStringResult := ExecuteStringResult
 			defer func() { ExecuteStringResult = originalExecuteStringResult }()
 
-			ExecuteStringResult = func(args []string) (string, error) {
+			ExecuteStringResult = func(_ context.Context, args []string) (string, error) {
 				// Check if this is setting default repository
 				if len(args) >= 2 && args[0] == "repo" && args[1] == "set-default" {
 					assert.Equal(t, tt.repoNameWithOwner, args[2], "SetDefaultRepository called with wrong repo")
@@ -1120,7 +1141,7 @@ func TestCli_ConfigureRemotesForExistingForkAfterRepository(t *testing.T) {
 			}
 
 			// Call the method under test
-			err := c.ConfigureRemotesForExistingForkAfterRepository(tt.forkName, tt.repoNameWithOwner)
+			err := c.ConfigureRemotesForExistingForkAfterRepository(context.Background(), tt.forkName, tt.repoNameWithOwner)
 
 			// Verify results
 			if tt.wantErr {
@@ -1134,3 +1155,315 @@ func TestCli_ConfigureRemotesForExistingForkAfterRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestCli_GetPullRequestForBranch(t *testing.T) {
+	tests := []struct {
+		name         string
+		stdout       string
+		stderr       string
+		mockError    error
+		wantPR       *domain.PullRequest
+		wantErr      bool
+		expectedArgs []string
+	}{
+		{
+			name:   "Returns nil if there is no pull request",
+			stderr: "no pull requests found for branch \"feature/GH-1\"",
+			wantPR: nil,
+		},
+		{
+			name:   "Returns pull request with summarized checks",
+			stdout: `{"number":1,"state":"OPEN","isDraft":true,"reviewDecision":"REVIEW_REQUIRED","statusCheckRollup":[{"status":"COMPLETED","conclusion":"SUCCESS"},{"state":"PENDING"}],"author":{"login":"octocat"}}`,
+			wantPR: &domain.PullRequest{
+				Number:         1,
+				State:          "OPEN",
+				IsDraft:        true,
+				ReviewDecision: "REVIEW_REQUIRED",
+				ChecksStatus:   domain.ChecksStatusPending,
+				Author:         "octocat",
+			},
+		},
+		{
+			name:    "Returns error if stderr is not empty",
+			stderr:  "something went wrong",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Cli{}
+
+			originalExecute := Execute
+			originalExecuteGitCommand := executeGitCommand
+			defer func() {
+				Execute = originalExecute
+				executeGitCommand = originalExecuteGitCommand
+			}()
+			git.ForgetRemotes()
+			executeGitCommand = forkRemotes(false)
+
+			var capturedArgs []string
+			Execute = func(_ context.Context, args ...string) (stdout, stderr bytes.Buffer, err error) {
+				capturedArgs = args
+				stdout.WriteString(tt.stdout)
+				stderr.WriteString(tt.stderr)
+				return stdout, stderr, tt.mockError
+			}
+
+			pr, err := c.GetPullRequestForBranch(context.Background(), "feature/GH-1")
+
+			assert.Equal(t, "feature/GH-1", capturedArgs[2])
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPR, pr)
+		})
+	}
+}
+
+func TestSummarizeChecks(t *testing.T) {
+	t.Run("Returns none if there are no checks", func(t *testing.T) {
+		assert.Equal(t, domain.ChecksStatusNone, summarizeChecks(nil))
+	})
+
+	t.Run("Returns success if all checks succeeded", func(t *testing.T) {
+		checks := []statusCheck{{Status: "COMPLETED", Conclusion: "SUCCESS"}, {State: "SUCCESS"}}
+		assert.Equal(t, domain.ChecksStatusSuccess, summarizeChecks(checks))
+	})
+
+	t.Run("Returns failure if any check failed", func(t *testing.T) {
+		checks := []statusCheck{{Status: "IN_PROGRESS"}, {Status: "COMPLETED", Conclusion: "FAILURE"}}
+		assert.Equal(t, domain.ChecksStatusFailure, summarizeChecks(checks))
+	})
+
+	t.Run("Returns pending if any check is running", func(t *testing.T) {
+		checks := []statusCheck{{Status: "COMPLETED", Conclusion: "SUCCESS"}, {Status: "QUEUED"}}
+		assert.Equal(t, domain.ChecksStatusPending, summarizeChecks(checks))
+	})
+}
+
+func TestCli_EditPullRequest(t *testing.T) {
+	tests := []struct {
+		name         string
+		edit         domain.PullRequestEdit
+		inFork       bool
+		expectedArgs []string
+	}{
+		{
+			name:         "Edits the title, body and labels",
+			edit:         domain.PullRequestEdit{Title: "title", Body: "body", AddLabels: []string{"kind/bug"}, RemoveLabels: []string{"kind/feature"}},
+			expectedArgs: []string{"pr", "edit", "feature/GH-1", "--title", "title", "--body", "body", "--add-label", "kind/bug", "--remove-label", "kind/feature"},
+		},
+		{
+			name:         "Requests the reviewers",
+			edit:         domain.PullRequestEdit{AddReviewers: []string{"octocat", "octo-org/team"}},
+			expectedArgs: []string{"pr", "edit", "feature/GH-1", "--add-reviewer", "octocat", "--add-reviewer", "octo-org/team"},
+		},
+		{
+			name:         "Does not change the labels in fork context",
+			edit:         domain.PullRequestEdit{Title: "title", AddLabels: []string{"kind/bug"}},
+			inFork:       true,
+			expectedArgs: []string{"pr", "edit", "user:feature/GH-1", "--repo", "upstream/repo", "--title", "title"},
+		},
+		{
+			name: "Does nothing if there are no changes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Cli{}
+
+			originalExecuteGitCommand := executeGitCommand
+			originalExecuteStringResult := ExecuteStringResult
+			defer func() {
+				executeGitCommand = originalExecuteGitCommand
+				ExecuteStringResult = originalExecuteStringResult
+			}()
+
+			git.ForgetRemotes()
+			executeGitCommand = forkRemotes(tt.inFork)
+
+			var capturedArgs []string
+			ExecuteStringResult = func(_ context.Context, args []string) (string, error) {
+				capturedArgs = args
+				return "", nil
+			}
+
+			err := c.EditPullRequest(context.Background(), "feature/GH-1", tt.edit)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedArgs, capturedArgs)
+		})
+	}
+}
+
+func TestCli_GetRequiredChecksStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		stdout     string
+		stderr     string
+		mockError  error
+		wantStatus domain.ChecksStatus
+		wantErr    bool
+	}{
+		{
+			name:       "Returns none if there are no required checks",
+			stderr:     "no required checks reported on the 'feature/GH-1' branch",
+			mockError:  errors.New("exit status 1"),
+			wantStatus: domain.ChecksStatusNone,
+		},
+		{
+			name:       "Returns success if all the required checks passed",
+			stdout:     `[{"name":"build","bucket":"pass"},{"name":"lint","bucket":"skipping"}]`,
+			wantStatus: domain.ChecksStatusSuccess,
+		},
+		{
+			name:       "Returns pending if any required check is running",
+			stdout:     `[{"name":"build","bucket":"pass"},{"name":"test","bucket":"pending"}]`,
+			mockError:  errors.New("exit status 8"),
+			wantStatus: domain.ChecksStatusPending,
+		},
+		{
+			name:       "Returns failure if any required check failed",
+			stdout:     `[{"name":"build","bucket":"pending"},{"name":"test","bucket":"fail"}]`,
+			mockError:  errors.New("exit status 1"),
+			wantStatus: domain.ChecksStatusFailure,
+		},
+		{
+			name:      "Returns error if the command fails without output",
+			stderr:    "could not find pull request",
+			mockError: errors.New("exit status 1"),
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Cli{}
+
+			originalExecute := Execute
+			originalExecuteGitCommand := executeGitCommand
+			defer func() {
+				Execute = originalExecute
+				executeGitCommand = originalExecuteGitCommand
+			}()
+			git.ForgetRemotes()
+			executeGitCommand = forkRemotes(false)
+
+			var capturedArgs []string
+			Execute = func(_ context.Context, args ...string) (stdout, stderr bytes.Buffer, err error) {
+				capturedArgs = args
+				stdout.WriteString(tt.stdout)
+				stderr.WriteString(tt.stderr)
+				return stdout, stderr, tt.mockError
+			}
+
+			status, err := c.GetRequiredChecksStatus(context.Background(), "feature/GH-1")
+
+			assert.Equal(t, []string{"pr", "checks", "feature/GH-1", "--required", "--json", "name,bucket"}, capturedArgs)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, status)
+		})
+	}
+}
+
+func TestCli_MarkPullRequestReady(t *testing.T) {
+	tests := []struct {
+		name         string
+		inFork       bool
+		expectedArgs []string
+	}{
+		{
+			name:         "Marks the pull request of the branch as ready",
+			expectedArgs: []string{"pr", "ready", "feature/GH-1"},
+		},
+		{
+			name:         "Marks the pull request of the fork branch in the upstream repository in fork context",
+			inFork:       true,
+			expectedArgs: []string{"pr", "ready", "user:feature/GH-1", "--repo", "upstream/repo"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Cli{}
+
+			originalExecuteGitCommand := executeGitCommand
+			originalExecuteStringResult := ExecuteStringResult
+			defer func() {
+				executeGitCommand = originalExecuteGitCommand
+				ExecuteStringResult = originalExecuteStringResult
+			}()
+
+			git.ForgetRemotes()
+			executeGitCommand = forkRemotes(tt.inFork)
+
+			var capturedArgs []string
+			ExecuteStringResult = func(_ context.Context, args []string) (string, error) {
+				capturedArgs = args
+				return "", nil
+			}
+
+			err := c.MarkPullRequestReady(context.Background(), "feature/GH-1")
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedArgs, capturedArgs)
+		})
+	}
+}
+
+// forkRemotes returns a git runner with the origin remote pointing to the fork user/repo and, in
+// fork context, the upstream remote pointing to upstream/repo
+func forkRemotes(inFork bool) func(ctx context.Context, args ...string) (string, error) {
+	urls := map[string]string{"origin": "https://github.com/user/repo.git"}
+	if inFork {
+		urls["upstream"] = "https://github.com/upstream/repo.git"
+	}
+
+	return func(_ context.Context, args ...string) (string, error) {
+		if len(args) == 3 && args[0] == "remote" && args[1] == "get-url" {
+			if remoteURL, ok := urls[args[2]]; ok {
+				return remoteURL, nil
+			}
+		}
+		return "", errors.New("remote not found")
+	}
+}
+
+func Test_execGh(t *testing.T) {
+	t.Run("should return the error of the context if it is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, _, err := execGh(ctx, "repo", "view")
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestCli_Execute(t *testing.T) {
+	t.Run("should return the error of the context even if there is a message in stderr", func(t *testing.T) {
+		originalExecute := Execute
+		defer func() { Execute = originalExecute }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 0)
+		defer cancel()
+		Execute = func(ctx context.Context, args ...string) (stdout, stderr bytes.Buffer, err error) {
+			stderr.WriteString("error connecting to api.github.com")
+			return stdout, stderr, fmt.Errorf("gh %s: %w", args[0], ctx.Err())
+		}
+
+		err := (&Cli{}).Execute(ctx, nil, []string{"api", "user"})
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, domain.ErrorCodeTimeout, domain.ErrorCodeOf(err))
+	})
+}
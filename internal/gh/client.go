@@ -0,0 +1,40 @@
+package gh
+
+import (
+	"context"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+)
+
+// Kinds of GitHub clients of the github.client setting
+const (
+	ClientCLI = "cli"
+	ClientAPI = "api"
+)
+
+// Client is a GitHub client that implements all the GitHub providers
+type Client interface {
+	domain.RepositoryProvider
+	domain.PullRequestProvider
+	domain.ForkProvider
+}
+
+var (
+	_ Client = (*Cli)(nil)
+	_ Client = (*API)(nil)
+)
+
+// NewClient returns the GitHub client of the given kind. The one running gh commands is returned
+// for the "cli" kind, and also when the API client cannot be created, e.g. when gh has no token.
+func NewClient(ctx context.Context, kind string) Client {
+	if kind == ClientAPI {
+		client, err := NewAPI(ctx)
+		if err == nil {
+			return client
+		}
+		logging.Debugf("Using the gh commands because the GitHub API client could not be created: %s", err)
+	}
+
+	return &Cli{}
+}
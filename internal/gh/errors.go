@@ -0,0 +1,111 @@
+package gh
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/cli/go-gh/v2/pkg/api"
+)
+
+var (
+	// ErrNotFound is returned when the GitHub API does not find the requested resource
+	ErrNotFound = errors.New("not found")
+	// ErrForbidden is returned when the GitHub token is not valid or has not enough permissions
+	ErrForbidden = domain.NewError(domain.ErrorCodeAuthFailed, errors.New("forbidden"))
+	// ErrRateLimited is returned when the GitHub API rate limit has been exceeded
+	ErrRateLimited = domain.ErrRateLimited
+)
+
+// APIError is an error returned by the GitHub API. It wraps ErrNotFound, ErrForbidden or
+// ErrRateLimited when the error is one of them, so it can be checked with errors.Is.
+type APIError struct {
+	StatusCode int
+	Kind       error
+	Err        error
+}
+
+func (e *APIError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *APIError) Unwrap() []error {
+	if e.Kind == nil {
+		return []error{e.Err}
+	}
+	return []error{e.Kind, e.Err}
+}
+
+// wrapAPIError converts the errors of the go-gh REST and GraphQL clients to an APIError
+func wrapAPIError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var httpErr *api.HTTPError
+	if errors.As(err, &httpErr) {
+		return &APIError{StatusCode: httpErr.StatusCode, Kind: httpErrorKind(httpErr), Err: err}
+	}
+
+	var graphQLErr *api.GraphQLError
+	if errors.As(err, &graphQLErr) {
+		var kind error
+		for _, item := range graphQLErr.Errors {
+			switch item.Type {
+			case "NOT_FOUND":
+				kind = ErrNotFound
+			case "FORBIDDEN", "INSUFFICIENT_SCOPES":
+				kind = ErrForbidden
+			case "RATE_LIMITED":
+				kind = ErrRateLimited
+			}
+		}
+		return &APIError{Kind: kind, Err: err}
+	}
+
+	return err
+}
+
+func httpErrorKind(err *api.HTTPError) error {
+	switch err.StatusCode {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusUnauthorized, http.StatusForbidden:
+		// The primary and secondary rate limits are also returned as forbidden
+		if err.Headers.Get("X-RateLimit-Remaining") == "0" || strings.Contains(strings.ToLower(err.Message), "rate limit") {
+			return ErrRateLimited
+		}
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// cliErrorCodes are the messages of the gh CLI that identify the kind of its errors
+var cliErrorCodes = []struct {
+	code     domain.ErrorCode
+	messages []string
+}{
+	// The rate limits are also returned as HTTP 403, so they are checked before the rest of the forbidden errors
+	{domain.ErrorCodeRateLimited, []string{"HTTP 429", "API rate limit exceeded", "secondary rate limit"}},
+	{domain.ErrorCodeAuthFailed, []string{"HTTP 401", "HTTP 403", "gh auth login", "authentication required", "Bad credentials"}},
+	{domain.ErrorCodeNetwork, []string{"error connecting to", "dial tcp", "no such host", "i/o timeout", "connection refused"}},
+}
+
+// cliError returns the error of the gh CLI with the given stderr output, with its code if it is a
+// known kind of error
+func cliError(stderr string) error {
+	err := errors.New(stderr)
+	for _, kind := range cliErrorCodes {
+		for _, message := range kind.messages {
+			if strings.Contains(stderr, message) {
+				return domain.NewError(kind.code, err)
+			}
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,35 @@
+package gh
+
+import (
+	"testing"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCliError(t *testing.T) {
+	tests := []struct {
+		name     string
+		stderr   string
+		wantCode domain.ErrorCode
+	}{
+		{name: "not logged in", stderr: "To get started with GitHub CLI, please run:  gh auth login", wantCode: domain.ErrorCodeAuthFailed},
+		{name: "bad credentials", stderr: "HTTP 401: Bad credentials (https://api.github.com/graphql)", wantCode: domain.ErrorCodeAuthFailed},
+		{name: "rate limit", stderr: "GraphQL: API rate limit exceeded for user ID 1.", wantCode: domain.ErrorCodeRateLimited},
+		{name: "forbidden", stderr: "gh: Resource not accessible by integration (HTTP 403)", wantCode: domain.ErrorCodeAuthFailed},
+		{name: "rate limit as forbidden", stderr: "gh: API rate limit exceeded for user ID 1. (HTTP 403)", wantCode: domain.ErrorCodeRateLimited},
+		{name: "too many requests", stderr: "HTTP 429: Too Many Requests", wantCode: domain.ErrorCodeRateLimited},
+		{name: "no connection", stderr: "error connecting to api.github.com", wantCode: domain.ErrorCodeNetwork},
+		{name: "timeout", stderr: "dial tcp 140.82.121.6:443: i/o timeout", wantCode: domain.ErrorCodeNetwork},
+		{name: "other errors", stderr: "no pull requests found for branch \"main\"", wantCode: domain.ErrorCodeUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cliError(tt.stderr)
+
+			assert.EqualError(t, err, tt.stderr)
+			assert.Equal(t, tt.wantCode, domain.ErrorCodeOf(err))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package gh
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+	"github.com/InditexTech/gh-sherpa/internal/utils"
+	"github.com/cli/go-gh/v2/pkg/auth"
+	"github.com/cli/go-gh/v2/pkg/ssh"
+)
+
+var (
+	// knownHosts returns the hosts gh is authenticated on
+	knownHosts = auth.KnownHosts
+	// defaultHost returns the host gh uses by default, GH_HOST or github.com
+	defaultHost = auth.DefaultHost
+	// resolveSSHHost returns the host name of an alias of the SSH configuration, like github-work
+	resolveSSHHost = func(alias string) string {
+		return ssh.NewTranslator().Translate(&url.URL{Scheme: "ssh", Host: alias}).Hostname()
+	}
+)
+
+// ConfigureHost sets the GitHub host used in the remote URLs and the API calls. It is the configured
+// one if it is set, otherwise the host of the push remote if gh is authenticated on it, or the
+// default host of gh. SSH host aliases of the push remote are recognized as GitHub hosts.
+func ConfigureHost(ctx context.Context, configuredHost string) {
+	utils.AddGitHubHosts(knownHosts()...)
+
+	originURL, _ := executeGitCommand(ctx, "remote", "get-url", remoteNames(ctx).Push)
+	originURL = strings.TrimSpace(originURL)
+	originHost := utils.HostFromURL(originURL)
+
+	isSSH := !strings.HasPrefix(originURL, "http://") && !strings.HasPrefix(originURL, "https://")
+	if originHost != "" && isSSH && !utils.IsGitHubHost(originHost) {
+		if resolved := resolveSSHHost(originHost); resolved != originHost && utils.IsGitHubHost(resolved) {
+			logging.Debugf("The SSH host alias %s of the push remote is the GitHub host %s", originHost, resolved)
+			utils.AddGitHubHosts(originHost)
+			originHost = resolved
+		}
+	}
+
+	if configuredHost != "" {
+		utils.SetGitHubHost(configuredHost)
+		return
+	}
+
+	if utils.IsGitHubHost(originHost) {
+		logging.Debugf("Using the GitHub host %s of the push remote", originHost)
+		utils.SetGitHubHost(originHost)
+		return
+	}
+
+	if host, _ := defaultHost(); host != "" {
+		utils.SetGitHubHost(host)
+	}
+}
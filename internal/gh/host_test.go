@@ -0,0 +1,82 @@
+package gh
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/InditexTech/gh-sherpa/internal/git"
+	"github.com/InditexTech/gh-sherpa/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigureHost(t *testing.T) {
+	setup := func(t *testing.T, originURL string) {
+		originalKnownHosts, originalDefaultHost, originalResolveSSHHost := knownHosts, defaultHost, resolveSSHHost
+		originalExecuteGitCommand := executeGitCommand
+		t.Cleanup(func() {
+			knownHosts, defaultHost, resolveSSHHost = originalKnownHosts, originalDefaultHost, originalResolveSSHHost
+			executeGitCommand = originalExecuteGitCommand
+			utils.SetGitHubHost(utils.DefaultGitHubHost)
+		})
+
+		knownHosts = func() []string { return []string{"github.com", "github.example.com"} }
+		defaultHost = func() (string, string) { return "github.com", "default" }
+		resolveSSHHost = func(alias string) string {
+			if alias == "github-work" {
+				return "github.com"
+			}
+			return alias
+		}
+		git.ForgetRemotes()
+		executeGitCommand = func(_ context.Context, args ...string) (string, error) {
+			if originURL == "" {
+				return "", errors.New("no such remote 'origin'")
+			}
+			return originURL + "\n", nil
+		}
+	}
+
+	t.Run("uses the configured host", func(t *testing.T) {
+		setup(t, "https://github.com/owner/repo.git")
+
+		ConfigureHost(context.Background(), "github.acme.com")
+
+		assert.Equal(t, "github.acme.com", utils.GitHubHost())
+	})
+
+	t.Run("uses the host of the origin remote if gh is logged in to it", func(t *testing.T) {
+		setup(t, "git@github.example.com:owner/repo.git")
+
+		ConfigureHost(context.Background(), "")
+
+		assert.Equal(t, "github.example.com", utils.GitHubHost())
+		assert.Equal(t, "owner/repo", utils.ExtractRepoFromURL("git@github.example.com:owner/repo.git"))
+	})
+
+	t.Run("recognizes the SSH host aliases of GitHub hosts", func(t *testing.T) {
+		setup(t, "git@github-work:owner/repo.git")
+
+		ConfigureHost(context.Background(), "")
+
+		assert.Equal(t, "github.com", utils.GitHubHost())
+		assert.Equal(t, "owner/repo", utils.ExtractRepoFromURL("git@github-work:owner/repo.git"))
+	})
+
+	t.Run("uses the default host of gh otherwise", func(t *testing.T) {
+		setup(t, "git@gitlab.com:owner/repo.git")
+
+		ConfigureHost(context.Background(), "")
+
+		assert.Equal(t, "github.com", utils.GitHubHost())
+	})
+}
+
+func TestQualifiedRepository(t *testing.T) {
+	t.Cleanup(func() { utils.SetGitHubHost(utils.DefaultGitHubHost) })
+
+	assert.Equal(t, "owner/repo", qualifiedRepository("owner/repo"))
+
+	utils.SetGitHubHost("github.example.com")
+	assert.Equal(t, "github.example.com/owner/repo", qualifiedRepository("owner/repo"))
+}
@@ -0,0 +1,253 @@
+package gh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/git"
+	"github.com/InditexTech/gh-sherpa/internal/utils"
+	ghConfig "github.com/cli/go-gh/v2/pkg/config"
+)
+
+const (
+	// GitProtocolHTTPS is the protocol of the remote URLs like https://github.com/owner/repo.git
+	GitProtocolHTTPS = "https"
+	// GitProtocolSSH is the protocol of the remote URLs like git@github.com:owner/repo.git
+	GitProtocolSSH = "ssh"
+)
+
+// gitProtocol is the protocol of the remotes configured for forks, empty to use the one of the origin remote
+var gitProtocol string
+
+// SetGitProtocol sets the protocol of the remotes configured for forks. If it is empty, the protocol
+// of the origin remote is kept, or the git_protocol of gh is used when the origin remote is not a
+// GitHub remote.
+func SetGitProtocol(protocol string) {
+	gitProtocol = protocol
+}
+
+// ghGitProtocol returns the git_protocol setting of gh for the host, as `gh config get git_protocol`
+var ghGitProtocol = func(host string) string {
+	cfg, err := ghConfig.Read()
+	if err != nil {
+		return ""
+	}
+	if protocol, err := cfg.Get([]string{"hosts", host, "git_protocol"}); err == nil && protocol != "" {
+		return protocol
+	}
+	protocol, _ := cfg.Get([]string{"git_protocol"})
+	return protocol
+}
+
+// resolvedRemotes are the push and base remotes and their URLs, resolved the first time they are
+// needed as it takes several git commands
+var resolvedRemotes struct {
+	sync.Mutex
+	generation uint64
+	names      *domain.Remotes
+	urls       map[string]string
+}
+
+// resolveRemotes returns the names of the push and base remotes and the URLs of the ones that exist,
+// resolving them again only when they may have changed since the last time
+func resolveRemotes(ctx context.Context) (domain.Remotes, map[string]string) {
+	resolvedRemotes.Lock()
+	defer resolvedRemotes.Unlock()
+
+	generation := git.RemotesGeneration()
+	if resolvedRemotes.names != nil && resolvedRemotes.generation == generation {
+		return *resolvedRemotes.names, resolvedRemotes.urls
+	}
+
+	names := git.ResolveRemotes(func(args ...string) (string, error) {
+		return executeGitCommand(ctx, args...)
+	})
+	urls := remoteURLs(ctx, names)
+	// The remotes resolved after the context is done are the default ones, so they are not kept
+	if ctx.Err() == nil {
+		resolvedRemotes.generation, resolvedRemotes.names, resolvedRemotes.urls = generation, &names, urls
+	}
+
+	return names, urls
+}
+
+// remoteNames returns the names of the push and base remotes
+func remoteNames(ctx context.Context) domain.Remotes {
+	names, _ := resolveRemotes(ctx)
+	return names
+}
+
+// remoteURLs returns the URLs of the push and base remotes that exist by remote name
+func remoteURLs(ctx context.Context, names domain.Remotes) map[string]string {
+	urls := make(map[string]string)
+	for _, name := range []string{names.Push, names.Base} {
+		if remoteURL, err := executeGitCommand(ctx, "remote", "get-url", name); err == nil {
+			urls[name] = strings.TrimSpace(remoteURL)
+		}
+	}
+
+	return urls
+}
+
+// remoteConfiguration returns the URLs of the push and base remotes that exist. The push remote is
+// returned as "origin" and, when it is not the same remote, the base remote as "upstream".
+func remoteConfiguration(ctx context.Context) (map[string]string, error) {
+	names, urls := resolveRemotes(ctx)
+
+	remotes := make(map[string]string)
+	if pushURL, ok := urls[names.Push]; ok {
+		remotes["origin"] = pushURL
+	}
+	if baseURL, ok := urls[names.Base]; ok && names.IsFork() {
+		remotes["upstream"] = baseURL
+	}
+
+	return remotes, nil
+}
+
+// isInForkContext reports whether the repository is a fork with the original repository as base remote
+func isInForkContext(ctx context.Context) bool {
+	remotes, err := remoteConfiguration(ctx)
+	if err != nil {
+		return false
+	}
+	_, hasUpstream := remotes["upstream"]
+	return hasUpstream
+}
+
+// upstreamRepository returns the owner and name of the repository of the base remote in a fork setup
+func upstreamRepository(ctx context.Context) (string, error) {
+	remotes, err := remoteConfiguration(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	upstream, hasUpstream := remotes["upstream"]
+	if !hasUpstream {
+		return "", fmt.Errorf("no upstream remote found")
+	}
+
+	return utils.ExtractRepoFromURL(upstream), nil
+}
+
+// formatHeadBranchForFork prefixes the head branch with the owner of the fork in fork context
+func formatHeadBranchForFork(ctx context.Context, headBranch string) (string, error) {
+	remotes, err := remoteConfiguration(ctx)
+	if err != nil {
+		return headBranch, nil
+	}
+
+	if _, hasUpstream := remotes["upstream"]; hasUpstream {
+		origin, hasOrigin := remotes["origin"]
+		if !hasOrigin {
+			return headBranch, nil
+		}
+
+		forkRepoName := utils.ExtractRepoFromURL(origin)
+		parts := strings.Split(forkRepoName, "/")
+		if len(parts) >= 1 {
+			forkOwner := parts[0]
+			return fmt.Sprintf("%s:%s", forkOwner, headBranch), nil
+		}
+	}
+
+	return headBranch, nil
+}
+
+// configureForkRemotes points the push remote to the fork and the base remote, upstream if they
+// were the same remote, to the original repository, which becomes the default repository of the
+// GitHub commands
+func configureForkRemotes(ctx context.Context, forkName string, repoNameWithOwner string, setDefaultRepository func(ctx context.Context, repo string) error) error {
+	names := remoteNames(ctx)
+	if !names.IsFork() {
+		names.Base = git.DefaultUpstreamRemote
+	}
+
+	pushURL, _ := executeGitCommand(ctx, "remote", "get-url", names.Push)
+	forkURL := forkRemoteURL(strings.TrimSpace(pushURL), forkName)
+	originalURL := forkRemoteURL(strings.TrimSpace(pushURL), repoNameWithOwner)
+
+	if _, err := executeGitCommand(ctx, "remote", "set-url", names.Push, forkURL); err != nil {
+		return fmt.Errorf("failed to set %s to fork: %w", names.Push, err)
+	}
+
+	if _, err := executeGitCommand(ctx, "remote", "add", names.Base, originalURL); err != nil {
+		if strings.Contains(err.Error(), "already exists") {
+			if _, err := executeGitCommand(ctx, "remote", "set-url", names.Base, originalURL); err != nil {
+				return fmt.Errorf("failed to set %s URL: %w", names.Base, err)
+			}
+		} else {
+			return fmt.Errorf("failed to add %s: %w", names.Base, err)
+		}
+	}
+
+	git.ForgetRemotes()
+
+	if err := setDefaultRepository(ctx, repoNameWithOwner); err != nil {
+		return fmt.Errorf("failed to set default repository: %w", err)
+	}
+
+	return nil
+}
+
+// forkRemoteURL returns the URL of the repository for the fork remotes. It keeps the protocol, user
+// and host alias of the origin remote, e.g. git@github-work:owner/repo.git, unless another protocol
+// is configured.
+func forkRemoteURL(originURL string, nameWithOwner string) string {
+	prefix, originProtocol := remoteURLPrefix(originURL)
+
+	protocol := gitProtocol
+	if protocol == "" {
+		protocol = originProtocol
+	}
+	if protocol == "" {
+		protocol = ghGitProtocol(utils.GitHubHost())
+	}
+
+	if prefix == "" || protocol != originProtocol {
+		if protocol == GitProtocolSSH {
+			prefix = fmt.Sprintf("git@%s:", utils.GitHubHost())
+		} else {
+			prefix = fmt.Sprintf("https://%s/", utils.GitHubHost())
+		}
+	}
+
+	return prefix + nameWithOwner + ".git"
+}
+
+// remoteURLPrefix returns the part of a GitHub remote URL before the repository and its protocol,
+// or empty strings if it is not a GitHub remote URL
+func remoteURLPrefix(remoteURL string) (prefix string, protocol string) {
+	if utils.ExtractRepoFromURL(remoteURL) == remoteURL {
+		return "", ""
+	}
+
+	if strings.Contains(remoteURL, "://") {
+		parsedURL, err := url.Parse(remoteURL)
+		if err != nil {
+			return "", ""
+		}
+		protocol = GitProtocolHTTPS
+		if parsedURL.Scheme == "ssh" {
+			protocol = GitProtocolSSH
+		}
+		parsedURL.Path = "/"
+		return parsedURL.String(), protocol
+	}
+
+	userHost, _, _ := strings.Cut(remoteURL, ":")
+	return userHost + ":", GitProtocolSSH
+}
+
+// qualifiedRepository prefixes the repository with the GitHub host when it is not github.com, as
+// the gh commands use github.com for the repositories given as OWNER/REPO
+func qualifiedRepository(nameWithOwner string) string {
+	if host := utils.GitHubHost(); host != utils.DefaultGitHubHost {
+		return host + "/" + nameWithOwner
+	}
+	return nameWithOwner
+}
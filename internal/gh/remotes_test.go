@@ -0,0 +1,106 @@
+package gh
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/InditexTech/gh-sherpa/internal/git"
+	"github.com/InditexTech/gh-sherpa/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForkRemoteURL(t *testing.T) {
+	originalGhGitProtocol := ghGitProtocol
+	t.Cleanup(func() {
+		ghGitProtocol = originalGhGitProtocol
+		SetGitProtocol("")
+	})
+	ghGitProtocol = func(host string) string { return GitProtocolSSH }
+	utils.AddGitHubHosts("github-work")
+
+	tests := []struct {
+		name       string
+		originURL  string
+		protocol   string
+		wantForkTo string
+	}{
+		{name: "keeps HTTPS origins", originURL: "https://github.com/owner/repo.git", wantForkTo: "https://github.com/user/repo.git"},
+		{name: "keeps SSH origins", originURL: "git@github.com:owner/repo.git", wantForkTo: "git@github.com:user/repo.git"},
+		{name: "keeps the SSH host alias", originURL: "git@github-work:owner/repo.git", wantForkTo: "git@github-work:user/repo.git"},
+		{name: "keeps the SSH URLs with port", originURL: "ssh://git@github.com:22/owner/repo.git", wantForkTo: "ssh://git@github.com:22/user/repo.git"},
+		{name: "uses the configured protocol", originURL: "git@github.com:owner/repo.git", protocol: GitProtocolHTTPS, wantForkTo: "https://github.com/user/repo.git"},
+		{name: "keeps the origin of the configured protocol", originURL: "git@github-work:owner/repo.git", protocol: GitProtocolSSH, wantForkTo: "git@github-work:user/repo.git"},
+		{name: "uses the protocol of gh without a GitHub origin", originURL: "", wantForkTo: "git@github.com:user/repo.git"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetGitProtocol(tt.protocol)
+
+			assert.Equal(t, tt.wantForkTo, forkRemoteURL(tt.originURL, "user/repo"))
+		})
+	}
+}
+
+func TestRemoteConfiguration_CustomRemoteNames(t *testing.T) {
+	originalExecuteGitCommand := executeGitCommand
+	t.Cleanup(func() { executeGitCommand = originalExecuteGitCommand })
+
+	urls := map[string]string{
+		"fork":   "git@github.com:user/repo.git",
+		"source": "git@github.com:owner/repo.git",
+	}
+	git.ForgetRemotes()
+	executeGitCommand = func(_ context.Context, args ...string) (string, error) {
+		if args[0] == "config" && args[1] == "--get-regexp" {
+			return "remote.fork.url " + urls["fork"] + "\nremote.source.url " + urls["source"] + "\nremote.source.gh-resolved base\n", nil
+		}
+		if len(args) == 3 && args[0] == "remote" && args[1] == "get-url" {
+			if remoteURL, ok := urls[args[2]]; ok {
+				return remoteURL + "\n", nil
+			}
+		}
+		return "", errors.New("unexpected command")
+	}
+
+	remotes, err := remoteConfiguration(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"origin": urls["fork"], "upstream": urls["source"]}, remotes)
+
+	head, err := formatHeadBranchForFork(context.Background(), "feature/GH-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "user:feature/GH-1", head)
+}
+
+func TestResolveRemotes_Cached(t *testing.T) {
+	originalExecuteGitCommand := executeGitCommand
+	t.Cleanup(func() { executeGitCommand = originalExecuteGitCommand })
+
+	calls := 0
+	git.ForgetRemotes()
+	executeGitCommand = func(_ context.Context, args ...string) (string, error) {
+		calls++
+		if args[0] == "config" && args[1] == "--get-regexp" {
+			return "remote.origin.url git@github.com:user/repo.git\nremote.upstream.url git@github.com:owner/repo.git\n", nil
+		}
+		if len(args) == 3 && args[0] == "remote" && args[1] == "get-url" {
+			return "git@github.com:" + map[string]string{"origin": "user", "upstream": "owner"}[args[2]] + "/repo.git\n", nil
+		}
+		return "", errors.New("unexpected command")
+	}
+
+	assert.True(t, isInForkContext(context.Background()))
+	resolveCalls := calls
+
+	_, err := formatHeadBranchForFork(context.Background(), "feature/GH-1")
+	assert.NoError(t, err)
+	_, err = upstreamRepository(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, resolveCalls, calls, "the remotes must be resolved only once")
+
+	git.ForgetRemotes()
+	assert.True(t, isInForkContext(context.Background()))
+	assert.Equal(t, 2*resolveCalls, calls, "the remotes must be resolved again after they change")
+}
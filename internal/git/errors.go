@@ -0,0 +1,87 @@
+package git
+
+import (
+	"errors"
+	"strings"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+var (
+	// ErrNotRepository is returned when the working directory is not inside a git repository
+	ErrNotRepository = errors.New("not a git repository")
+	// ErrReferenceNotFound is returned when a branch or reference does not exist
+	ErrReferenceNotFound = errors.New("reference not found")
+	// ErrSSHSigningKeyNotSet is returned when the commits are signed with SSH but there is no key to sign with
+	ErrSSHSigningKeyNotSet = errors.New("commit signing with SSH is enabled (gpg.format=ssh), but no key is set in user.signingkey or gpg.ssh.defaultKeyCommand")
+)
+
+// CommandError is the error of a git command. It wraps ErrNotRepository or ErrReferenceNotFound when
+// the error is one of them, so it can be checked with errors.Is as the ones of go-git.
+type CommandError struct {
+	Stderr string
+	Kind   error
+	Err    error
+}
+
+func (e *CommandError) Error() string {
+	if e.Stderr == "" {
+		return e.Err.Error()
+	}
+	return e.Stderr
+}
+
+func (e *CommandError) Unwrap() []error {
+	if e.Kind == nil {
+		return []error{e.Err}
+	}
+	return []error{e.Kind, e.Err}
+}
+
+// referenceNotFoundMessages are the messages of git when a branch or reference does not exist
+var referenceNotFoundMessages = []string{
+	"unknown revision",
+	"bad revision",
+	"Needed a single revision",
+	"not a valid ref",
+	"couldn't find remote ref",
+	"did not match any file(s) known to git",
+	"invalid reference",
+}
+
+// wrapCommandError converts the error of a git command that wrote stderr to a CommandError
+func wrapCommandError(err error, stderr string) error {
+	commandErr := &CommandError{Stderr: stderr, Err: err}
+
+	switch {
+	case strings.Contains(stderr, "not a git repository"):
+		commandErr.Kind = ErrNotRepository
+	case strings.HasPrefix(stderr, "error: branch '") && strings.Contains(stderr, "' not found"):
+		commandErr.Kind = ErrReferenceNotFound
+	default:
+		for _, message := range referenceNotFoundMessages {
+			if strings.Contains(stderr, message) {
+				commandErr.Kind = ErrReferenceNotFound
+				break
+			}
+		}
+	}
+
+	return commandErr
+}
+
+// wrapGoGitError converts the errors of go-git to the errors of this package, so they can be
+// checked with errors.Is whatever the backend is
+func wrapGoGitError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, gogit.ErrRepositoryNotExists):
+		return errors.Join(ErrNotRepository, err)
+	case errors.Is(err, plumbing.ErrReferenceNotFound), errors.Is(err, plumbing.ErrObjectNotFound):
+		return errors.Join(ErrReferenceNotFound, err)
+	}
+
+	return err
+}
@@ -2,10 +2,13 @@ package git
 
 import (
 	"bytes"
+	"context"
 	"fmt"
-	"os"
 	"os/exec"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/InditexTech/gh-sherpa/internal/domain"
 	"github.com/InditexTech/gh-sherpa/internal/logging"
@@ -13,29 +16,34 @@ import (
 
 const gitBin = "git"
 
-const DRY_RUN_ENV = "SHERPA_DRY_RUN"
-
-type Provider struct{}
+type Provider struct {
+	// remotes are the push and base remotes, resolved the first time they are needed as it takes
+	// several git commands
+	remotes           *domain.Remotes
+	remotesGeneration uint64
+	mu                sync.Mutex
+}
 
 var _ domain.GitProvider = (*Provider)(nil)
 
-var runGitCommand = func(args ...string) (out string, err error) {
+// runGitCommand runs git with the given arguments. The process is killed when the context is done,
+// and then the error of the context is returned.
+var runGitCommand = func(ctx context.Context, args ...string) (out string, err error) {
 	logging.Debugf("Running git command: %s %v", gitBin, strings.Join(args, " "))
 
-	if os.Getenv(DRY_RUN_ENV) != "" {
-		fmt.Printf("DRY RUN: \"%s %v\"\n", gitBin, strings.Join(args, " "))
-		return
-	}
-
-	cmd := exec.Command(gitBin, args...)
+	cmd := exec.CommandContext(ctx, gitBin, args...)
 
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
 	err = cmd.Run()
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		err = fmt.Errorf("git %s: %w", args[0], ctxErr)
+		return
+	}
 	if err != nil {
-		err = fmt.Errorf(stderr.String())
+		err = wrapCommandError(err, stderr.String())
 		return
 	}
 
@@ -43,45 +51,30 @@ var runGitCommand = func(args ...string) (out string, err error) {
 	return
 }
 
-func (p *Provider) BranchExists(branch string) bool {
+func (p *Provider) BranchExists(ctx context.Context, branch string) bool {
 	args := []string{"show-ref", "--verify", "refs/heads/" + branch}
 
-	_, err := runGitCommand(args...)
-
-	if os.Getenv(DRY_RUN_ENV) != "" {
-		return false
-	}
+	_, err := runGitCommand(ctx, args...)
 
 	return err == nil
 }
 
-func (p *Provider) FetchBranchFromOrigin(branch string) (err error) {
-	// In fork context, fetch from upstream to get the latest base branch
-	remote := "origin"
-	if p.hasUpstreamRemote() {
-		remote = "upstream"
-	}
+func (p *Provider) FetchBranchFromOrigin(ctx context.Context, branch string) (err error) {
+	// In fork context, fetch from the original repository to get the latest base branch
+	args := []string{"fetch", p.GetRemotes(ctx).Base, branch}
 
-	args := []string{"fetch", remote, branch}
-
-	_, err = runGitCommand(args...)
+	_, err = runGitCommand(ctx, args...)
 
 	return
 }
 
-func (p *Provider) CheckoutNewBranchFromOrigin(branch string, base string) (err error) {
-	// Check if we're in a fork context (upstream remote exists)
-	remote := "origin"
-	if p.hasUpstreamRemote() {
-		remote = "upstream"
-	}
-
-	args := []string{"checkout", "--no-track", "-b", branch, remote + "/" + base}
+func (p *Provider) CheckoutNewBranchFromOrigin(ctx context.Context, branch string, base string) (err error) {
+	args := []string{"checkout", "--no-track", "-b", branch, p.GetRemotes(ctx).Base + "/" + base}
 
-	_, err = runGitCommand(args...)
+	_, err = runGitCommand(ctx, args...)
 
 	if err != nil {
-		err = fmt.Errorf("failed to checkout the new branch.\n\nDetails:\n%s", err)
+		err = fmt.Errorf("failed to checkout the new branch.\n\nDetails:\n%w", err)
 
 		return
 	}
@@ -89,13 +82,13 @@ func (p *Provider) CheckoutNewBranchFromOrigin(branch string, base string) (err
 	return
 }
 
-func (p *Provider) GetCurrentBranch() (branchName string, err error) {
+func (p *Provider) GetCurrentBranch(ctx context.Context) (branchName string, err error) {
 	args := []string{"rev-parse", "--abbrev-ref", "HEAD"}
 
-	out, err := runGitCommand(args...)
+	out, err := runGitCommand(ctx, args...)
 
 	if err != nil {
-		err = fmt.Errorf("failed to get the current branch.\n\nDetails:\n%s", err)
+		err = fmt.Errorf("failed to get the current branch.\n\nDetails:\n%w", err)
 
 		return
 	}
@@ -108,28 +101,24 @@ func (p *Provider) GetCurrentBranch() (branchName string, err error) {
 	return
 }
 
-func (p *Provider) FindBranch(substring string) (branch string, exists bool) {
+func (p *Provider) FindBranch(ctx context.Context, substring string) (branch string, exists bool) {
 	args := []string{"rev-parse", "--abbrev-ref", "--branches=*" + substring + "*"}
 
-	out, _ := runGitCommand(args...)
+	out, _ := runGitCommand(ctx, args...)
 
 	branch = strings.Split(out, "\n")[0]
 	branch = strings.TrimSpace(branch)
 
-	if os.Getenv(DRY_RUN_ENV) != "" {
-		return "", false
-	}
-
 	return branch, branch != ""
 }
 
-func (p *Provider) CheckoutBranch(branch string) (err error) {
+func (p *Provider) CheckoutBranch(ctx context.Context, branch string) (err error) {
 	args := []string{"checkout", branch}
 
-	_, err = runGitCommand(args...)
+	_, err = runGitCommand(ctx, args...)
 
 	if err != nil {
-		err = fmt.Errorf("failed to checkout the branch.\n\nDetails:\n%s", err)
+		err = fmt.Errorf("failed to checkout the branch.\n\nDetails:\n%w", err)
 
 		return
 	}
@@ -137,20 +126,14 @@ func (p *Provider) CheckoutBranch(branch string) (err error) {
 	return
 }
 
-func (p *Provider) GetCommitsToPush(branch string) ([]string, error) {
+func (p *Provider) GetCommitsToPush(ctx context.Context, branch string) ([]string, error) {
 	commits := []string{}
 
-	// Determine which remote to use based on fork context
-	remote := "origin"
-	if p.hasUpstreamRemote() {
-		// In fork context, we need to check against upstream remote
-		// to determine if there are actual new commits beyond the fork
-		remote = "upstream"
-	}
-
-	args := []string{"log", "--pretty=format:'%h %s'", branch, "--not", "--remotes=" + remote}
+	// In fork context, we need to check against the original repository
+	// to determine if there are actual new commits beyond the fork
+	args := []string{"log", "--pretty=format:'%h %s'", branch, "--not", "--remotes=" + p.GetRemotes(ctx).Base}
 
-	out, err := runGitCommand(args...)
+	out, err := runGitCommand(ctx, args...)
 	if err != nil {
 		return commits, err
 	}
@@ -162,27 +145,39 @@ func (p *Provider) GetCommitsToPush(branch string) ([]string, error) {
 	return commits, nil
 }
 
-func (p *Provider) RemoteBranchExists(branch string) (exists bool) {
-	args := []string{"show-ref", "--verify", "refs/remotes/origin/" + branch}
+func (p *Provider) RemoteBranchExists(ctx context.Context, branch string) (exists bool) {
+	args := []string{"show-ref", "--verify", "refs/remotes/" + p.GetRemotes(ctx).Push + "/" + branch}
 
-	_, err := runGitCommand(args...)
+	_, err := runGitCommand(ctx, args...)
 
 	return err == nil
 }
 
-func (p *Provider) CommitEmpty(message string) (err error) {
-	signing := CommitSigningEnabled()
+// CommitEmpty makes a commit without changes, signed if commit signing is enabled. If signoff is
+// set, the Signed-off-by trailer is added to the message.
+func (p *Provider) CommitEmpty(ctx context.Context, message string, signoff bool) (err error) {
+	// --only leaves out the staged changes, which may have been carried from another branch
+	args := []string{"commit", "--allow-empty", "--only", "-m", message}
 
-	args := []string{"commit", "--allow-empty", "-m", message}
+	if signoff {
+		args = append(args, "--signoff")
+	}
 
-	if signing {
+	switch CommitSigningFormat(ctx) {
+	case "":
+	case SigningFormatSSH:
+		if !sshSigningKeyConfigured(ctx) {
+			return ErrSSHSigningKeyNotSet
+		}
+		args = append(args, "-S")
+	default:
 		args = append(args, "-S")
 	}
 
-	_, err = runGitCommand(args...)
+	_, err = runGitCommand(ctx, args...)
 
 	if err != nil {
-		err = fmt.Errorf("failed to commit.\n\nDetails:\n%s", err)
+		err = fmt.Errorf("failed to commit.\n\nDetails:\n%w", err)
 
 		return
 	}
@@ -190,13 +185,25 @@ func (p *Provider) CommitEmpty(message string) (err error) {
 	return
 }
 
-func (p *Provider) PushBranch(branch string) (err error) {
-	args := []string{"push", "-u", "origin", branch}
+// ResetLastCommit removes the last commit of the current branch, keeping its changes staged
+func (p *Provider) ResetLastCommit(ctx context.Context) (err error) {
+	args := []string{"reset", "--soft", "HEAD~1"}
 
-	_, err = runGitCommand(args...)
+	_, err = runGitCommand(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("failed to reset the last commit.\n\nDetails:\n%w", err)
+	}
+
+	return nil
+}
+
+func (p *Provider) PushBranch(ctx context.Context, branch string) (err error) {
+	args := []string{"push", "-u", p.GetRemotes(ctx).Push, branch}
+
+	_, err = runGitCommand(ctx, args...)
 
 	if err != nil {
-		err = fmt.Errorf("failed to push the branch.\n\nDetails:\n%s", err)
+		err = fmt.Errorf("failed to push the branch.\n\nDetails:\n%w", err)
 
 		return
 	}
@@ -204,18 +211,53 @@ func (p *Provider) PushBranch(branch string) (err error) {
 	return
 }
 
-func CommitSigningEnabled() bool {
-	args := []string{"config", "--get", "commit.gpgsign"}
+// Formats of the commit signatures, as set in the gpg.format setting
+const (
+	SigningFormatOpenPGP = "openpgp"
+	SigningFormatSSH     = "ssh"
+	SigningFormatX509    = "x509"
+)
+
+func CommitSigningEnabled(ctx context.Context) bool {
+	// --type=bool accepts all the boolean values of git, like yes or on
+	args := []string{"config", "--type=bool", "--get", "commit.gpgsign"}
+
+	stdout, err := runGitCommand(ctx, args...)
+
+	return err == nil && strings.TrimSpace(stdout) == "true"
+}
+
+// CommitSigningFormat returns the format of the commit signatures, openpgp unless gpg.format says
+// otherwise, or an empty string if commit signing is disabled
+func CommitSigningFormat(ctx context.Context) string {
+	if !CommitSigningEnabled(ctx) {
+		return ""
+	}
 
-	stdout, err := runGitCommand(args...)
+	stdout, err := runGitCommand(ctx, "config", "--get", "gpg.format")
+	if format := strings.TrimSpace(stdout); err == nil && format != "" {
+		return format
+	}
+
+	return SigningFormatOpenPGP
+}
+
+// sshSigningKeyConfigured reports whether git knows the SSH key to sign with, either from
+// user.signingkey or from the command in gpg.ssh.defaultKeyCommand
+func sshSigningKeyConfigured(ctx context.Context) bool {
+	for _, key := range []string{"user.signingkey", "gpg.ssh.defaultKeyCommand"} {
+		if stdout, err := runGitCommand(ctx, "config", "--get", key); err == nil && strings.TrimSpace(stdout) != "" {
+			return true
+		}
+	}
 
-	return err == nil && strings.Contains(stdout, "true")
+	return false
 }
 
-func (p *Provider) GetRepositoryRoot() (rootPath string, err error) {
+func (p *Provider) GetRepositoryRoot(ctx context.Context) (rootPath string, err error) {
 	args := []string{"rev-parse", "--show-toplevel"}
 
-	out, err := runGitCommand(args...)
+	out, err := runGitCommand(ctx, args...)
 	if err != nil {
 		return "", fmt.Errorf("failed to get repository root: %w", err)
 	}
@@ -223,9 +265,289 @@ func (p *Provider) GetRepositoryRoot() (rootPath string, err error) {
 	return strings.TrimSpace(out), nil
 }
 
-// hasUpstreamRemote checks if the upstream remote exists
-func (p *Provider) hasUpstreamRemote() bool {
-	args := []string{"remote", "get-url", "upstream"}
-	_, err := runGitCommand(args...)
-	return err == nil
+// GetAheadBehind returns the number of commits the branch is ahead and behind the remote base branch
+func (p *Provider) GetAheadBehind(ctx context.Context, branch string, base string) (ahead int, behind int, err error) {
+	remote := p.GetRemotes(ctx).Base
+
+	args := []string{"rev-list", "--left-right", "--count", fmt.Sprintf("%s...%s/%s", branch, remote, base)}
+
+	out, err := runGitCommand(ctx, args...)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to compare the branch %s with %s/%s: %w", branch, remote, base, err)
+	}
+
+	counts := strings.Fields(out)
+	if len(counts) != 2 {
+		return 0, 0, fmt.Errorf("unexpected output comparing the branch %s with %s/%s: %s", branch, remote, base, out)
+	}
+
+	if ahead, err = strconv.Atoi(counts[0]); err != nil {
+		return 0, 0, err
+	}
+
+	if behind, err = strconv.Atoi(counts[1]); err != nil {
+		return 0, 0, err
+	}
+
+	return ahead, behind, nil
+}
+
+// ListBranches returns the local branches and the branches of the push remote,
+// merging the ones that exist in both places
+func (p *Provider) ListBranches(ctx context.Context) ([]domain.Branch, error) {
+	remotePrefix := "refs/remotes/" + p.GetRemotes(ctx).Push + "/"
+
+	args := []string{"for-each-ref", "--format=%(refname)%09%(committerdate:unix)", "refs/heads", strings.TrimSuffix(remotePrefix, "/")}
+
+	out, err := runGitCommand(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the branches.\n\nDetails:\n%w", err)
+	}
+
+	branches := []domain.Branch{}
+	indexes := map[string]int{}
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		ref, date, found := strings.Cut(line, "\t")
+		if !found {
+			continue
+		}
+
+		var name string
+		var remote bool
+		switch {
+		case strings.HasPrefix(ref, "refs/heads/"):
+			name = strings.TrimPrefix(ref, "refs/heads/")
+		case strings.HasPrefix(ref, remotePrefix):
+			name, remote = strings.TrimPrefix(ref, remotePrefix), true
+		default:
+			continue
+		}
+		if name == "HEAD" {
+			continue
+		}
+
+		var lastCommitDate time.Time
+		if seconds, err := strconv.ParseInt(date, 10, 64); err == nil {
+			lastCommitDate = time.Unix(seconds, 0)
+		}
+
+		idx, ok := indexes[name]
+		if !ok {
+			idx = len(branches)
+			indexes[name] = idx
+			branches = append(branches, domain.Branch{Name: name})
+		}
+
+		branch := &branches[idx]
+		if remote {
+			branch.Remote = true
+		} else {
+			branch.Local = true
+		}
+		if lastCommitDate.After(branch.LastCommitDate) {
+			branch.LastCommitDate = lastCommitDate
+		}
+	}
+
+	return branches, nil
+}
+
+// DeleteBranch deletes a local branch. Without force, git refuses to delete it if it has commits
+// that are not merged into its upstream. Forcing it is needed for the branches of squashed or
+// rebased pull requests, as they leave the original commits unmerged.
+func (p *Provider) DeleteBranch(ctx context.Context, branch string, force bool) (err error) {
+	args := []string{"branch", "-d", branch}
+	if force {
+		args = []string{"branch", "-D", branch}
+	}
+
+	_, err = runGitCommand(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete the branch %s.\n\nDetails:\n%w", branch, err)
+	}
+
+	return nil
+}
+
+// DeleteRemoteBranch deletes a branch from the push remote
+func (p *Provider) DeleteRemoteBranch(ctx context.Context, branch string) (err error) {
+	args := []string{"push", p.GetRemotes(ctx).Push, "--delete", branch}
+
+	_, err = runGitCommand(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete the remote branch %s.\n\nDetails:\n%w", branch, err)
+	}
+
+	return nil
+}
+
+// CheckoutRemoteBranch creates a local branch tracking the branch of the push remote
+func (p *Provider) CheckoutRemoteBranch(ctx context.Context, branch string) (err error) {
+	args := []string{"checkout", "--track", p.GetRemotes(ctx).Push + "/" + branch}
+
+	_, err = runGitCommand(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("failed to checkout the remote branch.\n\nDetails:\n%w", err)
+	}
+
+	return nil
+}
+
+// Stash saves the uncommitted changes, including untracked files, with the given message.
+// It returns false if there was nothing to stash.
+func (p *Provider) Stash(ctx context.Context, message string) (stashed bool, err error) {
+	args := []string{"stash", "push", "--include-untracked", "-m", message}
+
+	out, err := runGitCommand(ctx, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to stash the changes.\n\nDetails:\n%w", err)
+	}
+
+	return !strings.Contains(out, "No local changes to save"), nil
+}
+
+// StashPop applies and drops the most recent stash saved with the given message.
+// It returns false if there is no such stash.
+func (p *Provider) StashPop(ctx context.Context, message string) (popped bool, err error) {
+	args := []string{"stash", "list", "--format=%gd%x09%gs"}
+
+	out, err := runGitCommand(ctx, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to list the stashes.\n\nDetails:\n%w", err)
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		ref, subject, found := strings.Cut(line, "\t")
+		// The subject has the form "On <branch>: <message>"
+		if !found || !strings.HasSuffix(subject, ": "+message) {
+			continue
+		}
+
+		if _, err := runGitCommand(ctx, "stash", "pop", ref); err != nil {
+			return false, fmt.Errorf("failed to apply the stash %s.\n\nDetails:\n%w", ref, err)
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// HasUncommittedChanges returns true if the working tree has changes that are not committed,
+// including untracked files
+func (p *Provider) HasUncommittedChanges(ctx context.Context) (dirty bool, err error) {
+	args := []string{"status", "--porcelain"}
+
+	out, err := runGitCommand(ctx, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the status of the working tree.\n\nDetails:\n%w", err)
+	}
+
+	return strings.TrimSpace(out) != "", nil
+}
+
+// GetBranchConfig returns the value of the given key of the branch configuration,
+// or an empty string if it is not set
+func (p *Provider) GetBranchConfig(ctx context.Context, branch string, key string) (value string, err error) {
+	args := []string{"config", "--default", "", "--get", fmt.Sprintf("branch.%s.%s", branch, key)}
+
+	out, err := runGitCommand(ctx, args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the configuration of the branch %s.\n\nDetails:\n%w", branch, err)
+	}
+
+	return strings.TrimSpace(out), nil
+}
+
+// SetBranchConfig sets the value of the given key of the branch configuration
+func (p *Provider) SetBranchConfig(ctx context.Context, branch string, key string, value string) (err error) {
+	args := []string{"config", fmt.Sprintf("branch.%s.%s", branch, key), value}
+
+	_, err = runGitCommand(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("failed to set the configuration of the branch %s.\n\nDetails:\n%w", branch, err)
+	}
+
+	return nil
+}
+
+// ResolveRef returns the hash of the commit the given reference points to
+func (p *Provider) ResolveRef(ctx context.Context, ref string) (sha string, err error) {
+	args := []string{"rev-parse", "--verify", ref + "^{commit}"}
+
+	out, err := runGitCommand(ctx, args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s.\n\nDetails:\n%w", ref, err)
+	}
+
+	return strings.TrimSpace(out), nil
+}
+
+// RebaseOnto moves the commits of the branch that are not in upstream on top of newBase.
+// If the rebase fails, for example because of conflicts, it is aborted so the branch is left untouched.
+func (p *Provider) RebaseOnto(ctx context.Context, branch string, newBase string, upstream string) (err error) {
+	args := []string{"rebase", "--onto", newBase, upstream, branch}
+
+	_, err = runGitCommand(ctx, args...)
+	if err != nil {
+		if _, abortErr := runGitCommand(ctx, "rebase", "--abort"); abortErr != nil {
+			logging.Debugf("failed to abort the rebase of %s: %s", branch, abortErr)
+		}
+		return fmt.Errorf("failed to rebase the branch %s onto %s.\n\nDetails:\n%w", branch, newBase, err)
+	}
+
+	return nil
+}
+
+// ForcePushBranch pushes a rewritten branch to the push remote, refusing to overwrite
+// commits pushed by someone else since the last fetch
+func (p *Provider) ForcePushBranch(ctx context.Context, branch string) (err error) {
+	args := []string{"push", "--force-with-lease", p.GetRemotes(ctx).Push, branch}
+
+	_, err = runGitCommand(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("failed to push the branch.\n\nDetails:\n%w", err)
+	}
+
+	return nil
+}
+
+// GetChangedFiles returns the paths of the files changed in the branch since it diverged from the remote base branch
+func (p *Provider) GetChangedFiles(ctx context.Context, branch string, base string) (files []string, err error) {
+	args := []string{"diff", "--name-only", fmt.Sprintf("%s/%s...%s", p.GetRemotes(ctx).Base, base, branch)}
+
+	out, err := runGitCommand(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the files changed in the branch %s.\n\nDetails:\n%w", branch, err)
+	}
+
+	files = []string{}
+	for _, file := range strings.Split(out, "\n") {
+		if file = strings.TrimSpace(file); file != "" {
+			files = append(files, file)
+		}
+	}
+
+	return files, nil
+}
+
+// GetRemotes returns the names of the push and base remotes
+func (p *Provider) GetRemotes(ctx context.Context) domain.Remotes {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	generation := remotesGeneration.Load()
+	if p.remotes != nil && p.remotesGeneration == generation {
+		return *p.remotes
+	}
+
+	remotes := ResolveRemotes(func(args ...string) (string, error) {
+		return runGitCommand(ctx, args...)
+	})
+	// The remotes resolved after the context is done are the default ones, so they are not kept
+	if ctx.Err() == nil {
+		p.remotes, p.remotesGeneration = &remotes, generation
+	}
+
+	return remotes
 }
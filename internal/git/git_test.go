@@ -1,18 +1,65 @@
 package git
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/InditexTech/gh-sherpa/internal/domain"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+// execGitCommand is the runner executing git, kept before the tests replace it
+var execGitCommand = runGitCommand
+
+func TestRunGitCommand(t *testing.T) {
+	t.Run("should return the error of the context if it is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := execGitCommand(ctx, "status")
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("should return a typed error outside a repository", func(t *testing.T) {
+		chdir(t, t.TempDir())
+
+		_, err := execGitCommand(context.Background(), "status")
+
+		var commandErr *CommandError
+		assert.ErrorAs(t, err, &commandErr)
+		assert.ErrorIs(t, err, ErrNotRepository)
+		assert.Contains(t, err.Error(), "not a git repository")
+	})
+}
+
+func TestGitMissingBranchInBothBackends(t *testing.T) {
+	r := newTestRepository(t)
+	r.commit("Initial commit")
+	chdir(t, r.root)
+	runGitCommand = execGitCommand
+
+	goGitProvider, err := NewGoGitProvider()
+	require.NoError(t, err)
+
+	for name, provider := range map[string]domain.GitProvider{"cli": &Provider{}, "go-git": goGitProvider} {
+		t.Run(name, func(t *testing.T) {
+			_, err := provider.GetCommitsToPush(context.Background(), "missing-branch")
+
+			assert.ErrorIs(t, err, ErrReferenceNotFound)
+		})
+	}
+}
+
 func TestGitCheckoutNewBranchFromOrigin(t *testing.T) {
 	provider := Provider{}
 
 	t.Run("GitCheckoutNewBranchFromOrigin should checkout a new branch from origin when no upstream", func(t *testing.T) {
 		var argsSent []string
-		runGitCommand = func(args ...string) (out string, err error) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
 			// Mock git remote get-url upstream to return error (no upstream)
 			if len(args) >= 3 && args[0] == "remote" && args[1] == "get-url" && args[2] == "upstream" {
 				return "", fmt.Errorf("no such remote")
@@ -22,15 +69,17 @@ func TestGitCheckoutNewBranchFromOrigin(t *testing.T) {
 			return "", nil
 		}
 
-		err := provider.CheckoutNewBranchFromOrigin("my-branch", "main")
+		err := provider.CheckoutNewBranchFromOrigin(context.Background(), "my-branch", "main")
 
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"checkout", "--no-track", "-b", "my-branch", "origin/main"}, argsSent)
 	})
 
 	t.Run("GitCheckoutNewBranchFromOrigin should checkout a new branch from upstream when upstream exists", func(t *testing.T) {
+		// A new provider, as the first one keeps the remotes resolved without upstream
+		provider := Provider{}
 		var argsSent []string
-		runGitCommand = func(args ...string) (out string, err error) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
 			// Mock git remote get-url upstream to return success (upstream exists)
 			if len(args) >= 3 && args[0] == "remote" && args[1] == "get-url" && args[2] == "upstream" {
 				return "https://github.com/upstream/repo.git", nil
@@ -40,14 +89,14 @@ func TestGitCheckoutNewBranchFromOrigin(t *testing.T) {
 			return "", nil
 		}
 
-		err := provider.CheckoutNewBranchFromOrigin("my-branch", "main")
+		err := provider.CheckoutNewBranchFromOrigin(context.Background(), "my-branch", "main")
 
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"checkout", "--no-track", "-b", "my-branch", "upstream/main"}, argsSent)
 	})
 
 	t.Run("GitCheckoutNewBranchFromOrigin should return an error if the branch is not found", func(t *testing.T) {
-		runGitCommand = func(args ...string) (out string, err error) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
 			// Mock git remote get-url upstream to return error (no upstream)
 			if len(args) >= 3 && args[0] == "remote" && args[1] == "get-url" && args[2] == "upstream" {
 				return "", fmt.Errorf("no such remote")
@@ -55,7 +104,7 @@ func TestGitCheckoutNewBranchFromOrigin(t *testing.T) {
 			err = fmt.Errorf("Failed to run Git command (%w)\n\nDetails:\n%s", err, "foo")
 			return
 		}
-		err := provider.CheckoutNewBranchFromOrigin("my-branch", "main")
+		err := provider.CheckoutNewBranchFromOrigin(context.Background(), "my-branch", "main")
 
 		assert.Error(t, err)
 	})
@@ -65,7 +114,7 @@ func TestGitFetchBranchFromOrigin(t *testing.T) {
 	provider := Provider{}
 	t.Run("GitFetchBranchFromOrigin should fetch a branch from origin when no upstream", func(t *testing.T) {
 		var argsSent []string
-		runGitCommand = func(args ...string) (out string, err error) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
 			// Mock git remote get-url upstream to return error (no upstream)
 			if len(args) >= 3 && args[0] == "remote" && args[1] == "get-url" && args[2] == "upstream" {
 				return "", fmt.Errorf("no such remote")
@@ -75,15 +124,17 @@ func TestGitFetchBranchFromOrigin(t *testing.T) {
 			return "", nil
 		}
 
-		err := provider.FetchBranchFromOrigin("my-branch")
+		err := provider.FetchBranchFromOrigin(context.Background(), "my-branch")
 
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"fetch", "origin", "my-branch"}, argsSent)
 	})
 
 	t.Run("GitFetchBranchFromOrigin should fetch a branch from upstream when upstream exists", func(t *testing.T) {
+		// A new provider, as the first one keeps the remotes resolved without upstream
+		provider := Provider{}
 		var argsSent []string
-		runGitCommand = func(args ...string) (out string, err error) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
 			// Mock git remote get-url upstream to return success (upstream exists)
 			if len(args) >= 3 && args[0] == "remote" && args[1] == "get-url" && args[2] == "upstream" {
 				return "https://github.com/upstream/repo.git", nil
@@ -93,7 +144,7 @@ func TestGitFetchBranchFromOrigin(t *testing.T) {
 			return "", nil
 		}
 
-		err := provider.FetchBranchFromOrigin("my-branch")
+		err := provider.FetchBranchFromOrigin(context.Background(), "my-branch")
 
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"fetch", "upstream", "my-branch"}, argsSent)
@@ -101,7 +152,7 @@ func TestGitFetchBranchFromOrigin(t *testing.T) {
 
 	t.Run("GitFetchBranchFromOrigin should return an error if the branch is not found", func(t *testing.T) {
 		var argsSent []string
-		runGitCommand = func(args ...string) (out string, err error) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
 			// Mock git remote get-url upstream to return error (no upstream)
 			if len(args) >= 3 && args[0] == "remote" && args[1] == "get-url" && args[2] == "upstream" {
 				return "", fmt.Errorf("no such remote")
@@ -111,7 +162,7 @@ func TestGitFetchBranchFromOrigin(t *testing.T) {
 			return
 		}
 
-		err := provider.FetchBranchFromOrigin("my-branch")
+		err := provider.FetchBranchFromOrigin(context.Background(), "my-branch")
 
 		assert.Error(t, err)
 		assert.Equal(t, []string{"fetch", "origin", "my-branch"}, argsSent)
@@ -122,23 +173,23 @@ func TestGitCheckoutBranch(t *testing.T) {
 	provider := Provider{}
 	t.Run("GitCheckoutBranch should checkout a branch", func(t *testing.T) {
 		var argsSent []string
-		runGitCommand = func(args ...string) (out string, err error) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
 			argsSent = args
 			return
 		}
 
-		err := provider.CheckoutBranch("my-branch")
+		err := provider.CheckoutBranch(context.Background(), "my-branch")
 
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"checkout", "my-branch"}, argsSent)
 	})
 
 	t.Run("GitCheckoutBranch should return an error if the branch is not found", func(t *testing.T) {
-		runGitCommand = func(args ...string) (out string, err error) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
 			err = fmt.Errorf("Failed to run Git command (%w)\n\nDetails:\n%s", err, "foo")
 			return
 		}
-		err := provider.CheckoutBranch("foo")
+		err := provider.CheckoutBranch(context.Background(), "foo")
 
 		assert.Error(t, err)
 	})
@@ -148,24 +199,24 @@ func TestGitBranchExists(t *testing.T) {
 	provider := Provider{}
 	t.Run("GitBranchExists should return true if the branch exists", func(t *testing.T) {
 		var argsSent []string
-		runGitCommand = func(args ...string) (out string, err error) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
 			argsSent = args
 			return
 		}
 
-		exists := provider.BranchExists("my-branch")
+		exists := provider.BranchExists(context.Background(), "my-branch")
 
 		assert.True(t, exists)
 		assert.Equal(t, []string{"show-ref", "--verify", "refs/heads/my-branch"}, argsSent)
 	})
 
 	t.Run("GitBranchExists should return false if the branch does not exist", func(t *testing.T) {
-		runGitCommand = func(args ...string) (out string, err error) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
 			err = fmt.Errorf("Failed to run Git command (%w)\n\nDetails:\n%s", err, "foo")
 			return
 		}
 
-		exists := provider.BranchExists("foo")
+		exists := provider.BranchExists(context.Background(), "foo")
 
 		assert.False(t, exists)
 	})
@@ -175,12 +226,12 @@ func TestGitPushBranch(t *testing.T) {
 	provider := Provider{}
 	t.Run("GitPush should push the branch to origin", func(t *testing.T) {
 		var argsSent []string
-		runGitCommand = func(args ...string) (out string, err error) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
 			argsSent = args
 			return
 		}
 
-		err := provider.PushBranch("my-branch")
+		err := provider.PushBranch(context.Background(), "my-branch")
 
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"push", "-u", "origin", "my-branch"}, argsSent)
@@ -188,15 +239,383 @@ func TestGitPushBranch(t *testing.T) {
 
 	t.Run("GitPush should return an error if the branch is not found", func(t *testing.T) {
 		var argsSent []string
-		runGitCommand = func(args ...string) (out string, err error) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
 			argsSent = args
 			err = fmt.Errorf("Failed to run Git command (%w)\n\nDetails:\n%s", err, "foo")
 			return
 		}
 
-		err := provider.PushBranch("my-branch")
+		err := provider.PushBranch(context.Background(), "my-branch")
 
 		assert.Error(t, err)
 		assert.Equal(t, []string{"push", "-u", "origin", "my-branch"}, argsSent)
 	})
 }
+
+func TestGitGetAheadBehind(t *testing.T) {
+	provider := Provider{}
+	t.Run("GitGetAheadBehind should count commits against the origin base branch", func(t *testing.T) {
+		var argsSent []string
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			if len(args) >= 3 && args[0] == "remote" && args[1] == "get-url" && args[2] == "upstream" {
+				return "", fmt.Errorf("no such remote")
+			}
+			argsSent = args
+			return "3\t5\n", nil
+		}
+
+		ahead, behind, err := provider.GetAheadBehind(context.Background(), "my-branch", "main")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, ahead)
+		assert.Equal(t, 5, behind)
+		assert.Equal(t, []string{"rev-list", "--left-right", "--count", "my-branch...origin/main"}, argsSent)
+	})
+
+	t.Run("GitGetAheadBehind should return an error if the output is not valid", func(t *testing.T) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			return "", nil
+		}
+
+		_, _, err := provider.GetAheadBehind(context.Background(), "my-branch", "main")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestGitListBranches(t *testing.T) {
+	provider := Provider{}
+	t.Run("GitListBranches should merge local and remote branches", func(t *testing.T) {
+		var argsSent []string
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			argsSent = args
+			return "refs/heads/main\t100\n" +
+				"refs/heads/feature/GH-1-local\t200\n" +
+				"refs/remotes/origin/HEAD\t300\n" +
+				"refs/remotes/origin/main\t300\n" +
+				"refs/remotes/origin/feature/GH-2-remote\t400\n", nil
+		}
+
+		branches, err := provider.ListBranches(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"for-each-ref", "--format=%(refname)%09%(committerdate:unix)", "refs/heads", "refs/remotes/origin"}, argsSent)
+		assert.Equal(t, []domain.Branch{
+			{Name: "main", Local: true, Remote: true, LastCommitDate: time.Unix(300, 0)},
+			{Name: "feature/GH-1-local", Local: true, LastCommitDate: time.Unix(200, 0)},
+			{Name: "feature/GH-2-remote", Remote: true, LastCommitDate: time.Unix(400, 0)},
+		}, branches)
+	})
+
+	t.Run("GitListBranches should return an error if the command fails", func(t *testing.T) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			return "", fmt.Errorf("not a git repository")
+		}
+
+		_, err := provider.ListBranches(context.Background())
+
+		assert.Error(t, err)
+	})
+}
+
+func TestGitDeleteBranch(t *testing.T) {
+	provider := Provider{}
+	t.Run("GitDeleteBranch should force delete the local branch", func(t *testing.T) {
+		var argsSent []string
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			argsSent = args
+			return
+		}
+
+		err := provider.DeleteBranch(context.Background(), "my-branch", true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"branch", "-D", "my-branch"}, argsSent)
+	})
+
+	t.Run("GitDeleteBranch should only delete the local branch if it is merged when it is not forced", func(t *testing.T) {
+		var argsSent []string
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			argsSent = args
+			return
+		}
+
+		err := provider.DeleteBranch(context.Background(), "my-branch", false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"branch", "-d", "my-branch"}, argsSent)
+	})
+
+	t.Run("GitDeleteBranch should keep the error of the git command", func(t *testing.T) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			return "", fmt.Errorf("git branch: %w", context.DeadlineExceeded)
+		}
+
+		err := provider.DeleteBranch(context.Background(), "my-branch", true)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("GitDeleteRemoteBranch should delete the branch from origin", func(t *testing.T) {
+		var argsSent []string
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			argsSent = args
+			return
+		}
+
+		err := provider.DeleteRemoteBranch(context.Background(), "my-branch")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"push", "origin", "--delete", "my-branch"}, argsSent)
+	})
+}
+
+// fakeConfigRunner answers the git config queries with the given settings and records the other commands
+func fakeConfigRunner(settings map[string]string, commands *[][]string) func(_ context.Context, args ...string) (string, error) {
+	return func(_ context.Context, args ...string) (string, error) {
+		if args[0] == "config" {
+			if value, ok := settings[args[len(args)-1]]; ok {
+				return value + "\n", nil
+			}
+			return "", fmt.Errorf("exit status 1")
+		}
+		*commands = append(*commands, args)
+		return "", nil
+	}
+}
+
+func TestGitCommitEmpty(t *testing.T) {
+	provider := Provider{}
+	t.Run("GitCommitEmpty should add the Signed-off-by trailer with signoff", func(t *testing.T) {
+		var commands [][]string
+		runGitCommand = fakeConfigRunner(map[string]string{}, &commands)
+
+		err := provider.CommitEmpty(context.Background(), "chore: GH-1 initial commit", true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"commit", "--allow-empty", "--only", "-m", "chore: GH-1 initial commit", "--signoff"}}, commands)
+	})
+
+	t.Run("GitCommitEmpty should sign the commit if commit signing is enabled", func(t *testing.T) {
+		var commands [][]string
+		runGitCommand = fakeConfigRunner(map[string]string{"commit.gpgsign": "true"}, &commands)
+
+		err := provider.CommitEmpty(context.Background(), "chore: initial commit", false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"commit", "--allow-empty", "--only", "-m", "chore: initial commit", "-S"}}, commands)
+	})
+
+	t.Run("GitCommitEmpty should sign the commit with the SSH key", func(t *testing.T) {
+		var commands [][]string
+		runGitCommand = fakeConfigRunner(map[string]string{
+			"commit.gpgsign":  "true",
+			"gpg.format":      "ssh",
+			"user.signingkey": "~/.ssh/id_ed25519.pub",
+		}, &commands)
+
+		err := provider.CommitEmpty(context.Background(), "chore: initial commit", false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"commit", "--allow-empty", "--only", "-m", "chore: initial commit", "-S"}}, commands)
+	})
+
+	t.Run("GitCommitEmpty should error if there is no SSH key to sign with", func(t *testing.T) {
+		var commands [][]string
+		runGitCommand = fakeConfigRunner(map[string]string{"commit.gpgsign": "true", "gpg.format": "ssh"}, &commands)
+
+		err := provider.CommitEmpty(context.Background(), "chore: initial commit", false)
+
+		assert.ErrorIs(t, err, ErrSSHSigningKeyNotSet)
+		assert.Empty(t, commands)
+	})
+}
+
+func TestCommitSigningFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings map[string]string
+		want     string
+	}{
+		{name: "disabled", settings: map[string]string{"gpg.format": "ssh"}, want: ""},
+		{name: "OpenPGP by default", settings: map[string]string{"commit.gpgsign": "true"}, want: SigningFormatOpenPGP},
+		{name: "SSH", settings: map[string]string{"commit.gpgsign": "true", "gpg.format": "ssh"}, want: SigningFormatSSH},
+		{name: "X.509", settings: map[string]string{"commit.gpgsign": "true", "gpg.format": "x509"}, want: SigningFormatX509},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runGitCommand = fakeConfigRunner(tt.settings, &[][]string{})
+
+			assert.Equal(t, tt.want, CommitSigningFormat(context.Background()))
+		})
+	}
+}
+
+func TestGitResetLastCommit(t *testing.T) {
+	provider := Provider{}
+	t.Run("GitResetLastCommit should remove the last commit keeping its changes", func(t *testing.T) {
+		var argsSent []string
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			argsSent = args
+			return
+		}
+
+		err := provider.ResetLastCommit(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"reset", "--soft", "HEAD~1"}, argsSent)
+	})
+}
+
+func TestGitStash(t *testing.T) {
+	provider := Provider{}
+	t.Run("GitStash should return false if there is nothing to stash", func(t *testing.T) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			return "No local changes to save\n", nil
+		}
+
+		stashed, err := provider.Stash(context.Background(), "sherpa: my-branch")
+
+		assert.NoError(t, err)
+		assert.False(t, stashed)
+	})
+
+	t.Run("GitStashPop should pop the stash with the given message", func(t *testing.T) {
+		var argsSent []string
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			if args[1] == "list" {
+				return "stash@{0}\tOn other: sherpa: other\nstash@{1}\tOn my-branch: sherpa: my-branch\n", nil
+			}
+			argsSent = args
+			return "", nil
+		}
+
+		popped, err := provider.StashPop(context.Background(), "sherpa: my-branch")
+
+		assert.NoError(t, err)
+		assert.True(t, popped)
+		assert.Equal(t, []string{"stash", "pop", "stash@{1}"}, argsSent)
+	})
+
+	t.Run("GitStashPop should return false if there is no stash with the given message", func(t *testing.T) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			return "stash@{0}\tOn other: sherpa: other\n", nil
+		}
+
+		popped, err := provider.StashPop(context.Background(), "sherpa: my-branch")
+
+		assert.NoError(t, err)
+		assert.False(t, popped)
+	})
+}
+
+func TestGitHasUncommittedChanges(t *testing.T) {
+	provider := Provider{}
+	t.Run("GitHasUncommittedChanges should return true if the status is not empty", func(t *testing.T) {
+		var argsSent []string
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			argsSent = args
+			return " M main.go\n?? new.go\n", nil
+		}
+
+		dirty, err := provider.HasUncommittedChanges(context.Background())
+
+		assert.NoError(t, err)
+		assert.True(t, dirty)
+		assert.Equal(t, []string{"status", "--porcelain"}, argsSent)
+	})
+
+	t.Run("GitHasUncommittedChanges should return false if the working tree is clean", func(t *testing.T) {
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			return "", nil
+		}
+
+		dirty, err := provider.HasUncommittedChanges(context.Background())
+
+		assert.NoError(t, err)
+		assert.False(t, dirty)
+	})
+}
+
+func TestGitRebaseOnto(t *testing.T) {
+	provider := Provider{}
+	t.Run("GitRebaseOnto should rebase the branch onto the new base", func(t *testing.T) {
+		var argsSent [][]string
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			argsSent = append(argsSent, args)
+			return "", nil
+		}
+
+		err := provider.RebaseOnto(context.Background(), "feature/GH-2-child", "origin/main", "abc123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"rebase", "--onto", "origin/main", "abc123", "feature/GH-2-child"}}, argsSent)
+	})
+
+	t.Run("GitRebaseOnto should abort the rebase if it fails", func(t *testing.T) {
+		var argsSent [][]string
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			argsSent = append(argsSent, args)
+			if args[1] == "--onto" {
+				return "", fmt.Errorf("CONFLICT (content): Merge conflict in main.go")
+			}
+			return "", nil
+		}
+
+		err := provider.RebaseOnto(context.Background(), "feature/GH-2-child", "origin/main", "abc123")
+
+		assert.ErrorContains(t, err, "Merge conflict in main.go")
+		assert.Equal(t, []string{"rebase", "--abort"}, argsSent[len(argsSent)-1])
+	})
+}
+
+func TestGitGetChangedFiles(t *testing.T) {
+	provider := Provider{}
+	t.Run("GitGetChangedFiles should return the files changed since the base branch", func(t *testing.T) {
+		var argsSent [][]string
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			argsSent = append(argsSent, args)
+			if args[0] == "remote" {
+				return "", fmt.Errorf("error: No such remote 'upstream'")
+			}
+			return "cmd/root.go\ninternal/git/git.go\n", nil
+		}
+
+		files, err := provider.GetChangedFiles(context.Background(), "feature/GH-1-sample", "main")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"cmd/root.go", "internal/git/git.go"}, files)
+		assert.Equal(t, []string{"diff", "--name-only", "origin/main...feature/GH-1-sample"}, argsSent[len(argsSent)-1])
+	})
+}
+
+func TestGitGetRemotes(t *testing.T) {
+	runs := 0
+	runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+		runs++
+		if len(args) >= 3 && args[0] == "remote" && args[1] == "get-url" && args[2] == "upstream" {
+			return "https://github.com/upstream/repo.git", nil
+		}
+		return "", fmt.Errorf("no such remote")
+	}
+	provider := Provider{}
+
+	t.Run("GetRemotes should resolve the remotes only once", func(t *testing.T) {
+		remotes := provider.GetRemotes(context.Background())
+		resolveRuns := runs
+
+		assert.Equal(t, domain.Remotes{Push: "origin", Base: "upstream"}, remotes)
+		assert.Equal(t, remotes, provider.GetRemotes(context.Background()))
+		assert.Equal(t, resolveRuns, runs)
+	})
+
+	t.Run("GetRemotes should resolve the remotes again after they are forgotten", func(t *testing.T) {
+		resolveRuns := runs
+
+		ForgetRemotes()
+		provider.GetRemotes(context.Background())
+
+		assert.Greater(t, runs, resolveRuns)
+	})
+}
@@ -0,0 +1,227 @@
+package git
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// Git backends of the git.backend setting
+const (
+	BackendCLI   = "cli"
+	BackendGoGit = "go-git"
+)
+
+// GoGitProvider is a git provider that reads the repository in process with go-git. The operations
+// that change the repository, like commits and pushes, run git commands as Provider does, so the
+// commit signing and the credentials of git keep working.
+type GoGitProvider struct {
+	Provider
+	repo *gogit.Repository
+}
+
+var _ domain.GitProvider = (*GoGitProvider)(nil)
+
+// NewGoGitProvider returns a go-git provider for the repository of the working directory
+func NewGoGitProvider() (*GoGitProvider, error) {
+	repo, err := gogit.PlainOpenWithOptions(".", &gogit.PlainOpenOptions{DetectDotGit: true, EnableDotGitCommonDir: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the repository: %w", wrapGoGitError(err))
+	}
+
+	return &GoGitProvider{repo: repo}, nil
+}
+
+// NewProvider returns the git provider of the given backend. The one running git commands is
+// returned for the "cli" backend, and also when the repository cannot be opened with go-git.
+func NewProvider(backend string) domain.GitProvider {
+	if backend == BackendGoGit {
+		provider, err := NewGoGitProvider()
+		if err == nil {
+			return provider
+		}
+		logging.Debugf("Using the git commands because the repository could not be opened with go-git: %s", err)
+	}
+
+	return &Provider{}
+}
+
+func (p *GoGitProvider) BranchExists(ctx context.Context, branch string) bool {
+	_, err := p.repo.Reference(plumbing.NewBranchReferenceName(branch), false)
+	return err == nil
+}
+
+// GetCurrentBranch returns the name of the checked out branch, or HEAD if it is detached
+func (p *GoGitProvider) GetCurrentBranch(ctx context.Context) (branchName string, err error) {
+	head, err := p.repo.Head()
+	if err != nil {
+		return "", fmt.Errorf("failed to get the current branch: %w", wrapGoGitError(err))
+	}
+
+	if !head.Name().IsBranch() {
+		return "HEAD", nil
+	}
+
+	return head.Name().Short(), nil
+}
+
+// FindBranch returns the first branch, in alphabetical order, whose name contains the substring
+func (p *GoGitProvider) FindBranch(ctx context.Context, substring string) (branch string, exists bool) {
+	branches, err := p.repo.Branches()
+	if err != nil {
+		logging.Debugf("failed to list the branches: %s", err)
+		return "", false
+	}
+
+	matches := []string{}
+	_ = branches.ForEach(func(ref *plumbing.Reference) error {
+		if name := ref.Name().Short(); strings.Contains(name, substring) {
+			matches = append(matches, name)
+		}
+		return nil
+	})
+	if len(matches) == 0 {
+		return "", false
+	}
+
+	sort.Strings(matches)
+	return matches[0], true
+}
+
+// GetCommitsToPush returns the commits of the branch that are not in any branch of the base remote,
+// in the same format as Provider
+func (p *GoGitProvider) GetCommitsToPush(ctx context.Context, branch string) ([]string, error) {
+	tip, err := p.repo.Reference(plumbing.NewBranchReferenceName(branch), true)
+	if err != nil {
+		return []string{}, fmt.Errorf("failed to resolve the branch %s: %w", branch, wrapGoGitError(err))
+	}
+
+	remotePrefix := "refs/remotes/" + p.GetRemotes(ctx).Base + "/"
+	hidden := []plumbing.Hash{}
+	refs, err := p.repo.References()
+	if err != nil {
+		return []string{}, wrapGoGitError(err)
+	}
+	_ = refs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() == plumbing.HashReference && strings.HasPrefix(ref.Name().String(), remotePrefix) {
+			hidden = append(hidden, ref.Hash())
+		}
+		return nil
+	})
+
+	commits, err := p.commitsNotIn(ctx, tip.Hash(), hidden)
+	if err != nil {
+		return []string{}, err
+	}
+
+	lines := []string{}
+	for _, commit := range commits {
+		subject, _, _ := strings.Cut(commit.Message, "\n")
+		lines = append(lines, fmt.Sprintf("'%s %s'", commit.Hash.String()[:7], subject))
+	}
+
+	return lines, nil
+}
+
+func (p *GoGitProvider) GetRepositoryRoot(ctx context.Context) (rootPath string, err error) {
+	worktree, err := p.repo.Worktree()
+	if err != nil {
+		return "", fmt.Errorf("failed to get repository root: %w", wrapGoGitError(err))
+	}
+
+	return worktree.Filesystem.Root(), nil
+}
+
+// commitsNotIn returns the commits reachable from tip that are not reachable from the hidden
+// commits, newest first, like `git log tip --not hidden...`. It walks the history by commit date
+// and stops as soon as the remaining commits are all hidden, so it does not read the whole history.
+// The walk stops with the error of the context when it is done.
+func (p *GoGitProvider) commitsNotIn(ctx context.Context, tip plumbing.Hash, hidden []plumbing.Hash) ([]*object.Commit, error) {
+	queue := &commitQueue{}
+	isHidden := map[plumbing.Hash]bool{}
+	seen := map[plumbing.Hash]bool{}
+
+	push := func(hash plumbing.Hash, hide bool) error {
+		if hide {
+			isHidden[hash] = true
+		}
+		if seen[hash] {
+			return nil
+		}
+		seen[hash] = true
+
+		commit, err := p.repo.CommitObject(hash)
+		if err != nil {
+			return wrapGoGitError(err)
+		}
+		heap.Push(queue, commit)
+		return nil
+	}
+
+	for _, hash := range hidden {
+		// The remote branches may point to commits that have not been fetched
+		if err := push(hash, true); err != nil && !errors.Is(err, ErrReferenceNotFound) {
+			return nil, err
+		}
+	}
+	if err := push(tip, false); err != nil {
+		return nil, err
+	}
+
+	commits := []*object.Commit{}
+	for queue.Len() > 0 && !p.allHidden(queue, isHidden) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		commit := heap.Pop(queue).(*object.Commit)
+		hide := isHidden[commit.Hash]
+		if !hide {
+			commits = append(commits, commit)
+		}
+
+		err := commit.Parents().ForEach(func(parent *object.Commit) error {
+			return push(parent.Hash, hide)
+		})
+		if err != nil && err != storer.ErrStop {
+			return nil, wrapGoGitError(err)
+		}
+	}
+
+	return commits, nil
+}
+
+func (p *GoGitProvider) allHidden(queue *commitQueue, isHidden map[plumbing.Hash]bool) bool {
+	for _, commit := range *queue {
+		if !isHidden[commit.Hash] {
+			return false
+		}
+	}
+	return true
+}
+
+// commitQueue is a priority queue of commits, the newest first
+type commitQueue []*object.Commit
+
+func (q commitQueue) Len() int { return len(q) }
+func (q commitQueue) Less(i, j int) bool {
+	return q[i].Committer.When.After(q[j].Committer.When)
+}
+func (q commitQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *commitQueue) Push(x any)   { *q = append(*q, x.(*object.Commit)) }
+func (q *commitQueue) Pop() any {
+	old := *q
+	commit := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return commit
+}
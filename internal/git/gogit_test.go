@@ -0,0 +1,165 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRepository struct {
+	t        *testing.T
+	root     string
+	repo     *gogit.Repository
+	worktree *gogit.Worktree
+	when     time.Time
+}
+
+func newTestRepository(t *testing.T) *testRepository {
+	root := t.TempDir()
+	repo, err := gogit.PlainInit(root, false)
+	require.NoError(t, err)
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+
+	// The remotes are resolved with git commands, which fail so origin is used
+	originalRunGitCommand := runGitCommand
+	t.Cleanup(func() { runGitCommand = originalRunGitCommand })
+	runGitCommand = func(_ context.Context, args ...string) (string, error) {
+		return "", fmt.Errorf("unexpected git command %v", args)
+	}
+
+	return &testRepository{t: t, root: root, repo: repo, worktree: worktree, when: time.Unix(1700000000, 0)}
+}
+
+func (r *testRepository) commit(message string) plumbing.Hash {
+	r.when = r.when.Add(time.Minute)
+	hash, err := r.worktree.Commit(message, &gogit.CommitOptions{
+		AllowEmptyCommits: true,
+		Author:            &object.Signature{Name: "Sherpa", Email: "sherpa@example.com", When: r.when},
+	})
+	require.NoError(r.t, err)
+	return hash
+}
+
+func (r *testRepository) checkout(branch string, create bool) {
+	require.NoError(r.t, r.worktree.Checkout(&gogit.CheckoutOptions{Branch: plumbing.NewBranchReferenceName(branch), Create: create}))
+}
+
+func (r *testRepository) setReference(name string, hash plumbing.Hash) {
+	require.NoError(r.t, r.repo.Storer.SetReference(plumbing.NewHashReference(plumbing.ReferenceName(name), hash)))
+}
+
+func TestGoGitProvider(t *testing.T) {
+	r := newTestRepository(t)
+	base := r.commit("Initial commit")
+	r.setReference("refs/remotes/origin/master", base)
+	r.checkout("feature/GH-1-sample-issue", true)
+	first := r.commit("First change")
+	r.setReference("refs/remotes/origin/feature/GH-1-sample-issue", first)
+	second := r.commit("Second change")
+
+	provider := &GoGitProvider{repo: r.repo}
+
+	t.Run("GetCurrentBranch returns the checked out branch", func(t *testing.T) {
+		branch, err := provider.GetCurrentBranch(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "feature/GH-1-sample-issue", branch)
+	})
+
+	t.Run("BranchExists checks the local branches", func(t *testing.T) {
+		assert.True(t, provider.BranchExists(context.Background(), "master"))
+		assert.False(t, provider.BranchExists(context.Background(), "feature/GH-2"))
+	})
+
+	t.Run("FindBranch returns the first branch containing the substring", func(t *testing.T) {
+		branch, exists := provider.FindBranch(context.Background(), "GH-1")
+
+		assert.True(t, exists)
+		assert.Equal(t, "feature/GH-1-sample-issue", branch)
+
+		_, exists = provider.FindBranch(context.Background(), "GH-2")
+		assert.False(t, exists)
+	})
+
+	t.Run("GetCommitsToPush returns the commits not in the remote", func(t *testing.T) {
+		commits, err := provider.GetCommitsToPush(context.Background(), "feature/GH-1-sample-issue")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{fmt.Sprintf("'%s Second change'", second.String()[:7])}, commits)
+	})
+
+	t.Run("GetCommitsToPush returns the error of the context if it is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := provider.GetCommitsToPush(ctx, "feature/GH-1-sample-issue")
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("GetCommitsToPush returns an error if the branch does not exist", func(t *testing.T) {
+		_, err := provider.GetCommitsToPush(context.Background(), "feature/GH-2")
+
+		assert.ErrorIs(t, err, ErrReferenceNotFound)
+	})
+
+	t.Run("GetRepositoryRoot returns the root of the worktree", func(t *testing.T) {
+		root, err := provider.GetRepositoryRoot(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, r.root, root)
+	})
+
+	t.Run("GetCurrentBranch returns HEAD if it is detached", func(t *testing.T) {
+		require.NoError(t, r.worktree.Checkout(&gogit.CheckoutOptions{Hash: first}))
+		t.Cleanup(func() { r.checkout("feature/GH-1-sample-issue", false) })
+
+		branch, err := provider.GetCurrentBranch(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "HEAD", branch)
+	})
+}
+
+func chdir(t *testing.T, dir string) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+}
+
+func TestNewGoGitProvider(t *testing.T) {
+	t.Run("returns a typed error outside a repository", func(t *testing.T) {
+		chdir(t, t.TempDir())
+
+		_, err := NewGoGitProvider()
+
+		assert.True(t, errors.Is(err, ErrNotRepository))
+	})
+
+	t.Run("finds the repository from a subdirectory", func(t *testing.T) {
+		r := newTestRepository(t)
+		r.commit("Initial commit")
+		subdirectory := filepath.Join(r.root, "docs")
+		require.NoError(t, r.worktree.Filesystem.MkdirAll("docs", 0o755))
+		chdir(t, subdirectory)
+
+		provider, err := NewGoGitProvider()
+
+		require.NoError(t, err)
+		root, err := provider.GetRepositoryRoot(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, r.root, root)
+	})
+}
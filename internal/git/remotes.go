@@ -0,0 +1,172 @@
+package git
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/utils"
+)
+
+const (
+	// DefaultPushRemote is the remote where the branches are pushed when it cannot be detected
+	DefaultPushRemote = "origin"
+	// DefaultUpstreamRemote is the remote of the original repository in a fork setup
+	DefaultUpstreamRemote = "upstream"
+)
+
+// configuredRemotes are the remote names set in the configuration, empty to detect them
+var configuredRemotes domain.Remotes
+
+// remotesGeneration changes every time the remotes may have changed, so the providers resolve them again
+var remotesGeneration atomic.Uint64
+
+// ForgetRemotes makes the providers resolve the remotes again, after they have been changed, e.g. when
+// setting up a fork
+func ForgetRemotes() {
+	remotesGeneration.Add(1)
+}
+
+// RemotesGeneration returns a number that changes every time the remotes may have changed, so the
+// remotes resolved for another generation must be resolved again
+func RemotesGeneration() uint64 {
+	return remotesGeneration.Load()
+}
+
+// SetRemotes sets the names of the push and base remotes. The empty ones are detected from the
+// repository remotes.
+func SetRemotes(push string, base string) {
+	configuredRemotes = domain.Remotes{Push: push, Base: base}
+	ForgetRemotes()
+}
+
+// ResolveRemotes returns the names of the push and base remotes, running the git commands with run.
+//
+// The base remote is the configured one, otherwise the default repository set with
+// `gh repo set-default`, the upstream remote or the origin remote. The push remote is the configured
+// one, otherwise the remote.pushDefault git setting, the origin remote when it is not the base one,
+// the only remote of a fork of the base repository or the only remote that is not the base one.
+func ResolveRemotes(run func(args ...string) (string, error)) domain.Remotes {
+	remotes := configuredRemotes
+	if remotes.Push != "" && remotes.Base != "" {
+		return remotes
+	}
+
+	urls, defaultRemote := listRemotes(run)
+	if len(urls) == 0 {
+		// Without the list of remotes, keep the conventional origin and upstream names
+		if remotes.Push == "" {
+			remotes.Push = DefaultPushRemote
+		}
+		if remotes.Base == "" {
+			remotes.Base = remotes.Push
+			if _, err := run("remote", "get-url", DefaultUpstreamRemote); err == nil {
+				remotes.Base = DefaultUpstreamRemote
+			}
+		}
+		return remotes
+	}
+
+	if remotes.Base == "" {
+		remotes.Base = detectBaseRemote(urls, defaultRemote)
+	}
+	if remotes.Push == "" {
+		remotes.Push = detectPushRemote(run, urls, remotes.Base)
+	}
+
+	return remotes
+}
+
+// listRemotes returns the URLs of the remotes by name and the remote set with `gh repo set-default`
+func listRemotes(run func(args ...string) (string, error)) (urls map[string]string, defaultRemote string) {
+	out, err := run("config", "--get-regexp", `^remote\..*\.(url|gh-resolved)$`)
+	if err != nil {
+		return nil, ""
+	}
+
+	urls = map[string]string{}
+	defaultRepo := ""
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		key, value, found := strings.Cut(strings.TrimSpace(line), " ")
+		if !found {
+			continue
+		}
+		key = strings.TrimPrefix(key, "remote.")
+		switch {
+		case strings.HasSuffix(key, ".url"):
+			urls[strings.TrimSuffix(key, ".url")] = value
+		case strings.HasSuffix(key, ".gh-resolved"):
+			// gh stores "base" in the default remote, or the repository when it is not a remote
+			if value == "base" {
+				defaultRemote = strings.TrimSuffix(key, ".gh-resolved")
+			} else {
+				defaultRepo = value
+			}
+		}
+	}
+
+	if defaultRemote == "" && defaultRepo != "" {
+		for name, remoteURL := range urls {
+			if strings.EqualFold(utils.ExtractRepoFromURL(remoteURL), defaultRepo) {
+				defaultRemote = name
+			}
+		}
+	}
+
+	return urls, defaultRemote
+}
+
+func detectBaseRemote(urls map[string]string, defaultRemote string) string {
+	if _, ok := urls[defaultRemote]; ok {
+		return defaultRemote
+	}
+	for _, name := range []string{DefaultUpstreamRemote, DefaultPushRemote} {
+		if _, ok := urls[name]; ok {
+			return name
+		}
+	}
+	if len(urls) == 1 {
+		for name := range urls {
+			return name
+		}
+	}
+	return DefaultPushRemote
+}
+
+func detectPushRemote(run func(args ...string) (string, error), urls map[string]string, base string) string {
+	if out, err := run("config", "--get", "remote.pushDefault"); err == nil {
+		if name := strings.TrimSpace(out); name != "" {
+			if _, ok := urls[name]; ok {
+				return name
+			}
+		}
+	}
+
+	// origin is your fork unless it is the base remote, as in the me and origin layout
+	if _, ok := urls[DefaultPushRemote]; ok && base != DefaultPushRemote {
+		return DefaultPushRemote
+	}
+
+	// Otherwise your fork is the remote of a repository with the same name as the base one and
+	// another owner
+	baseOwner, baseName, _ := strings.Cut(utils.ExtractRepoFromURL(urls[base]), "/")
+	forks, others := []string{}, []string{}
+	for name, remoteURL := range urls {
+		if name == base {
+			continue
+		}
+		others = append(others, name)
+		owner, repoName, found := strings.Cut(utils.ExtractRepoFromURL(remoteURL), "/")
+		if found && !strings.EqualFold(owner, baseOwner) && strings.EqualFold(repoName, baseName) {
+			forks = append(forks, name)
+		}
+	}
+	if len(forks) == 1 {
+		return forks[0]
+	}
+	if len(others) == 1 {
+		return others[0]
+	}
+
+	return base
+}
@@ -0,0 +1,130 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeRemotesRunner answers the git commands used to resolve the remotes
+func fakeRemotesRunner(config string, pushDefault string) func(args ...string) (string, error) {
+	return func(args ...string) (string, error) {
+		switch strings.Join(args[:2], " ") {
+		case "config --get-regexp":
+			if config == "" {
+				return "", fmt.Errorf("exit status 1")
+			}
+			return config, nil
+		case "config --get":
+			if pushDefault == "" {
+				return "", fmt.Errorf("exit status 1")
+			}
+			return pushDefault + "\n", nil
+		}
+		return "", fmt.Errorf("unexpected command %v", args)
+	}
+}
+
+func TestResolveRemotes(t *testing.T) {
+	tests := []struct {
+		name        string
+		configured  domain.Remotes
+		config      string
+		pushDefault string
+		want        domain.Remotes
+	}{
+		{
+			name:   "origin only",
+			config: "remote.origin.url https://github.com/owner/repo.git",
+			want:   domain.Remotes{Push: "origin", Base: "origin"},
+		},
+		{
+			name:   "origin and upstream",
+			config: "remote.origin.url https://github.com/user/repo.git\nremote.upstream.url https://github.com/owner/repo.git",
+			want:   domain.Remotes{Push: "origin", Base: "upstream"},
+		},
+		{
+			name:   "fork and source with the default repository set",
+			config: "remote.fork.url https://github.com/user/repo.git\nremote.source.url https://github.com/owner/repo.git\nremote.source.gh-resolved base",
+			want:   domain.Remotes{Push: "fork", Base: "source"},
+		},
+		{
+			name:   "default repository set as a repository",
+			config: "remote.fork.url https://github.com/user/repo.git\nremote.source.url git@github.com:owner/repo.git\nremote.fork.gh-resolved owner/repo",
+			want:   domain.Remotes{Push: "fork", Base: "source"},
+		},
+		{
+			name:        "me and origin with remote.pushDefault",
+			config:      "remote.me.url https://github.com/user/repo.git\nremote.origin.url https://github.com/owner/repo.git",
+			pushDefault: "me",
+			want:        domain.Remotes{Push: "me", Base: "origin"},
+		},
+		{
+			name:   "me and origin without remote.pushDefault",
+			config: "remote.me.url https://github.com/user/repo.git\nremote.origin.url https://github.com/owner/repo.git",
+			want:   domain.Remotes{Push: "me", Base: "origin"},
+		},
+		{
+			name:   "fork among several remotes",
+			config: "remote.mirror.url https://github.com/owner/repo.git\nremote.me.url git@github.com:user/repo.git\nremote.tools.url https://github.com/owner/tools.git\nremote.origin.url https://github.com/owner/repo.git",
+			want:   domain.Remotes{Push: "me", Base: "origin"},
+		},
+		{
+			name:   "several remotes without a fork",
+			config: "remote.mirror.url https://github.com/owner/repo.git\nremote.tools.url https://github.com/owner/tools.git\nremote.origin.url https://github.com/owner/repo.git",
+			want:   domain.Remotes{Push: "origin", Base: "origin"},
+		},
+		{
+			name:       "configured remotes",
+			configured: domain.Remotes{Push: "me", Base: "origin"},
+			config:     "remote.me.url https://github.com/user/repo.git\nremote.origin.url https://github.com/owner/repo.git",
+			want:       domain.Remotes{Push: "me", Base: "origin"},
+		},
+		{
+			name:       "configured base remote",
+			configured: domain.Remotes{Base: "source"},
+			config:     "remote.fork.url https://github.com/user/repo.git\nremote.source.url https://github.com/owner/repo.git",
+			want:       domain.Remotes{Push: "fork", Base: "source"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetRemotes(tt.configured.Push, tt.configured.Base)
+			defer SetRemotes("", "")
+
+			assert.Equal(t, tt.want, ResolveRemotes(fakeRemotesRunner(tt.config, tt.pushDefault)))
+		})
+	}
+
+	t.Run("falls back to origin and upstream if the remotes cannot be listed", func(t *testing.T) {
+		run := func(args ...string) (string, error) {
+			if strings.Join(args, " ") == "remote get-url upstream" {
+				return "https://github.com/owner/repo.git", nil
+			}
+			return "", fmt.Errorf("unexpected command %v", args)
+		}
+
+		assert.Equal(t, domain.Remotes{Push: "origin", Base: "upstream"}, ResolveRemotes(run))
+	})
+}
+
+func TestGitPushBranchToConfiguredRemote(t *testing.T) {
+	SetRemotes("me", "origin")
+	defer SetRemotes("", "")
+
+	var argsSent []string
+	runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+		argsSent = args
+		return
+	}
+
+	err := (&Provider{}).PushBranch(context.Background(), "my-branch")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"push", "-u", "me", "my-branch"}, argsSent)
+}
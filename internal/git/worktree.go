@@ -0,0 +1,66 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+)
+
+// AddWorktree creates a new worktree at the given path for the branch. If base is not empty, the branch
+// is created from the base branch of the base remote, otherwise the existing branch is checked out.
+func (p *Provider) AddWorktree(ctx context.Context, path string, branch string, base string) (err error) {
+	args := []string{"worktree", "add", path, branch}
+	if base != "" {
+		args = []string{"worktree", "add", "--no-track", "-b", branch, path, p.GetRemotes(ctx).Base + "/" + base}
+	}
+
+	_, err = runGitCommand(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("failed to add the worktree.\n\nDetails:\n%w", err)
+	}
+
+	return nil
+}
+
+// ListWorktrees returns the worktrees of the repository, the main one first
+func (p *Provider) ListWorktrees(ctx context.Context) (worktrees []domain.Worktree, err error) {
+	args := []string{"worktree", "list", "--porcelain"}
+
+	out, err := runGitCommand(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the worktrees.\n\nDetails:\n%w", err)
+	}
+
+	worktrees = []domain.Worktree{}
+	for _, line := range strings.Split(out, "\n") {
+		key, value, _ := strings.Cut(strings.TrimSpace(line), " ")
+		switch key {
+		case "worktree":
+			worktrees = append(worktrees, domain.Worktree{Path: value, Main: len(worktrees) == 0})
+		case "branch":
+			if len(worktrees) > 0 {
+				worktrees[len(worktrees)-1].Branch = strings.TrimPrefix(value, "refs/heads/")
+			}
+		}
+	}
+
+	return worktrees, nil
+}
+
+// RemoveWorktree removes the worktree at the given path. Unless force is set, it fails if the
+// worktree has uncommitted changes.
+func (p *Provider) RemoveWorktree(ctx context.Context, path string, force bool) (err error) {
+	args := []string{"worktree", "remove", path}
+	if force {
+		args = []string{"worktree", "remove", "--force", path}
+	}
+
+	_, err = runGitCommand(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("failed to remove the worktree.\n\nDetails:\n%w", err)
+	}
+
+	return nil
+}
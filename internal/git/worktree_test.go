@@ -0,0 +1,73 @@
+package git
+
+import (
+	"context"
+	"testing"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitAddWorktree(t *testing.T) {
+	SetRemotes("origin", "upstream")
+	defer SetRemotes("", "")
+
+	provider := Provider{}
+	t.Run("GitAddWorktree should create the branch from the base branch", func(t *testing.T) {
+		var argsSent []string
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			argsSent = args
+			return
+		}
+
+		err := provider.AddWorktree(context.Background(), "../repo-GH-1", "feature/GH-1-issue", "main")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"worktree", "add", "--no-track", "-b", "feature/GH-1-issue", "../repo-GH-1", "upstream/main"}, argsSent)
+	})
+
+	t.Run("GitAddWorktree should check out the existing branch if there is no base branch", func(t *testing.T) {
+		var argsSent []string
+		runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+			argsSent = args
+			return
+		}
+
+		err := provider.AddWorktree(context.Background(), "../repo-GH-1", "feature/GH-1-issue", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"worktree", "add", "../repo-GH-1", "feature/GH-1-issue"}, argsSent)
+	})
+}
+
+func TestGitListWorktrees(t *testing.T) {
+	provider := Provider{}
+	runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+		return "worktree /src/repo\nHEAD abc123\nbranch refs/heads/main\n\n" +
+			"worktree /src/repo-GH-1\nHEAD def456\nbranch refs/heads/feature/GH-1-issue\nlocked\n\n" +
+			"worktree /src/repo review\nHEAD 789abc\ndetached\n\n", nil
+	}
+
+	worktrees, err := provider.ListWorktrees(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Worktree{
+		{Path: "/src/repo", Branch: "main", Main: true},
+		{Path: "/src/repo-GH-1", Branch: "feature/GH-1-issue"},
+		{Path: "/src/repo review"},
+	}, worktrees)
+}
+
+func TestGitRemoveWorktree(t *testing.T) {
+	provider := Provider{}
+	var argsSent []string
+	runGitCommand = func(_ context.Context, args ...string) (out string, err error) {
+		argsSent = args
+		return
+	}
+
+	err := provider.RemoveWorktree(context.Background(), "/src/repo-GH-1", true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"worktree", "remove", "--force", "/src/repo-GH-1"}, argsSent)
+}
@@ -2,13 +2,15 @@ package interactive
 
 import (
 	"fmt"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/AlecAivazis/survey/v2/terminal"
 	"github.com/InditexTech/gh-sherpa/internal/domain"
 )
 
-var ErrOpCanceled error = fmt.Errorf("operation canceled by the user")
+var ErrOpCanceled error = domain.NewError(domain.ErrorCodeCancelled, fmt.Errorf("operation canceled by the user"))
 
 type UserInteractionProvider struct{}
 
@@ -70,6 +72,35 @@ func (u UserInteractionProvider) SelectOrInputPrompt(message string, validValues
 	return handleSurveyError(err)
 }
 
+// SelectWithFilter Prompt a select where the options can be filtered by typing part of them,
+// storing the index of the selected option.
+func (u UserInteractionProvider) SelectWithFilter(message string, options []string, selectedIndex *int) error {
+	prompt := &survey.Select{
+		Message:  message,
+		Options:  options,
+		PageSize: 10,
+	}
+
+	err := survey.AskOne(prompt, selectedIndex, survey.WithFilter(FuzzyFilter))
+	return handleSurveyError(err)
+}
+
+// FuzzyFilter matches the options containing all the characters of the filter in the same order,
+// ignoring the case. For example, "ghlog" matches "GH-12 Add login page".
+func FuzzyFilter(filter string, option string, _ int) bool {
+	option = strings.ToLower(option)
+
+	for _, r := range strings.ToLower(filter) {
+		idx := strings.IndexRune(option, r)
+		if idx == -1 {
+			return false
+		}
+		option = option[idx+utf8.RuneLen(r):]
+	}
+
+	return true
+}
+
 // TODO: Do not use "kind/*" here, use the actual config to retrieve the label
 func GetPromptMessageBranchType(branchType string, issueTrackerType domain.IssueTrackerType) string {
 	if issueTrackerType == domain.IssueTrackerTypeJira {
@@ -0,0 +1,29 @@
+package interactive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuzzyFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+		option string
+		want   bool
+	}{
+		{name: "empty filter", filter: "", option: "GH-12 Add login page", want: true},
+		{name: "substring", filter: "login", option: "GH-12 Add login page", want: true},
+		{name: "characters in order", filter: "ghlog", option: "GH-12 Add login page", want: true},
+		{name: "ignores case", filter: "ADD", option: "GH-12 Add login page", want: true},
+		{name: "characters out of order", filter: "gol", option: "GH-12 Add login page", want: false},
+		{name: "missing characters", filter: "logout", option: "GH-12 Add login page", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FuzzyFilter(tt.filter, tt.option, 0))
+		})
+	}
+}
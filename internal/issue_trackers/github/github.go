@@ -1,6 +1,7 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"slices"
@@ -11,18 +12,25 @@ import (
 	"github.com/InditexTech/gh-sherpa/internal/domain"
 	"github.com/InditexTech/gh-sherpa/internal/domain/issue_types"
 	"github.com/InditexTech/gh-sherpa/internal/gh"
+	"github.com/InditexTech/gh-sherpa/internal/utils"
 )
 
 var issuePattern = regexp.MustCompile(`^(?i:GH-)?(?P<issue_num>\d+)$`)
 
-var ErrIssueNotFound = fmt.Errorf("the issue was not found")
+// searchPageSize is the number of issues requested in each page of a search
+const searchPageSize = 100
+
+// maxSearchPages limits the number of pages requested in a search
+const maxSearchPages = 10
+
+var ErrIssueNotFound = domain.ErrIssueNotFound
 
 var ErrIdIsPullRequestNumber = func(identifier string) error {
 	return fmt.Errorf("given identifier %s is a Pull Request number", identifier)
 }
 
 type githubCli interface {
-	Execute(result interface{}, command []string) error
+	Execute(ctx context.Context, result interface{}, command []string) error
 	domain.RepositoryProvider
 }
 
@@ -41,6 +49,7 @@ type ghIssue struct {
 	Body        string
 	Labels      []Label
 	Url         string
+	State       string
 	PullRequest *ghPullRequest `json:"pull_request"`
 }
 
@@ -50,6 +59,11 @@ func (i ghIssue) isPullRequest() bool {
 
 type ghPullRequest map[string]any
 
+type ghSearchResult struct {
+	TotalCount int64 `json:"total_count"`
+	Items      []ghIssue
+}
+
 type Label struct {
 	Id          int64
 	Name        string
@@ -70,20 +84,33 @@ func New(cfg Configuration) (*Github, error) {
 	}, nil
 }
 
-func (g *Github) GetIssue(identifier string) (issue domain.Issue, err error) {
-	repo, err := g.cli.GetRepository()
+// apiCommand returns the arguments of a gh api command, in the GitHub Enterprise Server host if it is used
+func apiCommand(args ...string) []string {
+	command := []string{"api"}
+	if host := utils.GitHubHost(); host != utils.DefaultGitHubHost {
+		command = append(command, "--hostname", host)
+	}
+	return append(command, args...)
+}
+
+func (g *Github) GetIssue(ctx context.Context, identifier string) (issue domain.Issue, err error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+
+	repo, err := g.cli.GetRepository(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	apiPath := fmt.Sprintf("/repos/%s/issues/%s", repo.NameWithOwner, identifier)
-	command := []string{"api", apiPath}
+	command := apiCommand(apiPath)
 
 	result := ghIssue{}
 
-	err = g.cli.Execute(&result, command)
+	err = g.cli.Execute(ctx, &result, command)
 	if err != nil {
-		if strings.Contains(err.Error(), "Could not resolve to an issue or pull request") {
+		// The GraphQL API and the REST API report the missing issues with different messages
+		if strings.Contains(err.Error(), "Could not resolve to an issue or pull request") || strings.Contains(err.Error(), "HTTP 404") {
 			err = ErrIssueNotFound
 		}
 		return
@@ -93,6 +120,92 @@ func (g *Github) GetIssue(identifier string) (issue domain.Issue, err error) {
 		return nil, ErrIdIsPullRequestNumber(identifier)
 	}
 
+	return g.toIssue(result), nil
+}
+
+// GetAssignedIssues returns the open issues of the current repository assigned to the current user
+func (g *Github) GetAssignedIssues(ctx context.Context) (issues []domain.Issue, err error) {
+	return g.SearchIssues(ctx, "is:open assignee:@me")
+}
+
+// SearchIssues returns the issues matching the given search query, requesting all the pages
+// of results up to a maximum of maxSearchPages. The query is restricted to the issues of the
+// current repository unless it already sets a repository, organization or user qualifier.
+func (g *Github) SearchIssues(ctx context.Context, query string) (issues []domain.Issue, err error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+
+	repo, err := g.cli.GetRepository(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query = buildSearchQuery(query, repo.NameWithOwner)
+	issues = []domain.Issue{}
+	fetched := 0
+
+	for page := 1; page <= maxSearchPages; page++ {
+		command := apiCommand("--method", "GET", "search/issues", "-f", "q="+query,
+			"-f", fmt.Sprintf("per_page=%d", searchPageSize), "-f", fmt.Sprintf("page=%d", page))
+
+		result := ghSearchResult{}
+		if err := g.cli.Execute(ctx, &result, command); err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			if item.isPullRequest() {
+				continue
+			}
+			issues = append(issues, g.toIssue(item))
+		}
+
+		fetched += len(result.Items)
+		if len(result.Items) < searchPageSize || int64(fetched) >= result.TotalCount {
+			break
+		}
+	}
+
+	return issues, nil
+}
+
+// withTimeout returns the context of an operation in GitHub, limited to the time set in the
+// github.timeout setting if it is not 0
+func (g *Github) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if g.cfg.Timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, g.cfg.Timeout)
+}
+
+// buildSearchQuery adds to the query the qualifiers needed to search only issues of the given repository
+func buildSearchQuery(query string, nameWithOwner string) string {
+	terms := strings.Fields(query)
+
+	hasQualifier := func(prefixes ...string) bool {
+		return slices.ContainsFunc(terms, func(term string) bool {
+			for _, prefix := range prefixes {
+				if strings.HasPrefix(term, prefix) {
+					return true
+				}
+			}
+			return false
+		})
+	}
+
+	if !hasQualifier("is:issue", "is:pr", "type:") {
+		terms = append([]string{"is:issue"}, terms...)
+	}
+
+	if !hasQualifier("repo:", "org:", "user:") {
+		terms = append(terms, "repo:"+nameWithOwner)
+	}
+
+	return strings.Join(terms, " ")
+}
+
+func (g *Github) toIssue(result ghIssue) Issue {
 	labels := make([]domain.Label, len(result.Labels))
 
 	for i, label := range result.Labels {
@@ -114,8 +227,8 @@ func (g *Github) GetIssue(identifier string) (issue domain.Issue, err error) {
 		labels:    labels,
 		typeLabel: issueTypeLabel,
 		issueType: g.getIssueType(issueTypeLabel),
-	}, nil
-
+		state:     result.State,
+	}
 }
 
 func (g *Github) getIssueType(issueTypeLabel string) issue_types.IssueType {
@@ -1,15 +1,18 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/InditexTech/gh-sherpa/internal/config"
 	"github.com/InditexTech/gh-sherpa/internal/domain"
 	"github.com/InditexTech/gh-sherpa/internal/domain/issue_types"
 	"github.com/InditexTech/gh-sherpa/internal/gh"
+	"github.com/InditexTech/gh-sherpa/internal/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -17,8 +20,15 @@ import (
 
 type fakeCli struct {
 	gh.Cli
-	issue *ghIssue
-	err   error
+	issue        *ghIssue
+	searchResult *ghSearchResult
+	// searchPages holds the results of a paginated search, when it is set searchResult is ignored
+	searchPages []ghSearchResult
+	commands    [][]string
+	lastCommand []string
+	err         error
+	// lastCtx is the context of the last command
+	lastCtx context.Context
 }
 
 func (f *fakeCli) setError() {
@@ -56,10 +66,11 @@ func (f *fakeCli) addIssueTypeLabel(issueType issue_types.IssueType) {
 
 var _ githubCli = (*fakeCli)(nil)
 
-func (f *fakeCli) GetRepository() (repo *domain.Repository, err error) {
+func (f *fakeCli) GetRepository(_ context.Context) (repo *domain.Repository, err error) {
 	repo = &domain.Repository{
 		Name:             "Repo 1",
 		Owner:            "Owner 1",
+		NameWithOwner:    "owner/repo",
 		DefaultBranchRef: "main",
 	}
 	return
@@ -67,7 +78,10 @@ func (f *fakeCli) GetRepository() (repo *domain.Repository, err error) {
 
 var errExecuteError = fmt.Errorf("execute error")
 
-func (f *fakeCli) Execute(result any, _ []string) (err error) {
+func (f *fakeCli) Execute(ctx context.Context, result any, command []string) (err error) {
+	f.lastCtx = ctx
+	f.lastCommand = command
+	f.commands = append(f.commands, command)
 	if f.err != nil {
 		return f.err
 	}
@@ -77,6 +91,12 @@ func (f *fakeCli) Execute(result any, _ []string) (err error) {
 	switch result := result.(type) {
 	case *ghIssue:
 		*result = *f.issue
+	case *ghSearchResult:
+		if f.searchPages != nil {
+			*result = f.searchPages[len(f.commands)-1]
+		} else if f.searchResult != nil {
+			*result = *f.searchResult
+		}
 	default:
 		panic("unexpected type")
 	}
@@ -151,17 +171,46 @@ func (s *GithubTestSuite) TestGetIssue() {
 	s.Run("should return error if could not execute", func() {
 		s.fakeCli.setError()
 
-		issue, err := s.github.GetIssue(s.defaultIssueID)
+		issue, err := s.github.GetIssue(context.Background(), s.defaultIssueID)
 
 		s.Error(err)
 		s.Nil(issue)
 	})
 
+	s.Run("should return issue not found if the issue does not exist", func() {
+		s.fakeCli.err = errors.New("gh: Not Found (HTTP 404)")
+
+		issue, err := s.github.GetIssue(context.Background(), s.defaultIssueID)
+
+		s.ErrorIs(err, ErrIssueNotFound)
+		s.Equal(domain.ErrorCodeIssueNotFound, domain.ErrorCodeOf(err))
+		s.Nil(issue)
+	})
+
+	s.Run("should limit the time of the commands to the configured timeout", func() {
+		s.github.cfg.Timeout = time.Minute
+
+		_, err := s.github.GetIssue(context.Background(), s.defaultIssueID)
+
+		s.NoError(err)
+		deadline, ok := s.fakeCli.lastCtx.Deadline()
+		s.True(ok)
+		s.WithinDuration(time.Now().Add(time.Minute), deadline, 5*time.Second)
+	})
+
+	s.Run("should not limit the time of the commands without timeout", func() {
+		_, err := s.github.GetIssue(context.Background(), s.defaultIssueID)
+
+		s.NoError(err)
+		_, ok := s.fakeCli.lastCtx.Deadline()
+		s.False(ok)
+	})
+
 	s.Run("should return bug issue", func() {
 		s.fakeCli.resetLabels()
 		s.fakeCli.addIssueTypeLabel(issue_types.Bug)
 
-		issue, err := s.github.GetIssue(s.defaultIssueID)
+		issue, err := s.github.GetIssue(context.Background(), s.defaultIssueID)
 
 		s.NoError(err)
 		s.Require().NotNil(issue)
@@ -180,7 +229,7 @@ func (s *GithubTestSuite) TestGetIssue() {
 		})
 		s.fakeCli.addIssueTypeLabel(issue_types.Bug)
 
-		issue, err := s.github.GetIssue(s.defaultIssueID)
+		issue, err := s.github.GetIssue(context.Background(), s.defaultIssueID)
 
 		s.NoError(err)
 		s.Require().NotNil(issue)
@@ -191,7 +240,7 @@ func (s *GithubTestSuite) TestGetIssue() {
 	s.Run("should return unknown issue if no label is present", func() {
 		s.fakeCli.resetLabels()
 
-		issue, err := s.github.GetIssue(s.defaultIssueID)
+		issue, err := s.github.GetIssue(context.Background(), s.defaultIssueID)
 
 		s.NoError(err)
 		s.Require().NotNil(issue)
@@ -202,7 +251,7 @@ func (s *GithubTestSuite) TestGetIssue() {
 		s.fakeCli.resetLabels()
 		s.fakeCli.addIssueTypeLabel("random-label")
 
-		issue, err := s.github.GetIssue(s.defaultIssueID)
+		issue, err := s.github.GetIssue(context.Background(), s.defaultIssueID)
 
 		s.NoError(err)
 		s.Require().NotNil(issue)
@@ -210,7 +259,7 @@ func (s *GithubTestSuite) TestGetIssue() {
 	})
 
 	s.Run("should return issue", func() {
-		issue, err := s.github.GetIssue(s.defaultIssueID)
+		issue, err := s.github.GetIssue(context.Background(), s.defaultIssueID)
 
 		s.NoError(err)
 		s.Require().NotNil(s.expectedIssue)
@@ -221,7 +270,7 @@ func (s *GithubTestSuite) TestGetIssue() {
 		s.fakeCli.issue.PullRequest = &ghPullRequest{}
 
 		issueId := "99"
-		issue, err := s.github.GetIssue(issueId)
+		issue, err := s.github.GetIssue(context.Background(), issueId)
 
 		s.ErrorContains(err, ErrIdIsPullRequestNumber(issueId).Error())
 		s.Nil(issue)
@@ -229,6 +278,82 @@ func (s *GithubTestSuite) TestGetIssue() {
 
 }
 
+func (s *GithubTestSuite) TestGetAssignedIssues() {
+	s.Run("should return error if could not execute", func() {
+		s.fakeCli.setError()
+
+		issues, err := s.github.GetAssignedIssues(context.Background())
+
+		s.Error(err)
+		s.Nil(issues)
+	})
+
+	s.Run("should search the open issues assigned to the current user", func() {
+		pullRequest := *s.fakeCli.issue
+		pullRequest.Number = 2
+		pullRequest.PullRequest = &ghPullRequest{}
+		s.fakeCli.searchResult = &ghSearchResult{
+			TotalCount: 2,
+			Items:      []ghIssue{*s.fakeCli.issue, pullRequest},
+		}
+
+		issues, err := s.github.GetAssignedIssues(context.Background())
+
+		s.NoError(err)
+		s.Equal([]domain.Issue{*s.expectedIssue}, issues)
+		s.Equal([]string{"api", "--method", "GET", "search/issues", "-f", "q=is:issue is:open assignee:@me repo:owner/repo", "-f", "per_page=100", "-f", "page=1"}, s.fakeCli.lastCommand)
+	})
+}
+
+func (s *GithubTestSuite) TestSearchIssues() {
+	s.Run("should request all the pages of results", func() {
+		newPage := func(size int) ghSearchResult {
+			items := make([]ghIssue, size)
+			for i := range items {
+				items[i] = *s.fakeCli.issue
+			}
+			return ghSearchResult{TotalCount: int64(2*searchPageSize + 1), Items: items}
+		}
+		s.fakeCli.searchPages = []ghSearchResult{newPage(searchPageSize), newPage(searchPageSize), newPage(1)}
+
+		issues, err := s.github.SearchIssues(context.Background(), "label:triage is:open")
+
+		s.NoError(err)
+		s.Len(issues, 2*searchPageSize+1)
+		s.Require().Len(s.fakeCli.commands, 3)
+		s.Equal([]string{"api", "--method", "GET", "search/issues", "-f", "q=is:issue label:triage is:open repo:owner/repo", "-f", "per_page=100", "-f", "page=3"}, s.fakeCli.lastCommand)
+	})
+}
+
+func (s *GithubTestSuite) TestEnterpriseHost() {
+	s.Run("should call the API of the GitHub Enterprise Server host", func() {
+		utils.SetGitHubHost("github.example.com")
+		defer utils.SetGitHubHost(utils.DefaultGitHubHost)
+
+		_, err := s.github.GetIssue(context.Background(), "1")
+
+		s.NoError(err)
+		s.Equal([]string{"api", "--hostname", "github.example.com", "/repos/owner/repo/issues/1"}, s.fakeCli.lastCommand)
+	})
+}
+
+func TestBuildSearchQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{name: "adds the issue and repository qualifiers", query: "label:triage is:open", want: "is:issue label:triage is:open repo:owner/repo"},
+		{name: "keeps the type qualifier", query: "is:pr is:open", want: "is:pr is:open repo:owner/repo"},
+		{name: "keeps the repository qualifier", query: "is:open org:other", want: "is:issue is:open org:other"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildSearchQuery(tt.query, "owner/repo"))
+		})
+	}
+}
+
 func Test_CheckConfiguration(t *testing.T) {
 	type fields struct {
 		Cli githubCli
@@ -2,6 +2,7 @@ package github
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/InditexTech/gh-sherpa/internal/domain"
 	"github.com/InditexTech/gh-sherpa/internal/domain/issue_types"
@@ -15,6 +16,7 @@ type Issue struct {
 	typeLabel string
 	issueType issue_types.IssueType
 	labels    []domain.Label
+	state     string
 }
 
 var _ domain.Issue = (*Issue)(nil)
@@ -59,3 +61,11 @@ func (i Issue) HasLabel(labelName string) bool {
 	}
 	return false
 }
+
+func (i Issue) State() string {
+	return i.state
+}
+
+func (i Issue) IsClosed() bool {
+	return strings.EqualFold(i.state, "closed")
+}
@@ -1,7 +1,11 @@
 package issue_trackers
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/InditexTech/gh-sherpa/internal/config"
 	"github.com/InditexTech/gh-sherpa/internal/domain"
@@ -54,15 +58,15 @@ func NewFromConfiguration(globalConfig config.Configuration) (*Provider, error)
 	})
 }
 
-func (p Provider) GetIssue(identifier string) (domain.Issue, error) {
+func (p Provider) GetIssue(ctx context.Context, identifier string) (domain.Issue, error) {
 	if p.github.IdentifyIssue(identifier) {
 		logging.Debugf("Issue %s identified as a Github issue", identifier)
-		return p.github.GetIssue(identifier)
+		return p.github.GetIssue(ctx, identifier)
 	}
 
 	if p.jira.IdentifyIssue(identifier) {
 		logging.Debugf("Issue %s identified as a Jira issue", identifier)
-		return p.jira.GetIssue(identifier)
+		return p.jira.GetIssue(ctx, identifier)
 	}
 
 	return nil, fmt.Errorf("could not identify issue %s", identifier)
@@ -81,3 +85,98 @@ func (p Provider) ParseIssueId(identifier string) (issueId string) {
 
 	return
 }
+
+// GetAssignedIssues returns the open issues assigned to the current user in GitHub and, if it is
+// configured, in Jira. The issues of a tracker that fails are skipped unless all of them fail.
+func (p Provider) GetAssignedIssues(ctx context.Context) ([]domain.Issue, error) {
+	issues, githubErr := p.github.GetAssignedIssues(ctx)
+	if githubErr != nil {
+		logging.Debugf("could not get the GitHub issues assigned to you: %s", githubErr)
+		githubErr = fmt.Errorf("could not get the GitHub issues assigned to you: %w", githubErr)
+	}
+
+	if p.cfg.Jira.Auth.Host == "" {
+		return issues, githubErr
+	}
+
+	jiraIssues, jiraErr := p.jira.GetAssignedIssues(ctx)
+	if jiraErr != nil {
+		logging.Debugf("could not get the Jira issues assigned to you: %s", jiraErr)
+		jiraErr = fmt.Errorf("could not get the Jira issues assigned to you: %w", jiraErr)
+	}
+
+	if githubErr != nil && jiraErr != nil {
+		return nil, errors.Join(githubErr, jiraErr)
+	}
+
+	return append(issues, jiraIssues...), nil
+}
+
+// ErrUnknownQuery is returned when there is no saved query with the given name
+func ErrUnknownQuery(name string, available []string) error {
+	if len(available) == 0 {
+		return fmt.Errorf("there is no saved query named %s, you can add one in the jira.queries or github.queries settings", name)
+	}
+	return fmt.Errorf("there is no saved query named %s, the available queries are: %s", name, strings.Join(available, ", "))
+}
+
+// SearchIssues returns the issues matching the saved query with the given name. If both trackers
+// define a query with that name the results of both are returned.
+func (p Provider) SearchIssues(ctx context.Context, queryName string) ([]domain.Issue, error) {
+	// Configuration keys are case insensitive
+	queryName = strings.ToLower(queryName)
+
+	githubQuery, inGithub := p.cfg.Github.Queries[queryName]
+	jiraQuery, inJira := p.cfg.Jira.Queries[queryName]
+
+	if !inGithub && !inJira {
+		return nil, ErrUnknownQuery(queryName, p.queryNames())
+	}
+
+	issues := []domain.Issue{}
+
+	if inGithub {
+		logging.Debugf("Searching GitHub issues with the query %s: %s", queryName, githubQuery)
+		githubIssues, err := p.github.SearchIssues(ctx, githubQuery)
+		if err != nil {
+			return nil, fmt.Errorf("could not search the GitHub issues of the query %s: %w", queryName, err)
+		}
+		issues = append(issues, githubIssues...)
+	}
+
+	if inJira {
+		logging.Debugf("Searching Jira issues with the query %s: %s", queryName, jiraQuery)
+		jiraIssues, err := p.jira.SearchIssues(ctx, jiraQuery)
+		if err != nil {
+			return nil, fmt.Errorf("could not search the Jira issues of the query %s: %w", queryName, err)
+		}
+		issues = append(issues, jiraIssues...)
+	}
+
+	return issues, nil
+}
+
+// TransitionIssue moves the issue through the transition with the given name. Only Jira issues
+// have workflow transitions.
+func (p Provider) TransitionIssue(ctx context.Context, identifier string, transition string) error {
+	if !p.github.IdentifyIssue(identifier) && p.jira.IdentifyIssue(identifier) {
+		logging.Debugf("Transitioning the Jira issue %s through %s", identifier, transition)
+		return p.jira.TransitionIssue(ctx, identifier, transition)
+	}
+
+	return fmt.Errorf("could not transition the issue %s, only Jira issues have transitions", identifier)
+}
+
+// queryNames returns the sorted names of the saved queries of all the trackers
+func (p Provider) queryNames() []string {
+	names := []string{}
+	for _, queries := range []map[string]string{p.cfg.Github.Queries, p.cfg.Jira.Queries} {
+		for name := range queries {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	slices.Sort(names)
+	return names
+}
@@ -1,9 +1,11 @@
 package jira
 
 import (
+	"context"
 	"crypto/tls"
 	"net/http"
 
+	"github.com/InditexTech/gh-sherpa/internal/logging"
 	gojira "github.com/andygrunwald/go-jira"
 )
 
@@ -19,7 +21,7 @@ var createBearerClient = func(token string, host string, skipTLSVerify bool) (go
 
 	tp := gojira.BearerAuthTransport{
 		Token:     token,
-		Transport: customTransport,
+		Transport: logging.NewTransport("jira", customTransport),
 	}
 
 	gojiraClient, err := gojira.NewClient(tp.Client(), host)
@@ -31,6 +33,22 @@ var createBearerClient = func(token string, host string, skipTLSVerify bool) (go
 	return &client{*gojiraClient}, nil
 }
 
-func (c *client) getIssue(identifier string) (*gojira.Issue, *gojira.Response, error) {
-	return c.Issue.Get(identifier, &gojira.GetQueryOptions{Fields: "issuetype,summary"})
+func (c *client) getIssue(ctx context.Context, identifier string) (*gojira.Issue, *gojira.Response, error) {
+	return c.Issue.GetWithContext(ctx, identifier, &gojira.GetQueryOptions{Fields: "issuetype,summary,status"})
+}
+
+func (c *client) searchIssues(ctx context.Context, jql string, startAt int) ([]gojira.Issue, *gojira.Response, error) {
+	return c.Issue.SearchWithContext(ctx, jql, &gojira.SearchOptions{
+		Fields:     []string{"issuetype", "summary", "status"},
+		StartAt:    startAt,
+		MaxResults: searchPageSize,
+	})
+}
+
+func (c *client) getTransitions(ctx context.Context, issueID string) ([]gojira.Transition, *gojira.Response, error) {
+	return c.Issue.GetTransitionsWithContext(ctx, issueID)
+}
+
+func (c *client) doTransition(ctx context.Context, issueID string, transitionID string) (*gojira.Response, error) {
+	return c.Issue.DoTransitionWithContext(ctx, issueID, transitionID)
 }
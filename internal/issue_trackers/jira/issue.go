@@ -3,6 +3,7 @@ package jira
 import (
 	"github.com/InditexTech/gh-sherpa/internal/domain"
 	"github.com/InditexTech/gh-sherpa/internal/domain/issue_types"
+	gojira "github.com/andygrunwald/go-jira"
 )
 
 type Issue struct {
@@ -13,6 +14,7 @@ type Issue struct {
 	jiraIssueType JiraIssueType
 	typeLabel     string
 	issueType     issue_types.IssueType
+	status        JiraStatus
 }
 
 var _ domain.Issue = (*Issue)(nil)
@@ -23,6 +25,11 @@ type JiraIssueType struct {
 	Description string
 }
 
+type JiraStatus struct {
+	Name        string
+	CategoryKey string
+}
+
 func (i Issue) Body() string {
 	return i.body
 }
@@ -60,3 +67,11 @@ func (i Issue) HasLabel(labelName string) bool {
 	// This method always returns false for Jira issues
 	return false
 }
+
+func (i Issue) State() string {
+	return i.status.Name
+}
+
+func (i Issue) IsClosed() bool {
+	return i.status.CategoryKey == gojira.StatusCategoryComplete
+}
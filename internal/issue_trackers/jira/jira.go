@@ -1,10 +1,12 @@
 package jira
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
+	"strings"
 
 	"github.com/InditexTech/gh-sherpa/internal/config"
 	"github.com/InditexTech/gh-sherpa/internal/domain"
@@ -14,13 +16,38 @@ import (
 
 var issuePattern = regexp.MustCompile(`^(?P<issue_key>\w+)-(?P<issue_num>\d+)$`)
 
+// assignedIssuesJQL searches the issues assigned to the current user that are not done
+const assignedIssuesJQL = "assignee = currentUser() AND statusCategory != Done ORDER BY updated DESC"
+
+// searchPageSize is the number of issues requested in each page of a search
+const searchPageSize = 100
+
+// maxSearchPages limits the number of pages requested in a search
+const maxSearchPages = 10
+
+// ErrInvalidPAT is returned when the personal access token is not valid
+var ErrInvalidPAT = domain.NewError(domain.ErrorCodeAuthFailed, errors.New("your PAT is invalid or revoked"))
+
+// ErrNoResponse is returned when the Jira host cannot be reached
+func ErrNoResponse(host string) error {
+	return domain.NewError(domain.ErrorCodeNetwork, fmt.Errorf("could not get response from host '%s'. Check your jira configuration", host))
+}
+
+// errForbidden is returned when the user has not enough permissions to do the action
+func errForbidden(action string) error {
+	return domain.NewError(domain.ErrorCodeAuthFailed, fmt.Errorf("you do not have permission to %s", action))
+}
+
 type Jira struct {
 	cfg    Configuration
 	client gojiraClient
 }
 
 type gojiraClient interface {
-	getIssue(issueID string) (*gojira.Issue, *gojira.Response, error)
+	getIssue(ctx context.Context, issueID string) (*gojira.Issue, *gojira.Response, error)
+	searchIssues(ctx context.Context, jql string, startAt int) ([]gojira.Issue, *gojira.Response, error)
+	getTransitions(ctx context.Context, issueID string) ([]gojira.Transition, *gojira.Response, error)
+	doTransition(ctx context.Context, issueID string, transitionID string) (*gojira.Response, error)
 }
 
 type Configuration struct {
@@ -43,22 +70,25 @@ func New(cfg Configuration) (jira *Jira, err error) {
 	return
 }
 
-func (j *Jira) GetIssue(identifier string) (issue domain.Issue, err error) {
-	issueGot, res, err := j.client.getIssue(identifier)
+func (j *Jira) GetIssue(ctx context.Context, identifier string) (issue domain.Issue, err error) {
+	ctx, cancel := j.withTimeout(ctx)
+	defer cancel()
+
+	issueGot, res, err := j.client.getIssue(ctx, identifier)
 
 	if err != nil {
 		if res == nil {
-			err = fmt.Errorf("could not get response from host '%s'. Check your jira configuration", j.cfg.Auth.Host)
+			err = j.errNoResponse(ctx)
 			return
 		}
 
 		switch res.StatusCode {
 		case http.StatusUnauthorized:
-			err = errors.New("your PAT is invalid or revoked")
+			err = ErrInvalidPAT
 		case http.StatusForbidden:
-			err = errors.New("you do not have permission to get this issue")
+			err = errForbidden("get this issue")
 		case http.StatusNotFound:
-			err = errors.New("the issue was not found")
+			err = domain.ErrIssueNotFound
 		default:
 			err = fmt.Errorf("could not get issue: %s", err)
 		}
@@ -71,6 +101,110 @@ func (j *Jira) GetIssue(identifier string) (issue domain.Issue, err error) {
 	return
 }
 
+// GetAssignedIssues returns the issues assigned to the current user that are not done
+func (j *Jira) GetAssignedIssues(ctx context.Context) (issues []domain.Issue, err error) {
+	return j.SearchIssues(ctx, assignedIssuesJQL)
+}
+
+// SearchIssues returns the issues matching the given JQL query, requesting all the pages
+// of results up to a maximum of maxSearchPages
+func (j *Jira) SearchIssues(ctx context.Context, jql string) (issues []domain.Issue, err error) {
+	ctx, cancel := j.withTimeout(ctx)
+	defer cancel()
+
+	issues = []domain.Issue{}
+
+	for page := 0; page < maxSearchPages; page++ {
+		found, res, err := j.client.searchIssues(ctx, jql, len(issues))
+		if err != nil {
+			if res == nil {
+				return nil, j.errNoResponse(ctx)
+			}
+
+			switch res.StatusCode {
+			case http.StatusUnauthorized:
+				return nil, ErrInvalidPAT
+			case http.StatusBadRequest:
+				return nil, fmt.Errorf("the query %q is not valid: %s", jql, err)
+			}
+
+			return nil, fmt.Errorf("could not search issues: %s", err)
+		}
+
+		for _, issue := range found {
+			issues = append(issues, j.goJiraIssueToIssue(issue))
+		}
+
+		if res == nil || len(found) < searchPageSize || len(issues) >= res.Total {
+			break
+		}
+	}
+
+	return issues, nil
+}
+
+// TransitionIssue moves the issue through the transition with the given name, which can also
+// be the name of the status the transition leads to
+func (j *Jira) TransitionIssue(ctx context.Context, identifier string, transition string) (err error) {
+	ctx, cancel := j.withTimeout(ctx)
+	defer cancel()
+
+	transitions, res, err := j.client.getTransitions(ctx, identifier)
+	if err != nil {
+		if res == nil {
+			return j.errNoResponse(ctx)
+		}
+
+		switch res.StatusCode {
+		case http.StatusUnauthorized:
+			return ErrInvalidPAT
+		case http.StatusNotFound:
+			return domain.ErrIssueNotFound
+		}
+
+		return fmt.Errorf("could not get the transitions of the issue: %s", err)
+	}
+
+	available := make([]string, 0, len(transitions))
+	for _, t := range transitions {
+		if strings.EqualFold(t.Name, transition) || strings.EqualFold(t.To.Name, transition) {
+			if res, err := j.client.doTransition(ctx, identifier, t.ID); err != nil {
+				if res == nil {
+					return j.errNoResponse(ctx)
+				}
+				if res.StatusCode == http.StatusForbidden {
+					return errForbidden("transition this issue")
+				}
+				return fmt.Errorf("could not transition the issue: %s", err)
+			}
+			return nil
+		}
+		available = append(available, t.Name)
+	}
+
+	return fmt.Errorf("the issue %s has no transition named %q, the available transitions are: %s", identifier, transition, strings.Join(available, ", "))
+}
+
+// withTimeout returns the context of an operation in Jira, limited to the time set in the
+// jira.timeout setting if it is not 0
+func (j *Jira) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if j.cfg.Timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, j.cfg.Timeout)
+}
+
+// errNoResponse returns the error of a request that got no response, which is the error of the
+// context if it has been cancelled or it has timed out
+func (j *Jira) errNoResponse(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("the request to '%s' did not finish: %w", j.cfg.Auth.Host, err)
+	}
+
+	return ErrNoResponse(j.cfg.Auth.Host)
+}
+
 func (j *Jira) IdentifyIssue(identifier string) bool {
 	return issuePattern.MatchString(identifier)
 }
@@ -92,6 +226,14 @@ func (j *Jira) goJiraIssueToIssue(issue gojira.Issue) domain.Issue {
 
 	issueType := j.getIssueType(issue.Fields.Type.ID)
 
+	var status JiraStatus
+	if issue.Fields.Status != nil {
+		status = JiraStatus{
+			Name:        issue.Fields.Status.Name,
+			CategoryKey: issue.Fields.Status.StatusCategory.Key,
+		}
+	}
+
 	return Issue{
 		id:    issue.Key,
 		title: issue.Fields.Summary,
@@ -104,6 +246,7 @@ func (j *Jira) goJiraIssueToIssue(issue gojira.Issue) domain.Issue {
 		},
 		issueType: issueType,
 		typeLabel: j.getIssueTypeLabel(issueType),
+		status:    status,
 	}
 }
 
@@ -1,11 +1,14 @@
 package jira
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/InditexTech/gh-sherpa/internal/config"
+	"github.com/InditexTech/gh-sherpa/internal/domain"
 	"github.com/InditexTech/gh-sherpa/internal/domain/issue_types"
 	gojira "github.com/andygrunwald/go-jira"
 	"github.com/stretchr/testify/suite"
@@ -15,6 +18,15 @@ type fakeClient struct {
 	issue    *gojira.Issue
 	response *gojira.Response
 	err      error
+	lastJQL  string
+	// pages holds the results of a paginated search, when it is set the issue is ignored
+	pages    [][]gojira.Issue
+	startAts []int
+	// transitions are the available transitions of the issue, doneTransitions the ones applied
+	transitions     []gojira.Transition
+	doneTransitions []string
+	// hang makes the requests wait until their context is done, like a host that does not answer
+	hang bool
 }
 
 func (f *fakeClient) setError() {
@@ -50,10 +62,43 @@ func (f *fakeClient) setResponse(statusCode int) {
 	}
 }
 
-func (f *fakeClient) getIssue(identifier string) (*gojira.Issue, *gojira.Response, error) {
+func (f *fakeClient) getIssue(ctx context.Context, identifier string) (*gojira.Issue, *gojira.Response, error) {
+	if f.hang {
+		<-ctx.Done()
+		return nil, nil, ctx.Err()
+	}
 	return f.issue, f.response, f.err
 }
 
+func (f *fakeClient) searchIssues(_ context.Context, jql string, startAt int) ([]gojira.Issue, *gojira.Response, error) {
+	f.lastJQL = jql
+	f.startAts = append(f.startAts, startAt)
+	if f.pages != nil {
+		total := 0
+		for _, page := range f.pages {
+			total += len(page)
+		}
+		page := f.pages[len(f.startAts)-1]
+		return page, &gojira.Response{StartAt: startAt, MaxResults: searchPageSize, Total: total}, nil
+	}
+	if f.err != nil || f.issue == nil {
+		return nil, f.response, f.err
+	}
+	return []gojira.Issue{*f.issue}, f.response, nil
+}
+
+func (f *fakeClient) getTransitions(_ context.Context, issueID string) ([]gojira.Transition, *gojira.Response, error) {
+	if f.err != nil {
+		return nil, f.response, f.err
+	}
+	return f.transitions, f.response, nil
+}
+
+func (f *fakeClient) doTransition(_ context.Context, issueID string, transitionID string) (*gojira.Response, error) {
+	f.doneTransitions = append(f.doneTransitions, transitionID)
+	return f.response, nil
+}
+
 type JiraTestSuite struct {
 	suite.Suite
 	jira               *Jira
@@ -127,16 +172,64 @@ func (s *JiraTestSuite) TestGetIssue() {
 	s.Run("should return error if could not execute", func() {
 		s.fakeClient.setError()
 
-		issue, err := s.jira.GetIssue(s.defaultKey)
+		issue, err := s.jira.GetIssue(context.Background(), s.defaultKey)
 
 		s.Error(err)
 		s.Nil(issue)
 	})
 
+	s.Run("should return a not found error if the issue does not exist", func() {
+		s.fakeClient.setError()
+		s.fakeClient.setResponse(http.StatusNotFound)
+
+		_, err := s.jira.GetIssue(context.Background(), s.defaultKey)
+
+		s.ErrorIs(err, domain.ErrIssueNotFound)
+	})
+
+	s.Run("should return an auth error if the PAT is not valid", func() {
+		s.fakeClient.setError()
+		s.fakeClient.setResponse(http.StatusUnauthorized)
+
+		_, err := s.jira.GetIssue(context.Background(), s.defaultKey)
+
+		s.ErrorIs(err, domain.ErrAuthFailed)
+	})
+
+	s.Run("should return a network error if the host does not respond", func() {
+		s.fakeClient.setError()
+		s.fakeClient.response = nil
+
+		_, err := s.jira.GetIssue(context.Background(), s.defaultKey)
+
+		s.ErrorIs(err, domain.ErrNetwork)
+	})
+
+	s.Run("should return a timeout error if the host does not answer in time", func() {
+		s.fakeClient.hang = true
+		s.jira.cfg.Timeout = time.Millisecond
+
+		_, err := s.jira.GetIssue(context.Background(), s.defaultKey)
+
+		s.ErrorIs(err, context.DeadlineExceeded)
+		s.Equal(domain.ErrorCodeTimeout, domain.ErrorCodeOf(err))
+	})
+
+	s.Run("should return a cancelled error if the context is cancelled", func() {
+		s.fakeClient.hang = true
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := s.jira.GetIssue(ctx, s.defaultKey)
+
+		s.ErrorIs(err, context.Canceled)
+		s.Equal(domain.ErrorCodeCancelled, domain.ErrorCodeOf(err))
+	})
+
 	s.Run("should return bug issue", func() {
 		s.fakeClient.changeIssueType("1")
 
-		issue, err := s.jira.GetIssue(s.defaultKey)
+		issue, err := s.jira.GetIssue(context.Background(), s.defaultKey)
 
 		s.NoError(err)
 		s.Require().NotNil(issue)
@@ -148,7 +241,7 @@ func (s *JiraTestSuite) TestGetIssue() {
 
 		s.fakeClient.changeIssueType("99")
 
-		issue, err := s.jira.GetIssue(s.defaultKey)
+		issue, err := s.jira.GetIssue(context.Background(), s.defaultKey)
 
 		s.NoError(err)
 		s.Require().NotNil(issue)
@@ -156,10 +249,105 @@ func (s *JiraTestSuite) TestGetIssue() {
 	})
 
 	s.Run("should return issue", func() {
-		issue, err := s.jira.GetIssue(s.defaultKey)
+		issue, err := s.jira.GetIssue(context.Background(), s.defaultKey)
 
 		s.NoError(err)
 		s.Require().NotNil(s.expectedIssue)
 		s.Equal(*s.expectedIssue, issue)
 	})
 }
+
+func (s *JiraTestSuite) TestGetAssignedIssues() {
+	s.Run("should return error if the PAT is not valid", func() {
+		s.fakeClient.setError()
+		s.fakeClient.setResponse(http.StatusUnauthorized)
+
+		issues, err := s.jira.GetAssignedIssues(context.Background())
+
+		s.ErrorContains(err, "your PAT is invalid or revoked")
+		s.Nil(issues)
+	})
+
+	s.Run("should return the issues assigned to the current user that are not done", func() {
+		issues, err := s.jira.GetAssignedIssues(context.Background())
+
+		s.NoError(err)
+		s.Equal([]domain.Issue{*s.expectedIssue}, issues)
+		s.Equal("assignee = currentUser() AND statusCategory != Done ORDER BY updated DESC", s.fakeClient.lastJQL)
+	})
+}
+
+func (s *JiraTestSuite) TestSearchIssues() {
+	s.Run("should return error if the query is not valid", func() {
+		s.fakeClient.setError()
+		s.fakeClient.setResponse(http.StatusBadRequest)
+
+		issues, err := s.jira.SearchIssues(context.Background(), "sprint in")
+
+		s.ErrorContains(err, `the query "sprint in" is not valid`)
+		s.Nil(issues)
+	})
+
+	s.Run("should request all the pages of results", func() {
+		newPage := func(size int) []gojira.Issue {
+			page := make([]gojira.Issue, size)
+			for i := range page {
+				page[i] = *s.fakeClient.issue
+			}
+			return page
+		}
+		s.fakeClient.pages = [][]gojira.Issue{newPage(searchPageSize), newPage(searchPageSize), newPage(1)}
+
+		issues, err := s.jira.SearchIssues(context.Background(), "sprint in openSprints()")
+
+		s.NoError(err)
+		s.Len(issues, 2*searchPageSize+1)
+		s.Equal([]int{0, searchPageSize, 2 * searchPageSize}, s.fakeClient.startAts)
+		s.Equal("sprint in openSprints()", s.fakeClient.lastJQL)
+	})
+}
+
+func (s *JiraTestSuite) TestTransitionIssue() {
+	setTransitions := func() {
+		s.fakeClient.transitions = []gojira.Transition{
+			{ID: "11", Name: "Start progress", To: gojira.Status{Name: "In Progress"}},
+			{ID: "21", Name: "Ask for review", To: gojira.Status{Name: "In Review"}},
+		}
+	}
+
+	s.Run("should apply the transition with the given name", func() {
+		setTransitions()
+
+		err := s.jira.TransitionIssue(context.Background(), s.defaultKey, "ask for review")
+
+		s.NoError(err)
+		s.Equal([]string{"21"}, s.fakeClient.doneTransitions)
+	})
+
+	s.Run("should apply the transition to the status with the given name", func() {
+		setTransitions()
+
+		err := s.jira.TransitionIssue(context.Background(), s.defaultKey, "In Review")
+
+		s.NoError(err)
+		s.Equal([]string{"21"}, s.fakeClient.doneTransitions)
+	})
+
+	s.Run("should return error if there is no transition with the given name", func() {
+		setTransitions()
+
+		err := s.jira.TransitionIssue(context.Background(), s.defaultKey, "Done")
+
+		s.ErrorContains(err, `the issue PROJECTKEY-1 has no transition named "Done", the available transitions are: Start progress, Ask for review`)
+		s.Empty(s.fakeClient.doneTransitions)
+	})
+
+	s.Run("should return error if the PAT is not valid", func() {
+		s.fakeClient.setError()
+		s.fakeClient.setResponse(http.StatusUnauthorized)
+
+		err := s.jira.TransitionIssue(context.Background(), s.defaultKey, "In Review")
+
+		s.ErrorContains(err, "your PAT is invalid or revoked")
+	})
+}
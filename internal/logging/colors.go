@@ -43,15 +43,15 @@ func PaintItalic(message string) string {
 
 // Print an info message.
 func PrintInfo(message string) {
-	fmt.Println(info.Paint("INFO:", message))
+	fmt.Fprintln(output, info.Paint("INFO:", message))
 }
 
 // Print a warning message.
 func PrintWarn(message string) {
-	fmt.Println(warning.Paint("WARNING:", message))
+	fmt.Fprintln(output, warning.Paint("WARNING:", message))
 }
 
 // Print an error message.
 func PrintError(message string) {
-	fmt.Println(err.Paint("ERROR:", message))
+	fmt.Fprintln(output, err.Paint("ERROR:", message))
 }
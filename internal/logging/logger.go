@@ -0,0 +1,174 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Options configures the logs of sherpa
+type Options struct {
+	Level  string // --log-level: debug, info, warn or error. It is debug if SHERPA_DEBUG is set and info otherwise
+	Format string // --log-format: text or json
+	// File is the log file, written besides stderr. There is no log file if it is nil.
+	File *FileOptions
+}
+
+// FileOptions configures the log file
+type FileOptions struct {
+	Path       string
+	Level      string
+	MaxSize    int64 // bytes before rotating the file, 0 to never rotate it
+	MaxBackups int
+}
+
+var logger = newDefaultLogger()
+
+// logFile is the open log file, closed when the logs are configured again
+var logFile io.Closer
+
+func newDefaultLogger() *slog.Logger {
+	level := slog.LevelInfo
+	if os.Getenv(envDebug) != "" {
+		level = slog.LevelDebug
+	}
+
+	return slog.New(newHandler(os.Stderr, FormatText, level))
+}
+
+// Logger returns the logger of sherpa, which writes to stderr and to the log file if it is enabled
+func Logger() *slog.Logger {
+	return logger
+}
+
+// Configure sets up the logs with the given options. It can be called again, e.g. to add the log
+// file once the configuration is loaded.
+func Configure(opts Options) error {
+	level, err := parseLevel(opts.Level)
+	if err != nil {
+		return err
+	}
+	if opts.Level == "" && os.Getenv(envDebug) != "" {
+		level = slog.LevelDebug
+	}
+
+	format := opts.Format
+	if format == "" {
+		format = FormatText
+	}
+	if format != FormatText && format != FormatJSON {
+		return fmt.Errorf("invalid log format %q, it must be text or json", opts.Format)
+	}
+
+	handlers := []slog.Handler{newHandler(os.Stderr, format, level)}
+
+	var file *rotatingFile
+	if opts.File != nil {
+		fileLevel, err := parseLevel(opts.File.Level)
+		if err != nil {
+			return err
+		}
+		if file, err = openRotatingFile(opts.File.Path, opts.File.MaxSize, opts.File.MaxBackups); err != nil {
+			return fmt.Errorf("could not open the log file: %w", err)
+		}
+		handlers = append(handlers, newHandler(file, FormatJSON, fileLevel))
+	}
+
+	if logFile != nil {
+		logFile.Close()
+		logFile = nil
+	}
+	if file != nil {
+		logFile = file
+	}
+
+	logger = slog.New(fanoutHandler(handlers))
+
+	return nil
+}
+
+// Close closes the log file if it is open
+func Close() error {
+	if logFile == nil {
+		return nil
+	}
+
+	err := logFile.Close()
+	logFile = nil
+	return err
+}
+
+func parseLevel(level string) (slog.Level, error) {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("invalid log level %q, it must be debug, info, warn or error", level)
+	}
+}
+
+func newHandler(w io.Writer, format string, level slog.Level) slog.Handler {
+	opts := &slog.HandlerOptions{Level: level, ReplaceAttr: redactAttr}
+	if format == FormatJSON {
+		return slog.NewJSONHandler(w, opts)
+	}
+
+	return slog.NewTextHandler(w, opts)
+}
+
+// fanoutHandler sends the records to all the handlers that are enabled for their level
+type fanoutHandler []slog.Handler
+
+func (h fanoutHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, handler := range h {
+		if handler.Enabled(ctx, level) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h fanoutHandler) Handle(ctx context.Context, record slog.Record) error {
+	var errs []error
+	for _, handler := range h {
+		if handler.Enabled(ctx, record.Level) {
+			errs = append(errs, handler.Handle(ctx, record.Clone()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (h fanoutHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make(fanoutHandler, len(h))
+	for i, handler := range h {
+		handlers[i] = handler.WithAttrs(attrs)
+	}
+
+	return handlers
+}
+
+func (h fanoutHandler) WithGroup(name string) slog.Handler {
+	handlers := make(fanoutHandler, len(h))
+	for i, handler := range h {
+		handlers[i] = handler.WithGroup(name)
+	}
+
+	return handlers
+}
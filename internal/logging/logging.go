@@ -1,42 +1,59 @@
 package logging
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"log/slog"
 	"os"
+	"strings"
 )
 
+// envDebug enables the debug logs if --log-level is not set
 const envDebug = "SHERPA_DEBUG"
 
-func Debug(message ...string) {
-	if os.Getenv(envDebug) == "" {
-		return
-	}
+// output is where the messages for the user are printed
+var output io.Writer = os.Stdout
+
+// SetOutput sets where the messages for the user are printed. The commands print them to stderr
+// when they print their result as JSON, so the standard output only contains the JSON.
+func SetOutput(w io.Writer) {
+	output = w
+}
+
+// Output returns where the messages for the user are printed
+func Output() io.Writer {
+	return output
+}
 
-	fmt.Printf("DEBUG: %s\n", message)
+// Debug logs the message at debug level
+func Debug(message ...string) {
+	logger.Debug(strings.Join(message, " "))
 }
 
+// Debugf logs the formatted message at debug level
 func Debugf(message string, args ...interface{}) {
-	if os.Getenv(envDebug) == "" {
+	if !logger.Enabled(context.Background(), slog.LevelDebug) {
 		return
 	}
 
-	fmt.Printf("DEBUG: %s\n", fmt.Sprintf(message, args...))
+	logger.Debug(fmt.Sprintf(message, args...))
 }
 
 func Info(message string) {
-	fmt.Println(PaintInfo(message))
+	fmt.Fprintln(output, PaintInfo(message))
 }
 
 func Error(message string) {
-	fmt.Println(PaintError("ERROR: " + message))
+	fmt.Fprintln(output, PaintError("ERROR: "+message))
 }
 
 func Errorf(message string, args ...interface{}) {
-	fmt.Println(PaintError("ERROR: " + fmt.Sprintf(message, args...)))
+	fmt.Fprintln(output, PaintError("ERROR: "+fmt.Sprintf(message, args...)))
 }
 
 func PrintCommandHeader(command string) {
-	fmt.Printf("\n=> Running %s command in %s.\n\n",
+	fmt.Fprintf(output, "\n=> Running %s command in %s.\n\n",
 		PaintInfo(command),
 		PaintInfo("Sherpa"),
 	)
@@ -0,0 +1,73 @@
+package logging
+
+import (
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// secretKeys are the names of the attributes and headers whose values are secrets. The names that
+// contain them, like jira_token or Proxy-Authorization, are secret too.
+var secretKeys = []string{"token", "authorization", "password", "secret", "cookie"}
+
+// secretPatterns match the secrets inside the logged text, the first group is kept
+var secretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)(authorization["']?\s*[:=]\s*\[?["']?(?:bearer|token|basic)\s+)[^\s"'\]]+`),
+	regexp.MustCompile(`(?i)((?:token|password|access_token)=)[^&\s"']+`),
+	regexp.MustCompile(`()\b(?:gh[pousr]_[A-Za-z0-9]{20,}|github_pat_[A-Za-z0-9_]{20,})`),
+}
+
+// Redact returns the text with the tokens, passwords and Authorization headers replaced by [REDACTED]
+func Redact(text string) string {
+	for _, pattern := range secretPatterns {
+		text = pattern.ReplaceAllString(text, "${1}"+redacted)
+	}
+
+	return text
+}
+
+// redactAttr is the slog.HandlerOptions.ReplaceAttr function that removes the secrets from the logs
+func redactAttr(_ []string, a slog.Attr) slog.Attr {
+	if isSecretKey(a.Key) {
+		return slog.String(a.Key, redacted)
+	}
+
+	switch a.Value.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, Redact(a.Value.String()))
+	case slog.KindAny:
+		if header, ok := a.Value.Any().(http.Header); ok {
+			return slog.Any(a.Key, redactHeader(header))
+		}
+	}
+
+	return a
+}
+
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	if key == "pat" {
+		return true
+	}
+	for _, secret := range secretKeys {
+		if strings.Contains(key, secret) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func redactHeader(header http.Header) http.Header {
+	redactedHeader := header.Clone()
+	for name := range redactedHeader {
+		if isSecretKey(name) {
+			redactedHeader[name] = []string{redacted}
+		}
+	}
+
+	return redactedHeader
+}
@@ -0,0 +1,42 @@
+package logging
+
+import (
+	"net/http"
+	"time"
+)
+
+// transport traces the HTTP requests in the debug logs, without their secrets
+type transport struct {
+	name string
+	next http.RoundTripper
+}
+
+// NewTransport returns an http.RoundTripper that traces the requests done with next in the debug
+// logs. The name identifies the service, e.g. jira.
+func NewTransport(name string, next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &transport{name: name, next: next}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	res, err := t.next.RoundTrip(req)
+
+	attrs := []any{
+		"service", t.name,
+		"method", req.Method,
+		"url", req.URL.String(),
+		"headers", req.Header,
+		"duration", time.Since(start),
+	}
+	if err != nil {
+		logger.Debug("HTTP request failed", append(attrs, "error", err.Error())...)
+		return res, err
+	}
+	logger.Debug("HTTP request", append(attrs, "status", res.StatusCode)...)
+
+	return res, nil
+}
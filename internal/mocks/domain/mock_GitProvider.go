@@ -2,7 +2,12 @@
 
 package domain
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	domain "github.com/InditexTech/gh-sherpa/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockGitProvider is an autogenerated mock type for the GitProvider type
 type MockGitProvider struct {
@@ -17,13 +22,58 @@ func (_m *MockGitProvider) EXPECT() *MockGitProvider_Expecter {
 	return &MockGitProvider_Expecter{mock: &_m.Mock}
 }
 
-// BranchExists provides a mock function with given fields: branch
-func (_m *MockGitProvider) BranchExists(branch string) bool {
-	ret := _m.Called(branch)
+// AddWorktree provides a mock function with given fields: ctx, path, branch, base
+func (_m *MockGitProvider) AddWorktree(ctx context.Context, path string, branch string, base string) error {
+	ret := _m.Called(ctx, path, branch, base)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, path, branch, base)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitProvider_AddWorktree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddWorktree'
+type MockGitProvider_AddWorktree_Call struct {
+	*mock.Call
+}
+
+// AddWorktree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - branch string
+//   - base string
+func (_e *MockGitProvider_Expecter) AddWorktree(ctx interface{}, path interface{}, branch interface{}, base interface{}) *MockGitProvider_AddWorktree_Call {
+	return &MockGitProvider_AddWorktree_Call{Call: _e.mock.On("AddWorktree", ctx, path, branch, base)}
+}
+
+func (_c *MockGitProvider_AddWorktree_Call) Run(run func(ctx context.Context, path string, branch string, base string)) *MockGitProvider_AddWorktree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_AddWorktree_Call) Return(err error) *MockGitProvider_AddWorktree_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGitProvider_AddWorktree_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockGitProvider_AddWorktree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BranchExists provides a mock function with given fields: ctx, branch
+func (_m *MockGitProvider) BranchExists(ctx context.Context, branch string) bool {
+	ret := _m.Called(ctx, branch)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(string) bool); ok {
-		r0 = rf(branch)
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, branch)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
@@ -37,14 +87,15 @@ type MockGitProvider_BranchExists_Call struct {
 }
 
 // BranchExists is a helper method to define mock.On call
+//   - ctx context.Context
 //   - branch string
-func (_e *MockGitProvider_Expecter) BranchExists(branch interface{}) *MockGitProvider_BranchExists_Call {
-	return &MockGitProvider_BranchExists_Call{Call: _e.mock.On("BranchExists", branch)}
+func (_e *MockGitProvider_Expecter) BranchExists(ctx interface{}, branch interface{}) *MockGitProvider_BranchExists_Call {
+	return &MockGitProvider_BranchExists_Call{Call: _e.mock.On("BranchExists", ctx, branch)}
 }
 
-func (_c *MockGitProvider_BranchExists_Call) Run(run func(branch string)) *MockGitProvider_BranchExists_Call {
+func (_c *MockGitProvider_BranchExists_Call) Run(run func(ctx context.Context, branch string)) *MockGitProvider_BranchExists_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -54,18 +105,18 @@ func (_c *MockGitProvider_BranchExists_Call) Return(_a0 bool) *MockGitProvider_B
 	return _c
 }
 
-func (_c *MockGitProvider_BranchExists_Call) RunAndReturn(run func(string) bool) *MockGitProvider_BranchExists_Call {
+func (_c *MockGitProvider_BranchExists_Call) RunAndReturn(run func(context.Context, string) bool) *MockGitProvider_BranchExists_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CheckoutBranch provides a mock function with given fields: branch
-func (_m *MockGitProvider) CheckoutBranch(branch string) error {
-	ret := _m.Called(branch)
+// CheckoutBranch provides a mock function with given fields: ctx, branch
+func (_m *MockGitProvider) CheckoutBranch(ctx context.Context, branch string) error {
+	ret := _m.Called(ctx, branch)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(branch)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, branch)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -79,14 +130,15 @@ type MockGitProvider_CheckoutBranch_Call struct {
 }
 
 // CheckoutBranch is a helper method to define mock.On call
+//   - ctx context.Context
 //   - branch string
-func (_e *MockGitProvider_Expecter) CheckoutBranch(branch interface{}) *MockGitProvider_CheckoutBranch_Call {
-	return &MockGitProvider_CheckoutBranch_Call{Call: _e.mock.On("CheckoutBranch", branch)}
+func (_e *MockGitProvider_Expecter) CheckoutBranch(ctx interface{}, branch interface{}) *MockGitProvider_CheckoutBranch_Call {
+	return &MockGitProvider_CheckoutBranch_Call{Call: _e.mock.On("CheckoutBranch", ctx, branch)}
 }
 
-func (_c *MockGitProvider_CheckoutBranch_Call) Run(run func(branch string)) *MockGitProvider_CheckoutBranch_Call {
+func (_c *MockGitProvider_CheckoutBranch_Call) Run(run func(ctx context.Context, branch string)) *MockGitProvider_CheckoutBranch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -96,18 +148,18 @@ func (_c *MockGitProvider_CheckoutBranch_Call) Return(err error) *MockGitProvide
 	return _c
 }
 
-func (_c *MockGitProvider_CheckoutBranch_Call) RunAndReturn(run func(string) error) *MockGitProvider_CheckoutBranch_Call {
+func (_c *MockGitProvider_CheckoutBranch_Call) RunAndReturn(run func(context.Context, string) error) *MockGitProvider_CheckoutBranch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CheckoutNewBranchFromOrigin provides a mock function with given fields: branch, base
-func (_m *MockGitProvider) CheckoutNewBranchFromOrigin(branch string, base string) error {
-	ret := _m.Called(branch, base)
+// CheckoutNewBranchFromOrigin provides a mock function with given fields: ctx, branch, base
+func (_m *MockGitProvider) CheckoutNewBranchFromOrigin(ctx context.Context, branch string, base string) error {
+	ret := _m.Called(ctx, branch, base)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(branch, base)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, branch, base)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -121,15 +173,16 @@ type MockGitProvider_CheckoutNewBranchFromOrigin_Call struct {
 }
 
 // CheckoutNewBranchFromOrigin is a helper method to define mock.On call
+//   - ctx context.Context
 //   - branch string
 //   - base string
-func (_e *MockGitProvider_Expecter) CheckoutNewBranchFromOrigin(branch interface{}, base interface{}) *MockGitProvider_CheckoutNewBranchFromOrigin_Call {
-	return &MockGitProvider_CheckoutNewBranchFromOrigin_Call{Call: _e.mock.On("CheckoutNewBranchFromOrigin", branch, base)}
+func (_e *MockGitProvider_Expecter) CheckoutNewBranchFromOrigin(ctx interface{}, branch interface{}, base interface{}) *MockGitProvider_CheckoutNewBranchFromOrigin_Call {
+	return &MockGitProvider_CheckoutNewBranchFromOrigin_Call{Call: _e.mock.On("CheckoutNewBranchFromOrigin", ctx, branch, base)}
 }
 
-func (_c *MockGitProvider_CheckoutNewBranchFromOrigin_Call) Run(run func(branch string, base string)) *MockGitProvider_CheckoutNewBranchFromOrigin_Call {
+func (_c *MockGitProvider_CheckoutNewBranchFromOrigin_Call) Run(run func(ctx context.Context, branch string, base string)) *MockGitProvider_CheckoutNewBranchFromOrigin_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -139,18 +192,61 @@ func (_c *MockGitProvider_CheckoutNewBranchFromOrigin_Call) Return(err error) *M
 	return _c
 }
 
-func (_c *MockGitProvider_CheckoutNewBranchFromOrigin_Call) RunAndReturn(run func(string, string) error) *MockGitProvider_CheckoutNewBranchFromOrigin_Call {
+func (_c *MockGitProvider_CheckoutNewBranchFromOrigin_Call) RunAndReturn(run func(context.Context, string, string) error) *MockGitProvider_CheckoutNewBranchFromOrigin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckoutRemoteBranch provides a mock function with given fields: ctx, branch
+func (_m *MockGitProvider) CheckoutRemoteBranch(ctx context.Context, branch string) error {
+	ret := _m.Called(ctx, branch)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, branch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitProvider_CheckoutRemoteBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckoutRemoteBranch'
+type MockGitProvider_CheckoutRemoteBranch_Call struct {
+	*mock.Call
+}
+
+// CheckoutRemoteBranch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - branch string
+func (_e *MockGitProvider_Expecter) CheckoutRemoteBranch(ctx interface{}, branch interface{}) *MockGitProvider_CheckoutRemoteBranch_Call {
+	return &MockGitProvider_CheckoutRemoteBranch_Call{Call: _e.mock.On("CheckoutRemoteBranch", ctx, branch)}
+}
+
+func (_c *MockGitProvider_CheckoutRemoteBranch_Call) Run(run func(ctx context.Context, branch string)) *MockGitProvider_CheckoutRemoteBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_CheckoutRemoteBranch_Call) Return(err error) *MockGitProvider_CheckoutRemoteBranch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGitProvider_CheckoutRemoteBranch_Call) RunAndReturn(run func(context.Context, string) error) *MockGitProvider_CheckoutRemoteBranch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CommitEmpty provides a mock function with given fields: message
-func (_m *MockGitProvider) CommitEmpty(message string) error {
-	ret := _m.Called(message)
+// CommitEmpty provides a mock function with given fields: ctx, message, signoff
+func (_m *MockGitProvider) CommitEmpty(ctx context.Context, message string, signoff bool) error {
+	ret := _m.Called(ctx, message, signoff)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(message)
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, message, signoff)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -164,14 +260,16 @@ type MockGitProvider_CommitEmpty_Call struct {
 }
 
 // CommitEmpty is a helper method to define mock.On call
+//   - ctx context.Context
 //   - message string
-func (_e *MockGitProvider_Expecter) CommitEmpty(message interface{}) *MockGitProvider_CommitEmpty_Call {
-	return &MockGitProvider_CommitEmpty_Call{Call: _e.mock.On("CommitEmpty", message)}
+//   - signoff bool
+func (_e *MockGitProvider_Expecter) CommitEmpty(ctx interface{}, message interface{}, signoff interface{}) *MockGitProvider_CommitEmpty_Call {
+	return &MockGitProvider_CommitEmpty_Call{Call: _e.mock.On("CommitEmpty", ctx, message, signoff)}
 }
 
-func (_c *MockGitProvider_CommitEmpty_Call) Run(run func(message string)) *MockGitProvider_CommitEmpty_Call {
+func (_c *MockGitProvider_CommitEmpty_Call) Run(run func(ctx context.Context, message string, signoff bool)) *MockGitProvider_CommitEmpty_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
 	})
 	return _c
 }
@@ -181,18 +279,105 @@ func (_c *MockGitProvider_CommitEmpty_Call) Return(err error) *MockGitProvider_C
 	return _c
 }
 
-func (_c *MockGitProvider_CommitEmpty_Call) RunAndReturn(run func(string) error) *MockGitProvider_CommitEmpty_Call {
+func (_c *MockGitProvider_CommitEmpty_Call) RunAndReturn(run func(context.Context, string, bool) error) *MockGitProvider_CommitEmpty_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBranch provides a mock function with given fields: ctx, branch, force
+func (_m *MockGitProvider) DeleteBranch(ctx context.Context, branch string, force bool) error {
+	ret := _m.Called(ctx, branch, force)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, branch, force)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitProvider_DeleteBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBranch'
+type MockGitProvider_DeleteBranch_Call struct {
+	*mock.Call
+}
+
+// DeleteBranch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - branch string
+//   - force bool
+func (_e *MockGitProvider_Expecter) DeleteBranch(ctx interface{}, branch interface{}, force interface{}) *MockGitProvider_DeleteBranch_Call {
+	return &MockGitProvider_DeleteBranch_Call{Call: _e.mock.On("DeleteBranch", ctx, branch, force)}
+}
+
+func (_c *MockGitProvider_DeleteBranch_Call) Run(run func(ctx context.Context, branch string, force bool)) *MockGitProvider_DeleteBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_DeleteBranch_Call) Return(err error) *MockGitProvider_DeleteBranch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGitProvider_DeleteBranch_Call) RunAndReturn(run func(context.Context, string, bool) error) *MockGitProvider_DeleteBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRemoteBranch provides a mock function with given fields: ctx, branch
+func (_m *MockGitProvider) DeleteRemoteBranch(ctx context.Context, branch string) error {
+	ret := _m.Called(ctx, branch)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, branch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitProvider_DeleteRemoteBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRemoteBranch'
+type MockGitProvider_DeleteRemoteBranch_Call struct {
+	*mock.Call
+}
+
+// DeleteRemoteBranch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - branch string
+func (_e *MockGitProvider_Expecter) DeleteRemoteBranch(ctx interface{}, branch interface{}) *MockGitProvider_DeleteRemoteBranch_Call {
+	return &MockGitProvider_DeleteRemoteBranch_Call{Call: _e.mock.On("DeleteRemoteBranch", ctx, branch)}
+}
+
+func (_c *MockGitProvider_DeleteRemoteBranch_Call) Run(run func(ctx context.Context, branch string)) *MockGitProvider_DeleteRemoteBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_DeleteRemoteBranch_Call) Return(err error) *MockGitProvider_DeleteRemoteBranch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGitProvider_DeleteRemoteBranch_Call) RunAndReturn(run func(context.Context, string) error) *MockGitProvider_DeleteRemoteBranch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FetchBranchFromOrigin provides a mock function with given fields: branch
-func (_m *MockGitProvider) FetchBranchFromOrigin(branch string) error {
-	ret := _m.Called(branch)
+// FetchBranchFromOrigin provides a mock function with given fields: ctx, branch
+func (_m *MockGitProvider) FetchBranchFromOrigin(ctx context.Context, branch string) error {
+	ret := _m.Called(ctx, branch)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(branch)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, branch)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -206,14 +391,15 @@ type MockGitProvider_FetchBranchFromOrigin_Call struct {
 }
 
 // FetchBranchFromOrigin is a helper method to define mock.On call
+//   - ctx context.Context
 //   - branch string
-func (_e *MockGitProvider_Expecter) FetchBranchFromOrigin(branch interface{}) *MockGitProvider_FetchBranchFromOrigin_Call {
-	return &MockGitProvider_FetchBranchFromOrigin_Call{Call: _e.mock.On("FetchBranchFromOrigin", branch)}
+func (_e *MockGitProvider_Expecter) FetchBranchFromOrigin(ctx interface{}, branch interface{}) *MockGitProvider_FetchBranchFromOrigin_Call {
+	return &MockGitProvider_FetchBranchFromOrigin_Call{Call: _e.mock.On("FetchBranchFromOrigin", ctx, branch)}
 }
 
-func (_c *MockGitProvider_FetchBranchFromOrigin_Call) Run(run func(branch string)) *MockGitProvider_FetchBranchFromOrigin_Call {
+func (_c *MockGitProvider_FetchBranchFromOrigin_Call) Run(run func(ctx context.Context, branch string)) *MockGitProvider_FetchBranchFromOrigin_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -223,28 +409,28 @@ func (_c *MockGitProvider_FetchBranchFromOrigin_Call) Return(err error) *MockGit
 	return _c
 }
 
-func (_c *MockGitProvider_FetchBranchFromOrigin_Call) RunAndReturn(run func(string) error) *MockGitProvider_FetchBranchFromOrigin_Call {
+func (_c *MockGitProvider_FetchBranchFromOrigin_Call) RunAndReturn(run func(context.Context, string) error) *MockGitProvider_FetchBranchFromOrigin_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindBranch provides a mock function with given fields: substring
-func (_m *MockGitProvider) FindBranch(substring string) (string, bool) {
-	ret := _m.Called(substring)
+// FindBranch provides a mock function with given fields: ctx, substring
+func (_m *MockGitProvider) FindBranch(ctx context.Context, substring string) (string, bool) {
+	ret := _m.Called(ctx, substring)
 
 	var r0 string
 	var r1 bool
-	if rf, ok := ret.Get(0).(func(string) (string, bool)); ok {
-		return rf(substring)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, bool)); ok {
+		return rf(ctx, substring)
 	}
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(substring)
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, substring)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) bool); ok {
-		r1 = rf(substring)
+	if rf, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = rf(ctx, substring)
 	} else {
 		r1 = ret.Get(1).(bool)
 	}
@@ -258,14 +444,15 @@ type MockGitProvider_FindBranch_Call struct {
 }
 
 // FindBranch is a helper method to define mock.On call
+//   - ctx context.Context
 //   - substring string
-func (_e *MockGitProvider_Expecter) FindBranch(substring interface{}) *MockGitProvider_FindBranch_Call {
-	return &MockGitProvider_FindBranch_Call{Call: _e.mock.On("FindBranch", substring)}
+func (_e *MockGitProvider_Expecter) FindBranch(ctx interface{}, substring interface{}) *MockGitProvider_FindBranch_Call {
+	return &MockGitProvider_FindBranch_Call{Call: _e.mock.On("FindBranch", ctx, substring)}
 }
 
-func (_c *MockGitProvider_FindBranch_Call) Run(run func(substring string)) *MockGitProvider_FindBranch_Call {
+func (_c *MockGitProvider_FindBranch_Call) Run(run func(ctx context.Context, substring string)) *MockGitProvider_FindBranch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -275,30 +462,244 @@ func (_c *MockGitProvider_FindBranch_Call) Return(branch string, exists bool) *M
 	return _c
 }
 
-func (_c *MockGitProvider_FindBranch_Call) RunAndReturn(run func(string) (string, bool)) *MockGitProvider_FindBranch_Call {
+func (_c *MockGitProvider_FindBranch_Call) RunAndReturn(run func(context.Context, string) (string, bool)) *MockGitProvider_FindBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForcePushBranch provides a mock function with given fields: ctx, branch
+func (_m *MockGitProvider) ForcePushBranch(ctx context.Context, branch string) error {
+	ret := _m.Called(ctx, branch)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, branch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitProvider_ForcePushBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForcePushBranch'
+type MockGitProvider_ForcePushBranch_Call struct {
+	*mock.Call
+}
+
+// ForcePushBranch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - branch string
+func (_e *MockGitProvider_Expecter) ForcePushBranch(ctx interface{}, branch interface{}) *MockGitProvider_ForcePushBranch_Call {
+	return &MockGitProvider_ForcePushBranch_Call{Call: _e.mock.On("ForcePushBranch", ctx, branch)}
+}
+
+func (_c *MockGitProvider_ForcePushBranch_Call) Run(run func(ctx context.Context, branch string)) *MockGitProvider_ForcePushBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_ForcePushBranch_Call) Return(err error) *MockGitProvider_ForcePushBranch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGitProvider_ForcePushBranch_Call) RunAndReturn(run func(context.Context, string) error) *MockGitProvider_ForcePushBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAheadBehind provides a mock function with given fields: ctx, branch, base
+func (_m *MockGitProvider) GetAheadBehind(ctx context.Context, branch string, base string) (int, int, error) {
+	ret := _m.Called(ctx, branch, base)
+
+	var r0 int
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int, int, error)); ok {
+		return rf(ctx, branch, base)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int); ok {
+		r0 = rf(ctx, branch, base)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) int); ok {
+		r1 = rf(ctx, branch, base)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, branch, base)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockGitProvider_GetAheadBehind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAheadBehind'
+type MockGitProvider_GetAheadBehind_Call struct {
+	*mock.Call
+}
+
+// GetAheadBehind is a helper method to define mock.On call
+//   - ctx context.Context
+//   - branch string
+//   - base string
+func (_e *MockGitProvider_Expecter) GetAheadBehind(ctx interface{}, branch interface{}, base interface{}) *MockGitProvider_GetAheadBehind_Call {
+	return &MockGitProvider_GetAheadBehind_Call{Call: _e.mock.On("GetAheadBehind", ctx, branch, base)}
+}
+
+func (_c *MockGitProvider_GetAheadBehind_Call) Run(run func(ctx context.Context, branch string, base string)) *MockGitProvider_GetAheadBehind_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_GetAheadBehind_Call) Return(ahead int, behind int, err error) *MockGitProvider_GetAheadBehind_Call {
+	_c.Call.Return(ahead, behind, err)
+	return _c
+}
+
+func (_c *MockGitProvider_GetAheadBehind_Call) RunAndReturn(run func(context.Context, string, string) (int, int, error)) *MockGitProvider_GetAheadBehind_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBranchConfig provides a mock function with given fields: ctx, branch, key
+func (_m *MockGitProvider) GetBranchConfig(ctx context.Context, branch string, key string) (string, error) {
+	ret := _m.Called(ctx, branch, key)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, branch, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, branch, key)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, branch, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGitProvider_GetBranchConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBranchConfig'
+type MockGitProvider_GetBranchConfig_Call struct {
+	*mock.Call
+}
+
+// GetBranchConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - branch string
+//   - key string
+func (_e *MockGitProvider_Expecter) GetBranchConfig(ctx interface{}, branch interface{}, key interface{}) *MockGitProvider_GetBranchConfig_Call {
+	return &MockGitProvider_GetBranchConfig_Call{Call: _e.mock.On("GetBranchConfig", ctx, branch, key)}
+}
+
+func (_c *MockGitProvider_GetBranchConfig_Call) Run(run func(ctx context.Context, branch string, key string)) *MockGitProvider_GetBranchConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_GetBranchConfig_Call) Return(value string, err error) *MockGitProvider_GetBranchConfig_Call {
+	_c.Call.Return(value, err)
+	return _c
+}
+
+func (_c *MockGitProvider_GetBranchConfig_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *MockGitProvider_GetBranchConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChangedFiles provides a mock function with given fields: ctx, branch, base
+func (_m *MockGitProvider) GetChangedFiles(ctx context.Context, branch string, base string) ([]string, error) {
+	ret := _m.Called(ctx, branch, base)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]string, error)); ok {
+		return rf(ctx, branch, base)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
+		r0 = rf(ctx, branch, base)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, branch, base)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGitProvider_GetChangedFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChangedFiles'
+type MockGitProvider_GetChangedFiles_Call struct {
+	*mock.Call
+}
+
+// GetChangedFiles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - branch string
+//   - base string
+func (_e *MockGitProvider_Expecter) GetChangedFiles(ctx interface{}, branch interface{}, base interface{}) *MockGitProvider_GetChangedFiles_Call {
+	return &MockGitProvider_GetChangedFiles_Call{Call: _e.mock.On("GetChangedFiles", ctx, branch, base)}
+}
+
+func (_c *MockGitProvider_GetChangedFiles_Call) Run(run func(ctx context.Context, branch string, base string)) *MockGitProvider_GetChangedFiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_GetChangedFiles_Call) Return(files []string, err error) *MockGitProvider_GetChangedFiles_Call {
+	_c.Call.Return(files, err)
+	return _c
+}
+
+func (_c *MockGitProvider_GetChangedFiles_Call) RunAndReturn(run func(context.Context, string, string) ([]string, error)) *MockGitProvider_GetChangedFiles_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCommitsToPush provides a mock function with given fields: branch
-func (_m *MockGitProvider) GetCommitsToPush(branch string) ([]string, error) {
-	ret := _m.Called(branch)
+// GetCommitsToPush provides a mock function with given fields: ctx, branch
+func (_m *MockGitProvider) GetCommitsToPush(ctx context.Context, branch string) ([]string, error) {
+	ret := _m.Called(ctx, branch)
 
 	var r0 []string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]string, error)); ok {
-		return rf(branch)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, branch)
 	}
-	if rf, ok := ret.Get(0).(func(string) []string); ok {
-		r0 = rf(branch)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, branch)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(branch)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, branch)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -312,14 +713,15 @@ type MockGitProvider_GetCommitsToPush_Call struct {
 }
 
 // GetCommitsToPush is a helper method to define mock.On call
+//   - ctx context.Context
 //   - branch string
-func (_e *MockGitProvider_Expecter) GetCommitsToPush(branch interface{}) *MockGitProvider_GetCommitsToPush_Call {
-	return &MockGitProvider_GetCommitsToPush_Call{Call: _e.mock.On("GetCommitsToPush", branch)}
+func (_e *MockGitProvider_Expecter) GetCommitsToPush(ctx interface{}, branch interface{}) *MockGitProvider_GetCommitsToPush_Call {
+	return &MockGitProvider_GetCommitsToPush_Call{Call: _e.mock.On("GetCommitsToPush", ctx, branch)}
 }
 
-func (_c *MockGitProvider_GetCommitsToPush_Call) Run(run func(branch string)) *MockGitProvider_GetCommitsToPush_Call {
+func (_c *MockGitProvider_GetCommitsToPush_Call) Run(run func(ctx context.Context, branch string)) *MockGitProvider_GetCommitsToPush_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -329,28 +731,28 @@ func (_c *MockGitProvider_GetCommitsToPush_Call) Return(commits []string, err er
 	return _c
 }
 
-func (_c *MockGitProvider_GetCommitsToPush_Call) RunAndReturn(run func(string) ([]string, error)) *MockGitProvider_GetCommitsToPush_Call {
+func (_c *MockGitProvider_GetCommitsToPush_Call) RunAndReturn(run func(context.Context, string) ([]string, error)) *MockGitProvider_GetCommitsToPush_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCurrentBranch provides a mock function with given fields:
-func (_m *MockGitProvider) GetCurrentBranch() (string, error) {
-	ret := _m.Called()
+// GetCurrentBranch provides a mock function with given fields: ctx
+func (_m *MockGitProvider) GetCurrentBranch(ctx context.Context) (string, error) {
+	ret := _m.Called(ctx)
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (string, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() string); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -364,13 +766,14 @@ type MockGitProvider_GetCurrentBranch_Call struct {
 }
 
 // GetCurrentBranch is a helper method to define mock.On call
-func (_e *MockGitProvider_Expecter) GetCurrentBranch() *MockGitProvider_GetCurrentBranch_Call {
-	return &MockGitProvider_GetCurrentBranch_Call{Call: _e.mock.On("GetCurrentBranch")}
+//   - ctx context.Context
+func (_e *MockGitProvider_Expecter) GetCurrentBranch(ctx interface{}) *MockGitProvider_GetCurrentBranch_Call {
+	return &MockGitProvider_GetCurrentBranch_Call{Call: _e.mock.On("GetCurrentBranch", ctx)}
 }
 
-func (_c *MockGitProvider_GetCurrentBranch_Call) Run(run func()) *MockGitProvider_GetCurrentBranch_Call {
+func (_c *MockGitProvider_GetCurrentBranch_Call) Run(run func(ctx context.Context)) *MockGitProvider_GetCurrentBranch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context))
 	})
 	return _c
 }
@@ -380,62 +783,362 @@ func (_c *MockGitProvider_GetCurrentBranch_Call) Return(branchName string, err e
 	return _c
 }
 
-func (_c *MockGitProvider_GetCurrentBranch_Call) RunAndReturn(run func() (string, error)) *MockGitProvider_GetCurrentBranch_Call {
+func (_c *MockGitProvider_GetCurrentBranch_Call) RunAndReturn(run func(context.Context) (string, error)) *MockGitProvider_GetCurrentBranch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PushBranch provides a mock function with given fields: branch
-func (_m *MockGitProvider) PushBranch(branch string) error {
-	ret := _m.Called(branch)
+// GetRemotes provides a mock function with given fields: ctx
+func (_m *MockGitProvider) GetRemotes(ctx context.Context) domain.Remotes {
+	ret := _m.Called(ctx)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(branch)
+	var r0 domain.Remotes
+	if rf, ok := ret.Get(0).(func(context.Context) domain.Remotes); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(domain.Remotes)
 	}
 
 	return r0
 }
 
-// MockGitProvider_PushBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PushBranch'
-type MockGitProvider_PushBranch_Call struct {
+// MockGitProvider_GetRemotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRemotes'
+type MockGitProvider_GetRemotes_Call struct {
 	*mock.Call
 }
 
-// PushBranch is a helper method to define mock.On call
-//   - branch string
-func (_e *MockGitProvider_Expecter) PushBranch(branch interface{}) *MockGitProvider_PushBranch_Call {
-	return &MockGitProvider_PushBranch_Call{Call: _e.mock.On("PushBranch", branch)}
+// GetRemotes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGitProvider_Expecter) GetRemotes(ctx interface{}) *MockGitProvider_GetRemotes_Call {
+	return &MockGitProvider_GetRemotes_Call{Call: _e.mock.On("GetRemotes", ctx)}
 }
 
-func (_c *MockGitProvider_PushBranch_Call) Run(run func(branch string)) *MockGitProvider_PushBranch_Call {
+func (_c *MockGitProvider_GetRemotes_Call) Run(run func(ctx context.Context)) *MockGitProvider_GetRemotes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockGitProvider_PushBranch_Call) Return(err error) *MockGitProvider_PushBranch_Call {
-	_c.Call.Return(err)
+func (_c *MockGitProvider_GetRemotes_Call) Return(remotes domain.Remotes) *MockGitProvider_GetRemotes_Call {
+	_c.Call.Return(remotes)
 	return _c
 }
 
-func (_c *MockGitProvider_PushBranch_Call) RunAndReturn(run func(string) error) *MockGitProvider_PushBranch_Call {
+func (_c *MockGitProvider_GetRemotes_Call) RunAndReturn(run func(context.Context) domain.Remotes) *MockGitProvider_GetRemotes_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoteBranchExists provides a mock function with given fields: branch
-func (_m *MockGitProvider) RemoteBranchExists(branch string) bool {
-	ret := _m.Called(branch)
+// GetRepositoryRoot provides a mock function with given fields: ctx
+func (_m *MockGitProvider) GetRepositoryRoot(ctx context.Context) (string, error) {
+	ret := _m.Called(ctx)
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(string) bool); ok {
-		r0 = rf(branch)
-	} else {
-		r0 = ret.Get(0).(bool)
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGitProvider_GetRepositoryRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepositoryRoot'
+type MockGitProvider_GetRepositoryRoot_Call struct {
+	*mock.Call
+}
+
+// GetRepositoryRoot is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGitProvider_Expecter) GetRepositoryRoot(ctx interface{}) *MockGitProvider_GetRepositoryRoot_Call {
+	return &MockGitProvider_GetRepositoryRoot_Call{Call: _e.mock.On("GetRepositoryRoot", ctx)}
+}
+
+func (_c *MockGitProvider_GetRepositoryRoot_Call) Run(run func(ctx context.Context)) *MockGitProvider_GetRepositoryRoot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_GetRepositoryRoot_Call) Return(rootPath string, err error) *MockGitProvider_GetRepositoryRoot_Call {
+	_c.Call.Return(rootPath, err)
+	return _c
+}
+
+func (_c *MockGitProvider_GetRepositoryRoot_Call) RunAndReturn(run func(context.Context) (string, error)) *MockGitProvider_GetRepositoryRoot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasUncommittedChanges provides a mock function with given fields: ctx
+func (_m *MockGitProvider) HasUncommittedChanges(ctx context.Context) (bool, error) {
+	ret := _m.Called(ctx)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (bool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGitProvider_HasUncommittedChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasUncommittedChanges'
+type MockGitProvider_HasUncommittedChanges_Call struct {
+	*mock.Call
+}
+
+// HasUncommittedChanges is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGitProvider_Expecter) HasUncommittedChanges(ctx interface{}) *MockGitProvider_HasUncommittedChanges_Call {
+	return &MockGitProvider_HasUncommittedChanges_Call{Call: _e.mock.On("HasUncommittedChanges", ctx)}
+}
+
+func (_c *MockGitProvider_HasUncommittedChanges_Call) Run(run func(ctx context.Context)) *MockGitProvider_HasUncommittedChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_HasUncommittedChanges_Call) Return(dirty bool, err error) *MockGitProvider_HasUncommittedChanges_Call {
+	_c.Call.Return(dirty, err)
+	return _c
+}
+
+func (_c *MockGitProvider_HasUncommittedChanges_Call) RunAndReturn(run func(context.Context) (bool, error)) *MockGitProvider_HasUncommittedChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBranches provides a mock function with given fields: ctx
+func (_m *MockGitProvider) ListBranches(ctx context.Context) ([]domain.Branch, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.Branch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.Branch, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Branch); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Branch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGitProvider_ListBranches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBranches'
+type MockGitProvider_ListBranches_Call struct {
+	*mock.Call
+}
+
+// ListBranches is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGitProvider_Expecter) ListBranches(ctx interface{}) *MockGitProvider_ListBranches_Call {
+	return &MockGitProvider_ListBranches_Call{Call: _e.mock.On("ListBranches", ctx)}
+}
+
+func (_c *MockGitProvider_ListBranches_Call) Run(run func(ctx context.Context)) *MockGitProvider_ListBranches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_ListBranches_Call) Return(branches []domain.Branch, err error) *MockGitProvider_ListBranches_Call {
+	_c.Call.Return(branches, err)
+	return _c
+}
+
+func (_c *MockGitProvider_ListBranches_Call) RunAndReturn(run func(context.Context) ([]domain.Branch, error)) *MockGitProvider_ListBranches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWorktrees provides a mock function with given fields: ctx
+func (_m *MockGitProvider) ListWorktrees(ctx context.Context) ([]domain.Worktree, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.Worktree
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.Worktree, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Worktree); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Worktree)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGitProvider_ListWorktrees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorktrees'
+type MockGitProvider_ListWorktrees_Call struct {
+	*mock.Call
+}
+
+// ListWorktrees is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGitProvider_Expecter) ListWorktrees(ctx interface{}) *MockGitProvider_ListWorktrees_Call {
+	return &MockGitProvider_ListWorktrees_Call{Call: _e.mock.On("ListWorktrees", ctx)}
+}
+
+func (_c *MockGitProvider_ListWorktrees_Call) Run(run func(ctx context.Context)) *MockGitProvider_ListWorktrees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_ListWorktrees_Call) Return(worktrees []domain.Worktree, err error) *MockGitProvider_ListWorktrees_Call {
+	_c.Call.Return(worktrees, err)
+	return _c
+}
+
+func (_c *MockGitProvider_ListWorktrees_Call) RunAndReturn(run func(context.Context) ([]domain.Worktree, error)) *MockGitProvider_ListWorktrees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PushBranch provides a mock function with given fields: ctx, branch
+func (_m *MockGitProvider) PushBranch(ctx context.Context, branch string) error {
+	ret := _m.Called(ctx, branch)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, branch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitProvider_PushBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PushBranch'
+type MockGitProvider_PushBranch_Call struct {
+	*mock.Call
+}
+
+// PushBranch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - branch string
+func (_e *MockGitProvider_Expecter) PushBranch(ctx interface{}, branch interface{}) *MockGitProvider_PushBranch_Call {
+	return &MockGitProvider_PushBranch_Call{Call: _e.mock.On("PushBranch", ctx, branch)}
+}
+
+func (_c *MockGitProvider_PushBranch_Call) Run(run func(ctx context.Context, branch string)) *MockGitProvider_PushBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_PushBranch_Call) Return(err error) *MockGitProvider_PushBranch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGitProvider_PushBranch_Call) RunAndReturn(run func(context.Context, string) error) *MockGitProvider_PushBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebaseOnto provides a mock function with given fields: ctx, branch, newBase, upstream
+func (_m *MockGitProvider) RebaseOnto(ctx context.Context, branch string, newBase string, upstream string) error {
+	ret := _m.Called(ctx, branch, newBase, upstream)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, branch, newBase, upstream)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitProvider_RebaseOnto_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebaseOnto'
+type MockGitProvider_RebaseOnto_Call struct {
+	*mock.Call
+}
+
+// RebaseOnto is a helper method to define mock.On call
+//   - ctx context.Context
+//   - branch string
+//   - newBase string
+//   - upstream string
+func (_e *MockGitProvider_Expecter) RebaseOnto(ctx interface{}, branch interface{}, newBase interface{}, upstream interface{}) *MockGitProvider_RebaseOnto_Call {
+	return &MockGitProvider_RebaseOnto_Call{Call: _e.mock.On("RebaseOnto", ctx, branch, newBase, upstream)}
+}
+
+func (_c *MockGitProvider_RebaseOnto_Call) Run(run func(ctx context.Context, branch string, newBase string, upstream string)) *MockGitProvider_RebaseOnto_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_RebaseOnto_Call) Return(err error) *MockGitProvider_RebaseOnto_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGitProvider_RebaseOnto_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockGitProvider_RebaseOnto_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoteBranchExists provides a mock function with given fields: ctx, branch
+func (_m *MockGitProvider) RemoteBranchExists(ctx context.Context, branch string) bool {
+	ret := _m.Called(ctx, branch)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, branch)
+	} else {
+		r0 = ret.Get(0).(bool)
 	}
 
 	return r0
@@ -447,14 +1150,15 @@ type MockGitProvider_RemoteBranchExists_Call struct {
 }
 
 // RemoteBranchExists is a helper method to define mock.On call
+//   - ctx context.Context
 //   - branch string
-func (_e *MockGitProvider_Expecter) RemoteBranchExists(branch interface{}) *MockGitProvider_RemoteBranchExists_Call {
-	return &MockGitProvider_RemoteBranchExists_Call{Call: _e.mock.On("RemoteBranchExists", branch)}
+func (_e *MockGitProvider_Expecter) RemoteBranchExists(ctx interface{}, branch interface{}) *MockGitProvider_RemoteBranchExists_Call {
+	return &MockGitProvider_RemoteBranchExists_Call{Call: _e.mock.On("RemoteBranchExists", ctx, branch)}
 }
 
-func (_c *MockGitProvider_RemoteBranchExists_Call) Run(run func(branch string)) *MockGitProvider_RemoteBranchExists_Call {
+func (_c *MockGitProvider_RemoteBranchExists_Call) Run(run func(ctx context.Context, branch string)) *MockGitProvider_RemoteBranchExists_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -464,7 +1168,297 @@ func (_c *MockGitProvider_RemoteBranchExists_Call) Return(exists bool) *MockGitP
 	return _c
 }
 
-func (_c *MockGitProvider_RemoteBranchExists_Call) RunAndReturn(run func(string) bool) *MockGitProvider_RemoteBranchExists_Call {
+func (_c *MockGitProvider_RemoteBranchExists_Call) RunAndReturn(run func(context.Context, string) bool) *MockGitProvider_RemoteBranchExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveWorktree provides a mock function with given fields: ctx, path, force
+func (_m *MockGitProvider) RemoveWorktree(ctx context.Context, path string, force bool) error {
+	ret := _m.Called(ctx, path, force)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, path, force)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitProvider_RemoveWorktree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveWorktree'
+type MockGitProvider_RemoveWorktree_Call struct {
+	*mock.Call
+}
+
+// RemoveWorktree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - force bool
+func (_e *MockGitProvider_Expecter) RemoveWorktree(ctx interface{}, path interface{}, force interface{}) *MockGitProvider_RemoveWorktree_Call {
+	return &MockGitProvider_RemoveWorktree_Call{Call: _e.mock.On("RemoveWorktree", ctx, path, force)}
+}
+
+func (_c *MockGitProvider_RemoveWorktree_Call) Run(run func(ctx context.Context, path string, force bool)) *MockGitProvider_RemoveWorktree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_RemoveWorktree_Call) Return(err error) *MockGitProvider_RemoveWorktree_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGitProvider_RemoveWorktree_Call) RunAndReturn(run func(context.Context, string, bool) error) *MockGitProvider_RemoveWorktree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetLastCommit provides a mock function with given fields: ctx
+func (_m *MockGitProvider) ResetLastCommit(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitProvider_ResetLastCommit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetLastCommit'
+type MockGitProvider_ResetLastCommit_Call struct {
+	*mock.Call
+}
+
+// ResetLastCommit is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGitProvider_Expecter) ResetLastCommit(ctx interface{}) *MockGitProvider_ResetLastCommit_Call {
+	return &MockGitProvider_ResetLastCommit_Call{Call: _e.mock.On("ResetLastCommit", ctx)}
+}
+
+func (_c *MockGitProvider_ResetLastCommit_Call) Run(run func(ctx context.Context)) *MockGitProvider_ResetLastCommit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_ResetLastCommit_Call) Return(err error) *MockGitProvider_ResetLastCommit_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGitProvider_ResetLastCommit_Call) RunAndReturn(run func(context.Context) error) *MockGitProvider_ResetLastCommit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveRef provides a mock function with given fields: ctx, ref
+func (_m *MockGitProvider) ResolveRef(ctx context.Context, ref string) (string, error) {
+	ret := _m.Called(ctx, ref)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, ref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, ref)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGitProvider_ResolveRef_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveRef'
+type MockGitProvider_ResolveRef_Call struct {
+	*mock.Call
+}
+
+// ResolveRef is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ref string
+func (_e *MockGitProvider_Expecter) ResolveRef(ctx interface{}, ref interface{}) *MockGitProvider_ResolveRef_Call {
+	return &MockGitProvider_ResolveRef_Call{Call: _e.mock.On("ResolveRef", ctx, ref)}
+}
+
+func (_c *MockGitProvider_ResolveRef_Call) Run(run func(ctx context.Context, ref string)) *MockGitProvider_ResolveRef_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_ResolveRef_Call) Return(sha string, err error) *MockGitProvider_ResolveRef_Call {
+	_c.Call.Return(sha, err)
+	return _c
+}
+
+func (_c *MockGitProvider_ResolveRef_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockGitProvider_ResolveRef_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetBranchConfig provides a mock function with given fields: ctx, branch, key, value
+func (_m *MockGitProvider) SetBranchConfig(ctx context.Context, branch string, key string, value string) error {
+	ret := _m.Called(ctx, branch, key, value)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, branch, key, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitProvider_SetBranchConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBranchConfig'
+type MockGitProvider_SetBranchConfig_Call struct {
+	*mock.Call
+}
+
+// SetBranchConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - branch string
+//   - key string
+//   - value string
+func (_e *MockGitProvider_Expecter) SetBranchConfig(ctx interface{}, branch interface{}, key interface{}, value interface{}) *MockGitProvider_SetBranchConfig_Call {
+	return &MockGitProvider_SetBranchConfig_Call{Call: _e.mock.On("SetBranchConfig", ctx, branch, key, value)}
+}
+
+func (_c *MockGitProvider_SetBranchConfig_Call) Run(run func(ctx context.Context, branch string, key string, value string)) *MockGitProvider_SetBranchConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_SetBranchConfig_Call) Return(err error) *MockGitProvider_SetBranchConfig_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGitProvider_SetBranchConfig_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockGitProvider_SetBranchConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stash provides a mock function with given fields: ctx, message
+func (_m *MockGitProvider) Stash(ctx context.Context, message string) (bool, error) {
+	ret := _m.Called(ctx, message)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, message)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGitProvider_Stash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stash'
+type MockGitProvider_Stash_Call struct {
+	*mock.Call
+}
+
+// Stash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message string
+func (_e *MockGitProvider_Expecter) Stash(ctx interface{}, message interface{}) *MockGitProvider_Stash_Call {
+	return &MockGitProvider_Stash_Call{Call: _e.mock.On("Stash", ctx, message)}
+}
+
+func (_c *MockGitProvider_Stash_Call) Run(run func(ctx context.Context, message string)) *MockGitProvider_Stash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_Stash_Call) Return(stashed bool, err error) *MockGitProvider_Stash_Call {
+	_c.Call.Return(stashed, err)
+	return _c
+}
+
+func (_c *MockGitProvider_Stash_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockGitProvider_Stash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StashPop provides a mock function with given fields: ctx, message
+func (_m *MockGitProvider) StashPop(ctx context.Context, message string) (bool, error) {
+	ret := _m.Called(ctx, message)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, message)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGitProvider_StashPop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashPop'
+type MockGitProvider_StashPop_Call struct {
+	*mock.Call
+}
+
+// StashPop is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message string
+func (_e *MockGitProvider_Expecter) StashPop(ctx interface{}, message interface{}) *MockGitProvider_StashPop_Call {
+	return &MockGitProvider_StashPop_Call{Call: _e.mock.On("StashPop", ctx, message)}
+}
+
+func (_c *MockGitProvider_StashPop_Call) Run(run func(ctx context.Context, message string)) *MockGitProvider_StashPop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitProvider_StashPop_Call) Return(popped bool, err error) *MockGitProvider_StashPop_Call {
+	_c.Call.Return(popped, err)
+	return _c
+}
+
+func (_c *MockGitProvider_StashPop_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockGitProvider_StashPop_Call {
 	_c.Call.Return(run)
 	return _c
 }
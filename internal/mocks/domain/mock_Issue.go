@@ -104,6 +104,48 @@ func (_c *MockIssue_FormatID_Call) RunAndReturn(run func() string) *MockIssue_Fo
 	return _c
 }
 
+// HasLabel provides a mock function with given fields: labelName
+func (_m *MockIssue) HasLabel(labelName string) bool {
+	ret := _m.Called(labelName)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(labelName)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockIssue_HasLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasLabel'
+type MockIssue_HasLabel_Call struct {
+	*mock.Call
+}
+
+// HasLabel is a helper method to define mock.On call
+//   - labelName string
+func (_e *MockIssue_Expecter) HasLabel(labelName interface{}) *MockIssue_HasLabel_Call {
+	return &MockIssue_HasLabel_Call{Call: _e.mock.On("HasLabel", labelName)}
+}
+
+func (_c *MockIssue_HasLabel_Call) Run(run func(labelName string)) *MockIssue_HasLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockIssue_HasLabel_Call) Return(_a0 bool) *MockIssue_HasLabel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssue_HasLabel_Call) RunAndReturn(run func(string) bool) *MockIssue_HasLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ID provides a mock function with given fields:
 func (_m *MockIssue) ID() string {
 	ret := _m.Called()
@@ -145,6 +187,88 @@ func (_c *MockIssue_ID_Call) RunAndReturn(run func() string) *MockIssue_ID_Call
 	return _c
 }
 
+// IsClosed provides a mock function with given fields:
+func (_m *MockIssue) IsClosed() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockIssue_IsClosed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsClosed'
+type MockIssue_IsClosed_Call struct {
+	*mock.Call
+}
+
+// IsClosed is a helper method to define mock.On call
+func (_e *MockIssue_Expecter) IsClosed() *MockIssue_IsClosed_Call {
+	return &MockIssue_IsClosed_Call{Call: _e.mock.On("IsClosed")}
+}
+
+func (_c *MockIssue_IsClosed_Call) Run(run func()) *MockIssue_IsClosed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIssue_IsClosed_Call) Return(_a0 bool) *MockIssue_IsClosed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssue_IsClosed_Call) RunAndReturn(run func() bool) *MockIssue_IsClosed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// State provides a mock function with given fields:
+func (_m *MockIssue) State() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockIssue_State_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'State'
+type MockIssue_State_Call struct {
+	*mock.Call
+}
+
+// State is a helper method to define mock.On call
+func (_e *MockIssue_Expecter) State() *MockIssue_State_Call {
+	return &MockIssue_State_Call{Call: _e.mock.On("State")}
+}
+
+func (_c *MockIssue_State_Call) Run(run func()) *MockIssue_State_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIssue_State_Call) Return(_a0 string) *MockIssue_State_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssue_State_Call) RunAndReturn(run func() string) *MockIssue_State_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Title provides a mock function with given fields:
 func (_m *MockIssue) Title() string {
 	ret := _m.Called()
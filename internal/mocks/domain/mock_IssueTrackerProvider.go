@@ -3,6 +3,8 @@
 package domain
 
 import (
+	context "context"
+
 	domain "github.com/InditexTech/gh-sherpa/internal/domain"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -20,25 +22,79 @@ func (_m *MockIssueTrackerProvider) EXPECT() *MockIssueTrackerProvider_Expecter
 	return &MockIssueTrackerProvider_Expecter{mock: &_m.Mock}
 }
 
-// GetIssue provides a mock function with given fields: identifier
-func (_m *MockIssueTrackerProvider) GetIssue(identifier string) (domain.Issue, error) {
-	ret := _m.Called(identifier)
+// GetAssignedIssues provides a mock function with given fields: ctx
+func (_m *MockIssueTrackerProvider) GetAssignedIssues(ctx context.Context) ([]domain.Issue, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.Issue
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.Issue, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Issue); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Issue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIssueTrackerProvider_GetAssignedIssues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAssignedIssues'
+type MockIssueTrackerProvider_GetAssignedIssues_Call struct {
+	*mock.Call
+}
+
+// GetAssignedIssues is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIssueTrackerProvider_Expecter) GetAssignedIssues(ctx interface{}) *MockIssueTrackerProvider_GetAssignedIssues_Call {
+	return &MockIssueTrackerProvider_GetAssignedIssues_Call{Call: _e.mock.On("GetAssignedIssues", ctx)}
+}
+
+func (_c *MockIssueTrackerProvider_GetAssignedIssues_Call) Run(run func(ctx context.Context)) *MockIssueTrackerProvider_GetAssignedIssues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIssueTrackerProvider_GetAssignedIssues_Call) Return(issues []domain.Issue, err error) *MockIssueTrackerProvider_GetAssignedIssues_Call {
+	_c.Call.Return(issues, err)
+	return _c
+}
+
+func (_c *MockIssueTrackerProvider_GetAssignedIssues_Call) RunAndReturn(run func(context.Context) ([]domain.Issue, error)) *MockIssueTrackerProvider_GetAssignedIssues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIssue provides a mock function with given fields: ctx, identifier
+func (_m *MockIssueTrackerProvider) GetIssue(ctx context.Context, identifier string) (domain.Issue, error) {
+	ret := _m.Called(ctx, identifier)
 
 	var r0 domain.Issue
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (domain.Issue, error)); ok {
-		return rf(identifier)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.Issue, error)); ok {
+		return rf(ctx, identifier)
 	}
-	if rf, ok := ret.Get(0).(func(string) domain.Issue); ok {
-		r0 = rf(identifier)
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.Issue); ok {
+		r0 = rf(ctx, identifier)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(domain.Issue)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(identifier)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, identifier)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -52,14 +108,15 @@ type MockIssueTrackerProvider_GetIssue_Call struct {
 }
 
 // GetIssue is a helper method to define mock.On call
+//   - ctx context.Context
 //   - identifier string
-func (_e *MockIssueTrackerProvider_Expecter) GetIssue(identifier interface{}) *MockIssueTrackerProvider_GetIssue_Call {
-	return &MockIssueTrackerProvider_GetIssue_Call{Call: _e.mock.On("GetIssue", identifier)}
+func (_e *MockIssueTrackerProvider_Expecter) GetIssue(ctx interface{}, identifier interface{}) *MockIssueTrackerProvider_GetIssue_Call {
+	return &MockIssueTrackerProvider_GetIssue_Call{Call: _e.mock.On("GetIssue", ctx, identifier)}
 }
 
-func (_c *MockIssueTrackerProvider_GetIssue_Call) Run(run func(identifier string)) *MockIssueTrackerProvider_GetIssue_Call {
+func (_c *MockIssueTrackerProvider_GetIssue_Call) Run(run func(ctx context.Context, identifier string)) *MockIssueTrackerProvider_GetIssue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -69,7 +126,7 @@ func (_c *MockIssueTrackerProvider_GetIssue_Call) Return(issue domain.Issue, err
 	return _c
 }
 
-func (_c *MockIssueTrackerProvider_GetIssue_Call) RunAndReturn(run func(string) (domain.Issue, error)) *MockIssueTrackerProvider_GetIssue_Call {
+func (_c *MockIssueTrackerProvider_GetIssue_Call) RunAndReturn(run func(context.Context, string) (domain.Issue, error)) *MockIssueTrackerProvider_GetIssue_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -116,6 +173,105 @@ func (_c *MockIssueTrackerProvider_ParseIssueId_Call) RunAndReturn(run func(stri
 	return _c
 }
 
+// SearchIssues provides a mock function with given fields: ctx, queryName
+func (_m *MockIssueTrackerProvider) SearchIssues(ctx context.Context, queryName string) ([]domain.Issue, error) {
+	ret := _m.Called(ctx, queryName)
+
+	var r0 []domain.Issue
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.Issue, error)); ok {
+		return rf(ctx, queryName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.Issue); ok {
+		r0 = rf(ctx, queryName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Issue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, queryName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIssueTrackerProvider_SearchIssues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchIssues'
+type MockIssueTrackerProvider_SearchIssues_Call struct {
+	*mock.Call
+}
+
+// SearchIssues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queryName string
+func (_e *MockIssueTrackerProvider_Expecter) SearchIssues(ctx interface{}, queryName interface{}) *MockIssueTrackerProvider_SearchIssues_Call {
+	return &MockIssueTrackerProvider_SearchIssues_Call{Call: _e.mock.On("SearchIssues", ctx, queryName)}
+}
+
+func (_c *MockIssueTrackerProvider_SearchIssues_Call) Run(run func(ctx context.Context, queryName string)) *MockIssueTrackerProvider_SearchIssues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockIssueTrackerProvider_SearchIssues_Call) Return(issues []domain.Issue, err error) *MockIssueTrackerProvider_SearchIssues_Call {
+	_c.Call.Return(issues, err)
+	return _c
+}
+
+func (_c *MockIssueTrackerProvider_SearchIssues_Call) RunAndReturn(run func(context.Context, string) ([]domain.Issue, error)) *MockIssueTrackerProvider_SearchIssues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionIssue provides a mock function with given fields: ctx, identifier, transition
+func (_m *MockIssueTrackerProvider) TransitionIssue(ctx context.Context, identifier string, transition string) error {
+	ret := _m.Called(ctx, identifier, transition)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, identifier, transition)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueTrackerProvider_TransitionIssue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionIssue'
+type MockIssueTrackerProvider_TransitionIssue_Call struct {
+	*mock.Call
+}
+
+// TransitionIssue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identifier string
+//   - transition string
+func (_e *MockIssueTrackerProvider_Expecter) TransitionIssue(ctx interface{}, identifier interface{}, transition interface{}) *MockIssueTrackerProvider_TransitionIssue_Call {
+	return &MockIssueTrackerProvider_TransitionIssue_Call{Call: _e.mock.On("TransitionIssue", ctx, identifier, transition)}
+}
+
+func (_c *MockIssueTrackerProvider_TransitionIssue_Call) Run(run func(ctx context.Context, identifier string, transition string)) *MockIssueTrackerProvider_TransitionIssue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockIssueTrackerProvider_TransitionIssue_Call) Return(err error) *MockIssueTrackerProvider_TransitionIssue_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIssueTrackerProvider_TransitionIssue_Call) RunAndReturn(run func(context.Context, string, string) error) *MockIssueTrackerProvider_TransitionIssue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockIssueTrackerProvider creates a new instance of MockIssueTrackerProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIssueTrackerProvider(t interface {
@@ -3,6 +3,8 @@
 package domain
 
 import (
+	context "context"
+
 	domain "github.com/InditexTech/gh-sherpa/internal/domain"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -20,23 +22,23 @@ func (_m *MockPullRequestProvider) EXPECT() *MockPullRequestProvider_Expecter {
 	return &MockPullRequestProvider_Expecter{mock: &_m.Mock}
 }
 
-// CreatePullRequest provides a mock function with given fields: title, body, baseBranch, headBranch, draft, labels, reviewers, assignees
-func (_m *MockPullRequestProvider) CreatePullRequest(title string, body string, baseBranch string, headBranch string, draft bool, labels []string, reviewers []string, assignees []string) (string, error) {
-	ret := _m.Called(title, body, baseBranch, headBranch, draft, labels, reviewers, assignees)
+// CreatePullRequest provides a mock function with given fields: ctx, title, body, baseBranch, headBranch, draft, labels, reviewers, assignees
+func (_m *MockPullRequestProvider) CreatePullRequest(ctx context.Context, title string, body string, baseBranch string, headBranch string, draft bool, labels []string, reviewers []string, assignees []string) (string, error) {
+	ret := _m.Called(ctx, title, body, baseBranch, headBranch, draft, labels, reviewers, assignees)
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string, string, string, bool, []string, []string, []string) (string, error)); ok {
-		return rf(title, body, baseBranch, headBranch, draft, labels, reviewers, assignees)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, bool, []string, []string, []string) (string, error)); ok {
+		return rf(ctx, title, body, baseBranch, headBranch, draft, labels, reviewers, assignees)
 	}
-	if rf, ok := ret.Get(0).(func(string, string, string, string, bool, []string, []string, []string) string); ok {
-		r0 = rf(title, body, baseBranch, headBranch, draft, labels, reviewers, assignees)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, bool, []string, []string, []string) string); ok {
+		r0 = rf(ctx, title, body, baseBranch, headBranch, draft, labels, reviewers, assignees)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string, string, string, bool, []string, []string, []string) error); ok {
-		r1 = rf(title, body, baseBranch, headBranch, draft, labels, reviewers, assignees)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, bool, []string, []string, []string) error); ok {
+		r1 = rf(ctx, title, body, baseBranch, headBranch, draft, labels, reviewers, assignees)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -50,6 +52,7 @@ type MockPullRequestProvider_CreatePullRequest_Call struct {
 }
 
 // CreatePullRequest is a helper method to define mock.On call
+//   - ctx context.Context
 //   - title string
 //   - body string
 //   - baseBranch string
@@ -58,13 +61,13 @@ type MockPullRequestProvider_CreatePullRequest_Call struct {
 //   - labels []string
 //   - reviewers []string
 //   - assignees []string
-func (_e *MockPullRequestProvider_Expecter) CreatePullRequest(title interface{}, body interface{}, baseBranch interface{}, headBranch interface{}, draft interface{}, labels interface{}, reviewers interface{}, assignees interface{}) *MockPullRequestProvider_CreatePullRequest_Call {
-	return &MockPullRequestProvider_CreatePullRequest_Call{Call: _e.mock.On("CreatePullRequest", title, body, baseBranch, headBranch, draft, labels, reviewers, assignees)}
+func (_e *MockPullRequestProvider_Expecter) CreatePullRequest(ctx interface{}, title interface{}, body interface{}, baseBranch interface{}, headBranch interface{}, draft interface{}, labels interface{}, reviewers interface{}, assignees interface{}) *MockPullRequestProvider_CreatePullRequest_Call {
+	return &MockPullRequestProvider_CreatePullRequest_Call{Call: _e.mock.On("CreatePullRequest", ctx, title, body, baseBranch, headBranch, draft, labels, reviewers, assignees)}
 }
 
-func (_c *MockPullRequestProvider_CreatePullRequest_Call) Run(run func(title string, body string, baseBranch string, headBranch string, draft bool, labels []string, reviewers []string, assignees []string)) *MockPullRequestProvider_CreatePullRequest_Call {
+func (_c *MockPullRequestProvider_CreatePullRequest_Call) Run(run func(ctx context.Context, title string, body string, baseBranch string, headBranch string, draft bool, labels []string, reviewers []string, assignees []string)) *MockPullRequestProvider_CreatePullRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string), args[4].(bool), args[5].([]string), args[6].([]string), args[7].([]string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(bool), args[6].([]string), args[7].([]string), args[8].([]string))
 	})
 	return _c
 }
@@ -74,30 +77,74 @@ func (_c *MockPullRequestProvider_CreatePullRequest_Call) Return(prUrl string, e
 	return _c
 }
 
-func (_c *MockPullRequestProvider_CreatePullRequest_Call) RunAndReturn(run func(string, string, string, string, bool, []string, []string, []string) (string, error)) *MockPullRequestProvider_CreatePullRequest_Call {
+func (_c *MockPullRequestProvider_CreatePullRequest_Call) RunAndReturn(run func(context.Context, string, string, string, string, bool, []string, []string, []string) (string, error)) *MockPullRequestProvider_CreatePullRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPullRequestForBranch provides a mock function with given fields: branch
-func (_m *MockPullRequestProvider) GetPullRequestForBranch(branch string) (*domain.PullRequest, error) {
-	ret := _m.Called(branch)
+// EditPullRequest provides a mock function with given fields: ctx, headBranch, edit
+func (_m *MockPullRequestProvider) EditPullRequest(ctx context.Context, headBranch string, edit domain.PullRequestEdit) error {
+	ret := _m.Called(ctx, headBranch, edit)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.PullRequestEdit) error); ok {
+		r0 = rf(ctx, headBranch, edit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPullRequestProvider_EditPullRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditPullRequest'
+type MockPullRequestProvider_EditPullRequest_Call struct {
+	*mock.Call
+}
+
+// EditPullRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - headBranch string
+//   - edit domain.PullRequestEdit
+func (_e *MockPullRequestProvider_Expecter) EditPullRequest(ctx interface{}, headBranch interface{}, edit interface{}) *MockPullRequestProvider_EditPullRequest_Call {
+	return &MockPullRequestProvider_EditPullRequest_Call{Call: _e.mock.On("EditPullRequest", ctx, headBranch, edit)}
+}
+
+func (_c *MockPullRequestProvider_EditPullRequest_Call) Run(run func(ctx context.Context, headBranch string, edit domain.PullRequestEdit)) *MockPullRequestProvider_EditPullRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(domain.PullRequestEdit))
+	})
+	return _c
+}
+
+func (_c *MockPullRequestProvider_EditPullRequest_Call) Return(err error) *MockPullRequestProvider_EditPullRequest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPullRequestProvider_EditPullRequest_Call) RunAndReturn(run func(context.Context, string, domain.PullRequestEdit) error) *MockPullRequestProvider_EditPullRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPullRequestForBranch provides a mock function with given fields: ctx, branch
+func (_m *MockPullRequestProvider) GetPullRequestForBranch(ctx context.Context, branch string) (*domain.PullRequest, error) {
+	ret := _m.Called(ctx, branch)
 
 	var r0 *domain.PullRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*domain.PullRequest, error)); ok {
-		return rf(branch)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.PullRequest, error)); ok {
+		return rf(ctx, branch)
 	}
-	if rf, ok := ret.Get(0).(func(string) *domain.PullRequest); ok {
-		r0 = rf(branch)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.PullRequest); ok {
+		r0 = rf(ctx, branch)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.PullRequest)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(branch)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, branch)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -111,14 +158,15 @@ type MockPullRequestProvider_GetPullRequestForBranch_Call struct {
 }
 
 // GetPullRequestForBranch is a helper method to define mock.On call
+//   - ctx context.Context
 //   - branch string
-func (_e *MockPullRequestProvider_Expecter) GetPullRequestForBranch(branch interface{}) *MockPullRequestProvider_GetPullRequestForBranch_Call {
-	return &MockPullRequestProvider_GetPullRequestForBranch_Call{Call: _e.mock.On("GetPullRequestForBranch", branch)}
+func (_e *MockPullRequestProvider_Expecter) GetPullRequestForBranch(ctx interface{}, branch interface{}) *MockPullRequestProvider_GetPullRequestForBranch_Call {
+	return &MockPullRequestProvider_GetPullRequestForBranch_Call{Call: _e.mock.On("GetPullRequestForBranch", ctx, branch)}
 }
 
-func (_c *MockPullRequestProvider_GetPullRequestForBranch_Call) Run(run func(branch string)) *MockPullRequestProvider_GetPullRequestForBranch_Call {
+func (_c *MockPullRequestProvider_GetPullRequestForBranch_Call) Run(run func(ctx context.Context, branch string)) *MockPullRequestProvider_GetPullRequestForBranch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -128,7 +176,147 @@ func (_c *MockPullRequestProvider_GetPullRequestForBranch_Call) Return(pullReque
 	return _c
 }
 
-func (_c *MockPullRequestProvider_GetPullRequestForBranch_Call) RunAndReturn(run func(string) (*domain.PullRequest, error)) *MockPullRequestProvider_GetPullRequestForBranch_Call {
+func (_c *MockPullRequestProvider_GetPullRequestForBranch_Call) RunAndReturn(run func(context.Context, string) (*domain.PullRequest, error)) *MockPullRequestProvider_GetPullRequestForBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRequiredChecksStatus provides a mock function with given fields: ctx, headBranch
+func (_m *MockPullRequestProvider) GetRequiredChecksStatus(ctx context.Context, headBranch string) (domain.ChecksStatus, error) {
+	ret := _m.Called(ctx, headBranch)
+
+	var r0 domain.ChecksStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.ChecksStatus, error)); ok {
+		return rf(ctx, headBranch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.ChecksStatus); ok {
+		r0 = rf(ctx, headBranch)
+	} else {
+		r0 = ret.Get(0).(domain.ChecksStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, headBranch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPullRequestProvider_GetRequiredChecksStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequiredChecksStatus'
+type MockPullRequestProvider_GetRequiredChecksStatus_Call struct {
+	*mock.Call
+}
+
+// GetRequiredChecksStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - headBranch string
+func (_e *MockPullRequestProvider_Expecter) GetRequiredChecksStatus(ctx interface{}, headBranch interface{}) *MockPullRequestProvider_GetRequiredChecksStatus_Call {
+	return &MockPullRequestProvider_GetRequiredChecksStatus_Call{Call: _e.mock.On("GetRequiredChecksStatus", ctx, headBranch)}
+}
+
+func (_c *MockPullRequestProvider_GetRequiredChecksStatus_Call) Run(run func(ctx context.Context, headBranch string)) *MockPullRequestProvider_GetRequiredChecksStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPullRequestProvider_GetRequiredChecksStatus_Call) Return(status domain.ChecksStatus, err error) *MockPullRequestProvider_GetRequiredChecksStatus_Call {
+	_c.Call.Return(status, err)
+	return _c
+}
+
+func (_c *MockPullRequestProvider_GetRequiredChecksStatus_Call) RunAndReturn(run func(context.Context, string) (domain.ChecksStatus, error)) *MockPullRequestProvider_GetRequiredChecksStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkPullRequestReady provides a mock function with given fields: ctx, headBranch
+func (_m *MockPullRequestProvider) MarkPullRequestReady(ctx context.Context, headBranch string) error {
+	ret := _m.Called(ctx, headBranch)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, headBranch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPullRequestProvider_MarkPullRequestReady_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkPullRequestReady'
+type MockPullRequestProvider_MarkPullRequestReady_Call struct {
+	*mock.Call
+}
+
+// MarkPullRequestReady is a helper method to define mock.On call
+//   - ctx context.Context
+//   - headBranch string
+func (_e *MockPullRequestProvider_Expecter) MarkPullRequestReady(ctx interface{}, headBranch interface{}) *MockPullRequestProvider_MarkPullRequestReady_Call {
+	return &MockPullRequestProvider_MarkPullRequestReady_Call{Call: _e.mock.On("MarkPullRequestReady", ctx, headBranch)}
+}
+
+func (_c *MockPullRequestProvider_MarkPullRequestReady_Call) Run(run func(ctx context.Context, headBranch string)) *MockPullRequestProvider_MarkPullRequestReady_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPullRequestProvider_MarkPullRequestReady_Call) Return(err error) *MockPullRequestProvider_MarkPullRequestReady_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPullRequestProvider_MarkPullRequestReady_Call) RunAndReturn(run func(context.Context, string) error) *MockPullRequestProvider_MarkPullRequestReady_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePullRequestBase provides a mock function with given fields: ctx, headBranch, baseBranch
+func (_m *MockPullRequestProvider) UpdatePullRequestBase(ctx context.Context, headBranch string, baseBranch string) error {
+	ret := _m.Called(ctx, headBranch, baseBranch)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, headBranch, baseBranch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPullRequestProvider_UpdatePullRequestBase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePullRequestBase'
+type MockPullRequestProvider_UpdatePullRequestBase_Call struct {
+	*mock.Call
+}
+
+// UpdatePullRequestBase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - headBranch string
+//   - baseBranch string
+func (_e *MockPullRequestProvider_Expecter) UpdatePullRequestBase(ctx interface{}, headBranch interface{}, baseBranch interface{}) *MockPullRequestProvider_UpdatePullRequestBase_Call {
+	return &MockPullRequestProvider_UpdatePullRequestBase_Call{Call: _e.mock.On("UpdatePullRequestBase", ctx, headBranch, baseBranch)}
+}
+
+func (_c *MockPullRequestProvider_UpdatePullRequestBase_Call) Run(run func(ctx context.Context, headBranch string, baseBranch string)) *MockPullRequestProvider_UpdatePullRequestBase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPullRequestProvider_UpdatePullRequestBase_Call) Return(err error) *MockPullRequestProvider_UpdatePullRequestBase_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPullRequestProvider_UpdatePullRequestBase_Call) RunAndReturn(run func(context.Context, string, string) error) *MockPullRequestProvider_UpdatePullRequestBase_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,6 +3,8 @@
 package domain
 
 import (
+	context "context"
+
 	domain "github.com/InditexTech/gh-sherpa/internal/domain"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -20,25 +22,25 @@ func (_m *MockRepositoryProvider) EXPECT() *MockRepositoryProvider_Expecter {
 	return &MockRepositoryProvider_Expecter{mock: &_m.Mock}
 }
 
-// GetRepository provides a mock function with given fields:
-func (_m *MockRepositoryProvider) GetRepository() (*domain.Repository, error) {
-	ret := _m.Called()
+// GetRepository provides a mock function with given fields: ctx
+func (_m *MockRepositoryProvider) GetRepository(ctx context.Context) (*domain.Repository, error) {
+	ret := _m.Called(ctx)
 
 	var r0 *domain.Repository
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (*domain.Repository, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) (*domain.Repository, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() *domain.Repository); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) *domain.Repository); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Repository)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -52,13 +54,14 @@ type MockRepositoryProvider_GetRepository_Call struct {
 }
 
 // GetRepository is a helper method to define mock.On call
-func (_e *MockRepositoryProvider_Expecter) GetRepository() *MockRepositoryProvider_GetRepository_Call {
-	return &MockRepositoryProvider_GetRepository_Call{Call: _e.mock.On("GetRepository")}
+//   - ctx context.Context
+func (_e *MockRepositoryProvider_Expecter) GetRepository(ctx interface{}) *MockRepositoryProvider_GetRepository_Call {
+	return &MockRepositoryProvider_GetRepository_Call{Call: _e.mock.On("GetRepository", ctx)}
 }
 
-func (_c *MockRepositoryProvider_GetRepository_Call) Run(run func()) *MockRepositoryProvider_GetRepository_Call {
+func (_c *MockRepositoryProvider_GetRepository_Call) Run(run func(ctx context.Context)) *MockRepositoryProvider_GetRepository_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context))
 	})
 	return _c
 }
@@ -68,7 +71,7 @@ func (_c *MockRepositoryProvider_GetRepository_Call) Return(repo *domain.Reposit
 	return _c
 }
 
-func (_c *MockRepositoryProvider_GetRepository_Call) RunAndReturn(run func() (*domain.Repository, error)) *MockRepositoryProvider_GetRepository_Call {
+func (_c *MockRepositoryProvider_GetRepository_Call) RunAndReturn(run func(context.Context) (*domain.Repository, error)) *MockRepositoryProvider_GetRepository_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -160,6 +160,50 @@ func (_c *MockUserInteractionProvider_SelectOrInputPrompt_Call) RunAndReturn(run
 	return _c
 }
 
+// SelectWithFilter provides a mock function with given fields: message, options, selectedIndex
+func (_m *MockUserInteractionProvider) SelectWithFilter(message string, options []string, selectedIndex *int) error {
+	ret := _m.Called(message, options, selectedIndex)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string, *int) error); ok {
+		r0 = rf(message, options, selectedIndex)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserInteractionProvider_SelectWithFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectWithFilter'
+type MockUserInteractionProvider_SelectWithFilter_Call struct {
+	*mock.Call
+}
+
+// SelectWithFilter is a helper method to define mock.On call
+//   - message string
+//   - options []string
+//   - selectedIndex *int
+func (_e *MockUserInteractionProvider_Expecter) SelectWithFilter(message interface{}, options interface{}, selectedIndex interface{}) *MockUserInteractionProvider_SelectWithFilter_Call {
+	return &MockUserInteractionProvider_SelectWithFilter_Call{Call: _e.mock.On("SelectWithFilter", message, options, selectedIndex)}
+}
+
+func (_c *MockUserInteractionProvider_SelectWithFilter_Call) Run(run func(message string, options []string, selectedIndex *int)) *MockUserInteractionProvider_SelectWithFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]string), args[2].(*int))
+	})
+	return _c
+}
+
+func (_c *MockUserInteractionProvider_SelectWithFilter_Call) Return(_a0 error) *MockUserInteractionProvider_SelectWithFilter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserInteractionProvider_SelectWithFilter_Call) RunAndReturn(run func(string, []string, *int) error) *MockUserInteractionProvider_SelectWithFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockUserInteractionProvider creates a new instance of MockUserInteractionProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockUserInteractionProvider(t interface {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"runtime"
+	"slices"
 	"strings"
 
 	"github.com/stretchr/testify/mock"
@@ -17,7 +18,8 @@ import (
 //	mocks.UnsetExpectedCall(&mockedProvider.Mock, mockedProvider.MockedMethod)
 func UnsetExpectedCall(m *mock.Mock, method any) {
 	methodName := runtime.FuncForPC(reflect.ValueOf(method).Pointer()).Name()
-	for _, c := range m.ExpectedCalls {
+	// Unset removes the call from m.ExpectedCalls, so iterate over a copy to not skip any
+	for _, c := range slices.Clone(m.ExpectedCalls) {
 		// We use this to avoid matching wrong methods.
 		// More info -> https://stackoverflow.com/a/33325345
 		if strings.Contains(methodName, fmt.Sprintf(".%s-fm", c.Method)) {
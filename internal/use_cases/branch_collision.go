@@ -0,0 +1,112 @@
+package use_cases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+)
+
+// BranchCollisionStrategy defines what to do when the branch to create already exists
+type BranchCollisionStrategy string
+
+const (
+	// BranchCollisionFail returns an error if the branch already exists. This is the default strategy.
+	BranchCollisionFail BranchCollisionStrategy = "fail"
+	// BranchCollisionReuse checks out the existing branch instead of creating a new one.
+	BranchCollisionReuse BranchCollisionStrategy = "reuse"
+	// BranchCollisionSuffix creates a new branch appending a numeric suffix (-2, -3, ...).
+	BranchCollisionSuffix BranchCollisionStrategy = "suffix"
+	// BranchCollisionAsk lets the user choose one of the strategies above in interactive mode.
+	BranchCollisionAsk BranchCollisionStrategy = "ask"
+)
+
+// maxBranchSuffix is the greatest numeric suffix tried before giving up.
+const maxBranchSuffix = 100
+
+// ErrLocalBranchAlreadyExists is returned when the local branch already exists
+func ErrLocalBranchAlreadyExists(branchName string) error {
+	return domain.NewError(domain.ErrorCodeBranchExists, fmt.Errorf("a local branch with the name %s already exists", branchName))
+}
+
+type branchCollision struct {
+	git                     domain.GitProvider
+	userInteractionProvider domain.UserInteractionProvider
+	strategy                BranchCollisionStrategy
+	isInteractive           bool
+}
+
+// branchCollisionResult is the outcome of resolving a branch name collision
+type branchCollisionResult struct {
+	BranchName string
+	Reuse      bool
+}
+
+func (bc branchCollision) exists(ctx context.Context, branchName string) (local bool, remote bool) {
+	return bc.git.BranchExists(ctx, branchName), bc.git.RemoteBranchExists(ctx, branchName)
+}
+
+// resolve checks if the given branch name already exists locally or remotely and applies
+// the configured collision strategy. It returns the branch name to use and whether the
+// existing branch must be reused instead of created.
+func (bc branchCollision) resolve(ctx context.Context, branchName string) (result branchCollisionResult, err error) {
+	result.BranchName = branchName
+
+	local, remote := bc.exists(ctx, branchName)
+	if !local && !remote {
+		return result, nil
+	}
+
+	strategy := bc.strategy
+	if strategy == BranchCollisionAsk {
+		if !bc.isInteractive {
+			strategy = BranchCollisionFail
+		} else if strategy, err = bc.askStrategy(branchName); err != nil {
+			return result, err
+		}
+	}
+
+	switch strategy {
+	case BranchCollisionReuse:
+		logging.Debugf("Reusing existing branch %s", branchName)
+		result.Reuse = true
+	case BranchCollisionSuffix:
+		result.BranchName, err = bc.nextAvailableName(ctx, branchName)
+	default:
+		if remote {
+			err = ErrRemoteBranchAlreadyExists(branchName)
+		} else {
+			err = ErrLocalBranchAlreadyExists(branchName)
+		}
+	}
+
+	return result, err
+}
+
+func (bc branchCollision) nextAvailableName(ctx context.Context, branchName string) (string, error) {
+	for i := 2; i <= maxBranchSuffix; i++ {
+		candidate := fmt.Sprintf("%s-%d", branchName, i)
+		if local, remote := bc.exists(ctx, candidate); !local && !remote {
+			return candidate, nil
+		}
+	}
+
+	return "", fmt.Errorf("could not find an available name for the branch %s", branchName)
+}
+
+func (bc branchCollision) askStrategy(branchName string) (BranchCollisionStrategy, error) {
+	logging.PrintWarn(fmt.Sprintf("a branch named %s already exists", logging.PaintInfo(branchName)))
+
+	options := []string{
+		string(BranchCollisionReuse),
+		string(BranchCollisionSuffix),
+		string(BranchCollisionFail),
+	}
+	selected := options[0]
+	if err := bc.userInteractionProvider.SelectOrInputPrompt("What do you want to do with the existing branch?", options, &selected, true); err != nil {
+		return "", err
+	}
+
+	return BranchCollisionStrategy(selected), nil
+}
@@ -0,0 +1,105 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+)
+
+// carryStashPrefix identifies the stashes saved to carry the uncommitted changes to another branch
+const carryStashPrefix = "sherpa-carry: "
+
+// ErrDirtyWorkingTree is returned when the branch cannot be switched because of the uncommitted changes
+var ErrDirtyWorkingTree = domain.NewError(domain.ErrorCodeDirtyWorkingTree,
+	errors.New("the working tree has uncommitted changes that prevent switching the branch, commit them or use --carry-changes to take them to the branch"))
+
+// ErrCarryChangesConflict is returned when the changes carried to the branch conflict with it
+func ErrCarryChangesConflict(branch string, stash string, err error) error {
+	return fmt.Errorf("the uncommitted changes carried to the branch %s conflict with it. "+
+		"They are kept in the stash \"%s\": resolve the conflicts and run \"git stash drop\" to remove it.\n\nDetails:\n%w",
+		branch, stash, err)
+}
+
+// ErrCarryStashNotFound is returned when the stash with the carried changes is missing after the checkout
+func ErrCarryStashNotFound(branch string, stash string) error {
+	return fmt.Errorf("the uncommitted changes could not be carried to the branch %s because the stash \"%s\" was not found. "+
+		"Check \"git stash list\" to recover them", branch, stash)
+}
+
+// carryChanges switches between branches taking the uncommitted changes of the working tree along
+type carryChanges struct {
+	git                     domain.GitProvider
+	userInteractionProvider domain.UserInteractionProvider
+	enabled                 bool // --carry-changes: carry the changes without asking
+	isInteractive           bool
+}
+
+// checkout runs the given checkout of the branch. If the working tree is dirty and carrying the changes
+// is enabled or confirmed by the user, they are stashed before and applied again after the checkout.
+// It returns whether the changes were carried.
+func (c carryChanges) checkout(ctx context.Context, to string, checkout func() error) (carried bool, err error) {
+	dirty, err := c.git.HasUncommittedChanges(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if dirty && !c.enabled && c.isInteractive {
+		logging.PrintWarn("the working tree has uncommitted changes")
+		if c.enabled, err = c.userInteractionProvider.AskUserForConfirmation(
+			fmt.Sprintf("Do you want to carry them to the branch %s", to), true); err != nil {
+			return false, err
+		}
+	}
+
+	if !dirty || !c.enabled {
+		if err := checkout(); err != nil {
+			if dirty {
+				return false, fmt.Errorf("%w.\n\nDetails:\n%w", ErrDirtyWorkingTree, err)
+			}
+			return false, err
+		}
+		return false, nil
+	}
+
+	from, err := c.git.GetCurrentBranch(ctx)
+	if err != nil {
+		return false, fmt.Errorf("could not get the current branch name because %w", err)
+	}
+
+	stash := carryStashPrefix + from
+	stashed, err := c.git.Stash(ctx, stash)
+	if err != nil {
+		return false, err
+	}
+
+	if err := checkout(); err != nil {
+		if !stashed {
+			return false, err
+		}
+		popped, popErr := c.git.StashPop(ctx, stash)
+		if popErr != nil {
+			return false, fmt.Errorf("%w (the stashed changes could not be restored: %s)", err, popErr)
+		}
+		if !popped {
+			return false, fmt.Errorf("%w (the stashed changes could not be restored: the stash \"%s\" was not found)", err, stash)
+		}
+		return false, err
+	}
+
+	if !stashed {
+		return false, nil
+	}
+
+	popped, err := c.git.StashPop(ctx, stash)
+	if err != nil {
+		return false, ErrCarryChangesConflict(to, stash, err)
+	}
+	if !popped {
+		return false, ErrCarryStashNotFound(to, stash)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,263 @@
+package use_cases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+)
+
+const (
+	cleanupReasonMerged      = "pull request merged"
+	cleanupReasonClosed      = "pull request closed"
+	cleanupReasonIssueClosed = "issue closed"
+)
+
+// CleanupBranch holds a branch to be deleted and the outcome of its deletion
+type CleanupBranch struct {
+	BranchName    string `json:"branch"`
+	Reason        string `json:"reason"`
+	Local         bool   `json:"local"`
+	Remote        bool   `json:"remote"`
+	Deleted       bool   `json:"deleted"`
+	RemoteDeleted bool   `json:"remote_deleted"`
+	Error         string `json:"error,omitempty"`
+}
+
+// CleanupResult holds the outcome of a Cleanup execution.
+type CleanupResult struct {
+	Branches []CleanupBranch `json:"branches"`
+	DryRun   bool            `json:"dry_run"`
+}
+
+// CleanupConfiguration contains the arguments for the Cleanup use case
+type CleanupConfiguration struct {
+	BaseBranch    string // --base: branch that is never deleted besides the default branch
+	DeleteRemote  bool   // --remote: also delete the branches from your fork, only when working with one
+	IsInteractive bool
+	DryRun        bool   // --dry-run: print the plan without deleting anything
+	OutputFormat  string // --output: "" (default) or "json"
+}
+
+type Cleanup struct {
+	Cfg                     CleanupConfiguration
+	Git                     domain.GitProvider
+	RepositoryProvider      domain.RepositoryProvider
+	IssueTrackerProvider    domain.IssueTrackerProvider
+	PullRequestProvider     domain.PullRequestProvider
+	UserInteractionProvider domain.UserInteractionProvider
+}
+
+// Execute executes the cleanup use case
+func (c Cleanup) Execute(ctx context.Context) (result CleanupResult, err error) {
+	result.DryRun = c.Cfg.DryRun
+
+	protected, err := c.protectedBranches(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	list := List{
+		Git:                  c.Git,
+		IssueTrackerProvider: c.IssueTrackerProvider,
+		PullRequestProvider:  c.PullRequestProvider,
+	}
+	items, err := list.collect(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	// The remote branches are only deleted from your fork, as the ones of a shared repository may be
+	// used by others
+	deleteRemote := c.Cfg.DeleteRemote
+	if remotes := c.Git.GetRemotes(ctx); deleteRemote && remotes.Push == remotes.Base {
+		logging.PrintWarn("The remote branches are only deleted when working with a fork, so they are kept")
+		deleteRemote = false
+	}
+
+	result.Branches = []CleanupBranch{}
+	for _, item := range items {
+		if protected[item.BranchName] {
+			logging.Debugf("Skipping protected branch %s", item.BranchName)
+			continue
+		}
+
+		// The branches that only exist in the remote have not been created by you in this repository
+		if !item.Local {
+			continue
+		}
+
+		reason := cleanupReason(item)
+		if reason == "" {
+			continue
+		}
+
+		if reason == cleanupReasonMerged && !c.isMergedCommit(ctx, item) {
+			logging.PrintWarn(fmt.Sprintf("Skipping %s because it has commits that are not in its merged pull request", item.BranchName))
+			continue
+		}
+
+		result.Branches = append(result.Branches, CleanupBranch{
+			BranchName: item.BranchName,
+			Reason:     reason,
+			Local:      item.Local,
+			Remote:     item.Remote && deleteRemote,
+		})
+	}
+
+	if len(result.Branches) == 0 || c.Cfg.DryRun {
+		return result, c.printResult(result)
+	}
+
+	if c.Cfg.OutputFormat != "json" {
+		printCleanupPlan(result)
+	}
+
+	if c.Cfg.IsInteractive {
+		confirmed, err := c.UserInteractionProvider.AskUserForConfirmation("Do you want to delete these branches?", false)
+		if err != nil {
+			return result, err
+		}
+		if !confirmed {
+			result.Branches = []CleanupBranch{}
+			return result, c.printResult(result)
+		}
+	}
+
+	for i := range result.Branches {
+		c.delete(ctx, &result.Branches[i])
+	}
+
+	return result, c.printResult(result)
+}
+
+// protectedBranches returns the branches that must never be deleted
+func (c Cleanup) protectedBranches(ctx context.Context) (map[string]bool, error) {
+	currentBranch, err := c.Git.GetCurrentBranch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the current branch name because %w", err)
+	}
+
+	repo, err := c.RepositoryProvider.GetRepository(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	protected := map[string]bool{
+		currentBranch:         true,
+		repo.DefaultBranchRef: true,
+	}
+	if c.Cfg.BaseBranch != "" {
+		protected[c.Cfg.BaseBranch] = true
+	}
+
+	return protected, nil
+}
+
+// cleanupReason returns why the branch can be deleted, or an empty string if it must be kept.
+// Branches with an open pull request are always kept.
+func cleanupReason(item ListItem) string {
+	if item.PullRequest != nil {
+		switch {
+		case item.PullRequest.State == pullRequestStateMerged:
+			return cleanupReasonMerged
+		case item.PullRequest.State == "CLOSED":
+			return cleanupReasonClosed
+		default:
+			return ""
+		}
+	}
+
+	if item.Issue != nil && item.Issue.Closed {
+		return cleanupReasonIssueClosed
+	}
+
+	return ""
+}
+
+// isMergedCommit tells if the local branch points to the last commit of its merged pull request,
+// so it can be force deleted without losing any commit
+func (c Cleanup) isMergedCommit(ctx context.Context, item ListItem) bool {
+	if item.PullRequest.headCommit == "" {
+		return false
+	}
+
+	sha, err := c.Git.ResolveRef(ctx, item.BranchName)
+	if err != nil {
+		logging.Debugf("Could not resolve the branch %s: %s", item.BranchName, err)
+		return false
+	}
+
+	return sha == item.PullRequest.headCommit
+}
+
+// delete deletes the branch. Only the branches of merged pull requests are force deleted, as they
+// point to the merged commit, the rest of them are kept if they have commits that have not been
+// pushed, as they would be lost.
+func (c Cleanup) delete(ctx context.Context, branch *CleanupBranch) {
+	if branch.Local {
+		force := branch.Reason == cleanupReasonMerged
+		if err := c.Git.DeleteBranch(ctx, branch.BranchName, force); err != nil {
+			branch.Error = err.Error()
+			return
+		}
+		branch.Deleted = true
+	}
+
+	if branch.Remote {
+		if err := c.Git.DeleteRemoteBranch(ctx, branch.BranchName); err != nil {
+			branch.Error = err.Error()
+			return
+		}
+		branch.RemoteDeleted = true
+	}
+}
+
+func (c Cleanup) printResult(result CleanupResult) error {
+	if c.Cfg.OutputFormat == "json" {
+		jsonBytes, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("failed to serialize result: %w", err)
+		}
+		fmt.Println(string(jsonBytes))
+		return nil
+	}
+
+	switch {
+	case len(result.Branches) == 0:
+		fmt.Fprintln(logging.Output(), "There are no branches to clean up")
+	case result.DryRun:
+		printCleanupPlan(result)
+	default:
+		for _, branch := range result.Branches {
+			if branch.Error != "" {
+				logging.PrintError(fmt.Sprintf("could not delete %s: %s", branch.BranchName, branch.Error))
+				continue
+			}
+			fmt.Fprintf(logging.Output(), "Deleted %s\n", logging.PaintInfo(branch.BranchName))
+		}
+	}
+
+	return nil
+}
+
+func printCleanupPlan(result CleanupResult) {
+	prefix := ""
+	if result.DryRun {
+		prefix = "[dry-run] "
+	}
+
+	fmt.Fprintf(logging.Output(), "%sThe following branches are going to be deleted:\n", prefix)
+	for _, branch := range result.Branches {
+		location := "local"
+		switch {
+		case branch.Local && branch.Remote:
+			location = "local and remote"
+		case branch.Remote:
+			location = "remote"
+		}
+		fmt.Fprintf(logging.Output(), "  %s (%s, %s)\n", logging.PaintInfo(branch.BranchName), location, branch.Reason)
+	}
+}
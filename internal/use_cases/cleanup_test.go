@@ -0,0 +1,192 @@
+package use_cases_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/domain/issue_types"
+	domainFakes "github.com/InditexTech/gh-sherpa/internal/fakes/domain"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+	domainMocks "github.com/InditexTech/gh-sherpa/internal/mocks/domain"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/stretchr/testify/suite"
+)
+
+type CleanupExecutionTestSuite struct {
+	suite.Suite
+	uc                      use_cases.Cleanup
+	gitProvider             *domainFakes.FakeGitProvider
+	issueTrackerProvider    *domainFakes.FakeIssueTrackerProvider
+	pullRequestProvider     *domainFakes.FakePullRequestProvider
+	userInteractionProvider *domainMocks.MockUserInteractionProvider
+}
+
+func TestCleanupExecutionTestSuite(t *testing.T) {
+	suite.Run(t, new(CleanupExecutionTestSuite))
+}
+
+func (s *CleanupExecutionTestSuite) SetupSubTest() {
+	s.gitProvider = domainFakes.NewFakeGitProvider()
+	s.gitProvider.AddLocalBranches("feature/GH-1-merged", "feature/GH-2-open", "feature/GH-3-issue-closed", "feature/GH-4-current")
+	s.gitProvider.AddRemoteBranches("feature/GH-1-merged", "feature/GH-5-remote-closed")
+	s.gitProvider.CurrentBranch = "feature/GH-4-current"
+
+	s.issueTrackerProvider = domainFakes.NewFakeIssueTrackerProvider()
+	for _, id := range []string{"1", "2", "3", "4", "5"} {
+		issue := domainFakes.NewFakeIssue(id, issue_types.Feature, domain.IssueTrackerTypeGithub)
+		if id == "3" || id == "4" {
+			issue.SetState("closed", true)
+		}
+		s.issueTrackerProvider.AddIssue(issue)
+	}
+
+	s.pullRequestProvider = domainFakes.NewFakePullRequestProvider()
+	s.pullRequestProvider.AddPullRequest("feature/GH-1-merged", domain.PullRequest{Number: 1, State: "MERGED", Closed: true, HeadRefOid: "sha-feature/GH-1-merged"})
+	s.pullRequestProvider.AddPullRequest("feature/GH-2-open", domain.PullRequest{Number: 2, State: "OPEN"})
+	s.pullRequestProvider.AddPullRequest("feature/GH-4-current", domain.PullRequest{Number: 4, State: "MERGED", Closed: true})
+	s.pullRequestProvider.AddPullRequest("feature/GH-5-remote-closed", domain.PullRequest{Number: 5, State: "CLOSED", Closed: true})
+
+	s.userInteractionProvider = &domainMocks.MockUserInteractionProvider{}
+
+	s.uc = use_cases.Cleanup{
+		Cfg:                     use_cases.CleanupConfiguration{OutputFormat: "json"},
+		Git:                     s.gitProvider,
+		RepositoryProvider:      domainFakes.NewRepositoryProvider(),
+		IssueTrackerProvider:    s.issueTrackerProvider,
+		PullRequestProvider:     s.pullRequestProvider,
+		UserInteractionProvider: s.userInteractionProvider,
+	}
+}
+
+func (s *CleanupExecutionTestSuite) branchNames(result use_cases.CleanupResult) []string {
+	names := []string{}
+	for _, branch := range result.Branches {
+		names = append(names, branch.BranchName)
+	}
+	return names
+}
+
+func (s *CleanupExecutionTestSuite) TestCleanupExecution() {
+	s.Run("should delete local branches with merged pull request or closed issue", func() {
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal([]string{"feature/GH-1-merged", "feature/GH-3-issue-closed"}, s.branchNames(result))
+		s.Equal("pull request merged", result.Branches[0].Reason)
+		s.Equal("issue closed", result.Branches[1].Reason)
+		s.True(result.Branches[0].Deleted)
+		s.False(result.Branches[0].RemoteDeleted)
+		s.False(s.gitProvider.BranchExists(context.Background(), "feature/GH-1-merged"))
+		s.True(s.gitProvider.RemoteBranchExists(context.Background(), "feature/GH-1-merged"))
+	})
+
+	s.Run("should keep the branches of closed pull requests with commits that have not been pushed", func() {
+		s.gitProvider.AddLocalBranches("feature/GH-5-remote-closed")
+		s.gitProvider.CommitsToPush["feature/GH-5-remote-closed"] = []string{"work in progress"}
+		s.gitProvider.CommitsToPush["feature/GH-1-merged"] = []string{"squashed commit"}
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal([]string{"feature/GH-1-merged", "feature/GH-3-issue-closed", "feature/GH-5-remote-closed"}, s.branchNames(result))
+		s.True(result.Branches[0].Deleted)
+		s.False(result.Branches[2].Deleted)
+		s.Contains(result.Branches[2].Error, "not fully merged")
+		s.True(s.gitProvider.BranchExists(context.Background(), "feature/GH-5-remote-closed"))
+	})
+
+	s.Run("should never delete the current branch nor branches with an open pull request", func() {
+		_, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(s.gitProvider.BranchExists(context.Background(), "feature/GH-4-current"))
+		s.True(s.gitProvider.BranchExists(context.Background(), "feature/GH-2-open"))
+		s.True(s.gitProvider.BranchExists(context.Background(), "main"))
+	})
+
+	s.Run("should skip the merged branches with commits added after merging", func() {
+		s.gitProvider.Commits["feature/GH-1-merged"] = "sha-new-commit"
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal([]string{"feature/GH-3-issue-closed"}, s.branchNames(result))
+		s.True(s.gitProvider.BranchExists(context.Background(), "feature/GH-1-merged"))
+	})
+
+	s.Run("should also delete the branches from your fork when requested", func() {
+		s.uc.Cfg.DeleteRemote = true
+		s.gitProvider.Remotes = domain.Remotes{Push: "origin", Base: "upstream"}
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal([]string{"feature/GH-1-merged", "feature/GH-3-issue-closed"}, s.branchNames(result))
+		s.True(result.Branches[0].RemoteDeleted)
+		s.False(s.gitProvider.RemoteBranchExists(context.Background(), "feature/GH-1-merged"))
+		s.True(s.gitProvider.RemoteBranchExists(context.Background(), "feature/GH-5-remote-closed"))
+	})
+
+	s.Run("should keep the remote branches when not working with a fork", func() {
+		s.uc.Cfg.DeleteRemote = true
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal([]string{"feature/GH-1-merged", "feature/GH-3-issue-closed"}, s.branchNames(result))
+		s.False(result.Branches[0].Remote)
+		s.False(result.Branches[0].RemoteDeleted)
+		s.True(s.gitProvider.RemoteBranchExists(context.Background(), "feature/GH-1-merged"))
+	})
+
+	s.Run("should not delete anything in dry-run mode", func() {
+		s.uc.Cfg.DryRun = true
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(result.DryRun)
+		s.Len(result.Branches, 2)
+		s.False(result.Branches[0].Deleted)
+		s.True(s.gitProvider.BranchExists(context.Background(), "feature/GH-1-merged"))
+	})
+
+	s.Run("should not delete anything if the user does not confirm", func() {
+		s.uc.Cfg.IsInteractive = true
+		s.userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to delete these branches?", false).Return(false, nil).Once()
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Empty(result.Branches)
+		s.True(s.gitProvider.BranchExists(context.Background(), "feature/GH-1-merged"))
+		s.userInteractionProvider.AssertExpectations(s.T())
+	})
+
+	s.Run("should print the deleted branches to the output of the messages", func() {
+		var output bytes.Buffer
+		logging.SetOutput(&output)
+		defer logging.SetOutput(os.Stdout)
+		s.uc.Cfg.OutputFormat = ""
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Contains(output.String(), "The following branches are going to be deleted")
+		s.Contains(output.String(), "feature/GH-1-merged")
+	})
+
+	s.Run("should delete the branches if the user confirms", func() {
+		s.uc.Cfg.IsInteractive = true
+		s.userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to delete these branches?", false).Return(true, nil).Once()
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Len(result.Branches, 2)
+		s.False(s.gitProvider.BranchExists(context.Background(), "feature/GH-3-issue-closed"))
+	})
+}
@@ -1,7 +1,9 @@
 package use_cases
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/InditexTech/gh-sherpa/internal/domain"
@@ -10,17 +12,35 @@ import (
 
 // CreateBranchResult holds the outcome of a successful CreateBranch execution.
 type CreateBranchResult struct {
-	BranchName string `json:"branch"`
+	BranchName     string       `json:"branch"`
+	BaseBranch     string       `json:"base"`
+	Issue          *StatusIssue `json:"issue,omitempty"`
+	Reused         bool         `json:"reused"`
+	Created        bool         `json:"created"`
+	CarriedChanges bool         `json:"carried_changes"`
+	Worktree       string       `json:"worktree,omitempty"`
+	Fork           *ForkResult  `json:"fork,omitempty"`
+	// DryRun is set when nothing was done and Plan holds the steps that would have been done
+	DryRun bool       `json:"dry_run,omitempty"`
+	Plan   []PlanStep `json:"plan,omitempty"`
 }
 
 type CreateBranchConfiguration struct {
 	IssueID         string
+	Query           string // --query: pick the issue among the results of this saved query
 	BaseBranch      string
 	FetchFromOrigin bool
 	IsInteractive   bool
 	BranchName      string // --branch-name: bypass generation and use this name directly
-	DryRun          bool   // --dry-run: print what would happen without executing
+	DryRun          bool   // --dry-run: print the plan of the steps without doing them
 	OutputFormat    string // --output: "" (default) or "json"
+	CarryChanges    bool   // --carry-changes: stash the uncommitted changes and apply them again on the branch
+	Worktree        bool   // --worktree: create the branch in a new worktree instead of switching to it
+	WorktreePath    string // --worktree path or the git.worktree_path setting, DefaultWorktreePath if it is not set
+	OnCollision     BranchCollisionStrategy
+
+	// quiet disables the JSON result output when the use case is run from another one
+	quiet bool
 }
 
 type CreateBranch struct {
@@ -30,15 +50,38 @@ type CreateBranch struct {
 	IssueTrackerProvider    domain.IssueTrackerProvider
 	UserInteractionProvider domain.UserInteractionProvider
 	BranchProvider          domain.BranchProvider
+	// Plan holds the steps planned before the use case, like the fork setup. A new plan is used if it is nil.
+	Plan *Plan
+	// Fork is the fork set up with the plan, reported in the result
+	Fork *ForkResult
 }
 
 // Execute executes the create branch use case
-func (cb CreateBranch) Execute() (result CreateBranchResult, err error) {
+func (cb CreateBranch) Execute(ctx context.Context) (result CreateBranchResult, err error) {
 	if cb.Cfg.IssueID == "" {
-		return result, fmt.Errorf("sherpa needs an valid issue identifier")
+		errNoIssue := fmt.Errorf("sherpa needs an valid issue identifier")
+		if !cb.Cfg.IsInteractive {
+			if cb.Cfg.Query != "" {
+				return result, fmt.Errorf("the issue of the query %s can only be picked in interactive mode", cb.Cfg.Query)
+			}
+			return result, errNoIssue
+		}
+
+		picker := issuePicker{
+			issueTrackerProvider:    cb.IssueTrackerProvider,
+			userInteractionProvider: cb.UserInteractionProvider,
+			query:                   cb.Cfg.Query,
+		}
+		cb.Cfg.IssueID, err = picker.pick(ctx)
+		if errors.Is(err, ErrNoAssignedIssues) {
+			return result, errNoIssue
+		}
+		if err != nil {
+			return result, err
+		}
 	}
 
-	repo, err := cb.RepositoryProvider.GetRepository()
+	repo, err := cb.RepositoryProvider.GetRepository(ctx)
 	if err != nil {
 		return result, err
 	}
@@ -49,14 +92,18 @@ func (cb CreateBranch) Execute() (result CreateBranchResult, err error) {
 		baseBranch = repo.DefaultBranchRef
 	}
 
+	result.BaseBranch = baseBranch
+	result.Fork = cb.Fork
+
 	var branchName string
 	if cb.Cfg.BranchName != "" {
 		branchName = cb.Cfg.BranchName
 	} else {
-		issue, err := cb.IssueTrackerProvider.GetIssue(cb.Cfg.IssueID)
+		issue, err := cb.IssueTrackerProvider.GetIssue(ctx, cb.Cfg.IssueID)
 		if err != nil {
 			return result, err
 		}
+		result.Issue = newResultIssue(issue)
 
 		branchName, err = cb.BranchProvider.GetBranchName(issue, *repo)
 		if err != nil {
@@ -64,65 +111,157 @@ func (cb CreateBranch) Execute() (result CreateBranchResult, err error) {
 		}
 	}
 
+	collision, err := cb.branchCollision().resolve(ctx, branchName)
+	if err != nil {
+		return result, err
+	}
+	branchName = collision.BranchName
+
 	result.BranchName = branchName
+	result.Reused = collision.Reuse
 
-	if cb.Cfg.DryRun {
-		if cb.Cfg.OutputFormat == "json" {
-			jsonBytes, jsonErr := json.Marshal(result)
-			if jsonErr != nil {
-				return result, fmt.Errorf("failed to serialize dry-run result: %w", jsonErr)
-			}
-			fmt.Println(string(jsonBytes))
-		} else {
-			fmt.Printf("[dry-run] Would create branch: %s from %s\n", logging.PaintInfo(branchName), logging.PaintInfo(baseBranch))
+	if cb.Cfg.Worktree {
+		if result.Worktree, err = worktreePath(ctx, cb.Git, cb.Cfg.WorktreePath, branchName); err != nil {
+			return result, err
 		}
-		return result, nil
+	} else if !collision.Reuse && cb.Git.BranchExists(ctx, branchName) {
+		return result, ErrLocalBranchAlreadyExists(branchName)
 	}
 
-	if cb.Cfg.OutputFormat != "json" {
-		fmt.Printf("\nA new local branch named %s is going to be created\n", logging.PaintInfo(branchName))
-	}
-	if cb.Cfg.IsInteractive {
-		confirmed, err := cb.UserInteractionProvider.AskUserForConfirmation("Do you want to continue?", true)
-		if err != nil {
-			return result, err
+	if !collision.Reuse && !cb.Cfg.DryRun {
+		if cb.Cfg.OutputFormat != "json" {
+			if result.Worktree != "" {
+				fmt.Fprintf(logging.Output(), "\nA new local branch named %s is going to be created in the worktree %s\n",
+					logging.PaintInfo(branchName), logging.PaintInfo(result.Worktree))
+			} else {
+				fmt.Fprintf(logging.Output(), "\nA new local branch named %s is going to be created\n", logging.PaintInfo(branchName))
+			}
 		}
-		if !confirmed {
-			return result, nil
+		if cb.Cfg.IsInteractive {
+			confirmed, err := cb.UserInteractionProvider.AskUserForConfirmation("Do you want to continue?", true)
+			if err != nil {
+				return result, err
+			}
+			if !confirmed {
+				return result, nil
+			}
 		}
 	}
 
-	if err := cb.checkoutBranch(branchName, baseBranch, !cb.Cfg.FetchFromOrigin); err != nil {
+	result.Created = !collision.Reuse
+	plan := cb.plan(ctx, &result, baseBranch, collision.Reuse)
+
+	if cb.Cfg.DryRun {
+		result.DryRun = true
+		result.Plan = plan.Steps
+		if cb.Cfg.OutputFormat == "json" {
+			return result, cb.printJSON(result)
+		}
+		plan.Print()
+		return result, nil
+	}
+
+	if err := plan.Run(); err != nil {
 		return result, err
 	}
 
 	if cb.Cfg.OutputFormat == "json" {
-		jsonBytes, jsonErr := json.Marshal(result)
-		if jsonErr != nil {
-			return result, fmt.Errorf("failed to serialize result: %w", jsonErr)
-		}
-		fmt.Println(string(jsonBytes))
-	} else {
-		fmt.Printf("A local branch named %s has been created!\n", logging.PaintInfo(branchName))
+		return result, cb.printJSON(result)
 	}
 
+	switch {
+	case result.Worktree != "":
+		fmt.Fprintf(logging.Output(), "The branch %s is ready in the worktree %s\n", logging.PaintInfo(branchName), logging.PaintInfo(result.Worktree))
+	case collision.Reuse:
+		fmt.Fprintf(logging.Output(), "Switched to the existing branch %s\n", logging.PaintInfo(branchName))
+	default:
+		fmt.Fprintf(logging.Output(), "A local branch named %s has been created!\n", logging.PaintInfo(branchName))
+	}
+	cb.printCarriedChanges(result)
+
 	return result, nil
 }
 
-func (cb CreateBranch) checkoutBranch(branchName string, baseBranch string, fetch bool) error {
-	if cb.Git.BranchExists(branchName) {
-		return fmt.Errorf("a local branch with the name %s already exists", branchName)
+// plan adds the steps to create the branch, or to switch to it if it is reused, to the plan of the
+// use case. The worktree of the branch is added instead if it is set in the result.
+func (cb CreateBranch) plan(ctx context.Context, result *CreateBranchResult, baseBranch string, reuse bool) *Plan {
+	plan := cb.Plan
+	if plan == nil {
+		plan = &Plan{}
+	}
+	branchName := result.BranchName
+
+	if !reuse && cb.Cfg.FetchFromOrigin {
+		plan.Add(PlanStep{Action: PlanActionFetch, Branch: baseBranch}, func() error {
+			if err := cb.Git.FetchBranchFromOrigin(ctx, baseBranch); err != nil {
+				return fmt.Errorf("error while fetching the branch %s: %s", baseBranch, err)
+			}
+			return nil
+		})
 	}
 
-	if fetch {
-		if err := cb.Git.FetchBranchFromOrigin(baseBranch); err != nil {
-			return fmt.Errorf("error while fetching the branch %s: %s", baseBranch, err)
+	switch {
+	case result.Worktree != "":
+		base := baseBranch
+		if reuse {
+			base = ""
 		}
+		plan.Add(PlanStep{Action: PlanActionAddWorktree, Path: result.Worktree, Branch: branchName, Base: base}, func() error {
+			return cb.Git.AddWorktree(ctx, result.Worktree, branchName, base)
+		})
+	case reuse:
+		plan.Add(PlanStep{Action: PlanActionCheckoutBranch, Branch: branchName, CarryChanges: cb.Cfg.CarryChanges}, func() (err error) {
+			result.CarriedChanges, err = cb.carryChanges().checkout(ctx, branchName, func() error {
+				return cb.Git.CheckoutBranch(ctx, branchName)
+			})
+			return err
+		})
+	default:
+		plan.Add(PlanStep{Action: PlanActionCreateBranch, Branch: branchName, Base: baseBranch, CarryChanges: cb.Cfg.CarryChanges}, func() (err error) {
+			result.CarriedChanges, err = cb.carryChanges().checkout(ctx, branchName, func() error {
+				return cb.Git.CheckoutNewBranchFromOrigin(ctx, branchName, baseBranch)
+			})
+			return err
+		})
+	}
+
+	return plan
+}
+
+func (cb CreateBranch) printJSON(result CreateBranchResult) error {
+	if cb.Cfg.quiet {
+		return nil
 	}
 
-	if err := cb.Git.CheckoutNewBranchFromOrigin(branchName, baseBranch); err != nil {
-		return err
+	jsonBytes, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to serialize result: %w", err)
 	}
+	fmt.Println(string(jsonBytes))
 
 	return nil
 }
+
+func (cb CreateBranch) branchCollision() branchCollision {
+	return branchCollision{
+		git:                     cb.Git,
+		userInteractionProvider: cb.UserInteractionProvider,
+		strategy:                cb.Cfg.OnCollision,
+		isInteractive:           cb.Cfg.IsInteractive,
+	}
+}
+
+func (cb CreateBranch) carryChanges() carryChanges {
+	return carryChanges{
+		git:                     cb.Git,
+		userInteractionProvider: cb.UserInteractionProvider,
+		enabled:                 cb.Cfg.CarryChanges,
+		isInteractive:           cb.Cfg.IsInteractive,
+	}
+}
+
+func (cb CreateBranch) printCarriedChanges(result CreateBranchResult) {
+	if result.CarriedChanges {
+		fmt.Fprintf(logging.Output(), "The uncommitted changes have been carried to the branch %s\n", logging.PaintInfo(result.BranchName))
+	}
+}
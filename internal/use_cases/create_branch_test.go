@@ -1,6 +1,7 @@
 package use_cases_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -75,17 +76,62 @@ func (s *CreateGithubBranchExecutionTestSuite) TestCreateBranchExecution() {
 
 		s.uc.Cfg.IssueID = "1"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.Error(err)
-		s.False(s.gitProvider.BranchExists(s.defaultBranchName))
+		s.False(s.gitProvider.BranchExists(context.Background(), s.defaultBranchName))
 	})
 
 	s.Run("should error if no issue flag is provided", func() {
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, "sherpa needs an valid issue identifier")
-		s.False(s.gitProvider.BranchExists(s.defaultBranchName))
+		s.False(s.gitProvider.BranchExists(context.Background(), s.defaultBranchName))
+	})
+
+	s.Run("should let the user pick an assigned issue if no issue flag is provided", func() {
+		mocks.UnsetExpectedCall(&s.userInteractionProvider.Mock, s.userInteractionProvider.AskUserForConfirmation)
+		s.userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to continue?", true).Return(true, nil).Maybe()
+		s.issueTrackerProvider.AssignedIssues = []domain.Issue{
+			domainFakes.NewFakeIssue("3", issue_types.Documentation, domain.IssueTrackerTypeGithub),
+			domainFakes.NewFakeIssue("1", issue_types.Feature, domain.IssueTrackerTypeGithub),
+		}
+		s.userInteractionProvider.EXPECT().SelectWithFilter(mock.Anything, []string{"GH-3 fake title", "GH-1 fake title"}, mock.Anything).
+			Run(func(message string, options []string, selectedIndex *int) {
+				*selectedIndex = 1
+			}).Return(nil).Once()
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(s.gitProvider.BranchExists(context.Background(), s.defaultBranchName))
+		s.userInteractionProvider.AssertExpectations(s.T())
+	})
+
+	s.Run("should let the user pick an issue of the saved query", func() {
+		mocks.UnsetExpectedCall(&s.userInteractionProvider.Mock, s.userInteractionProvider.AskUserForConfirmation)
+		s.userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to continue?", true).Return(true, nil).Maybe()
+		s.uc.Cfg.Query = "triage"
+		s.issueTrackerProvider.QueryIssues = map[string][]domain.Issue{
+			"triage": {domainFakes.NewFakeIssue("1", issue_types.Feature, domain.IssueTrackerTypeGithub)},
+		}
+		s.userInteractionProvider.EXPECT().SelectWithFilter(mock.Anything, []string{"GH-1 fake title"}, mock.Anything).Return(nil).Once()
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(s.gitProvider.BranchExists(context.Background(), s.defaultBranchName))
+		s.userInteractionProvider.AssertExpectations(s.T())
+	})
+
+	s.Run("should error if the saved query has no issues", func() {
+		s.uc.Cfg.Query = "triage"
+		s.issueTrackerProvider.QueryIssues = map[string][]domain.Issue{"triage": {}}
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, "there are no issues matching the query triage")
+		s.False(s.gitProvider.BranchExists(context.Background(), s.defaultBranchName))
 	})
 
 	s.Run("should error if branch already exists with default flag", func() {
@@ -96,7 +142,7 @@ func (s *CreateGithubBranchExecutionTestSuite) TestCreateBranchExecution() {
 		s.uc.Cfg.IssueID = "3"
 		s.uc.Cfg.IsInteractive = false
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, fmt.Sprintf("a local branch with the name %s already exists", branchName))
 	})
@@ -105,10 +151,29 @@ func (s *CreateGithubBranchExecutionTestSuite) TestCreateBranchExecution() {
 		s.uc.Cfg.IssueID = "1"
 		s.uc.Cfg.IsInteractive = false
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
-		s.True(s.gitProvider.BranchExists(s.defaultBranchName))
+		s.True(s.gitProvider.BranchExists(context.Background(), s.defaultBranchName))
+	})
+
+	s.Run("should return the issue, base branch and fork in the result", func() {
+		s.uc.Cfg.IssueID = "1"
+		s.uc.Cfg.IsInteractive = false
+		s.uc.Fork = &use_cases.ForkResult{Name: "octocat/gh-sherpa-test-repo", Upstream: "inditextech/gh-sherpa-test-repo", Created: true}
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal(use_cases.CreateBranchResult{
+			BranchName: s.defaultBranchName,
+			BaseBranch: "main",
+			Issue: &use_cases.StatusIssue{
+				ID: "GH-1", Title: "fake title", Type: "feature", Tracker: "github", State: "open", URL: "fake url",
+			},
+			Created: true,
+			Fork:    s.uc.Fork,
+		}, result)
 	})
 
 	s.Run("should create branch if not exists without default flag", func() {
@@ -116,10 +181,10 @@ func (s *CreateGithubBranchExecutionTestSuite) TestCreateBranchExecution() {
 		s.userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to continue?", true).Return(true, nil).Maybe()
 		s.uc.Cfg.IssueID = "1"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
-		s.True(s.gitProvider.BranchExists(s.defaultBranchName))
+		s.True(s.gitProvider.BranchExists(context.Background(), s.defaultBranchName))
 	})
 
 	s.Run("should error if branch already exists without default flag", func() {
@@ -132,10 +197,219 @@ func (s *CreateGithubBranchExecutionTestSuite) TestCreateBranchExecution() {
 
 		s.uc.Cfg.IssueID = "3"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, fmt.Sprintf("a local branch with the name %s already exists", branchName))
 	})
+
+	s.Run("should error if branch already exists only in remote with default strategy", func() {
+		branchName := "feature/GH-3-remote-branch"
+		s.gitProvider.AddRemoteBranches(branchName)
+		s.branchProvider.SetBranchName(branchName)
+
+		s.uc.Cfg.IssueID = "3"
+		s.uc.Cfg.IsInteractive = false
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, use_cases.ErrRemoteBranchAlreadyExists(branchName).Error())
+		s.ErrorIs(err, domain.ErrBranchExists)
+		s.False(s.gitProvider.BranchExists(context.Background(), branchName))
+	})
+
+	s.Run("should switch to existing branch with reuse strategy", func() {
+		branchName := "feature/GH-3-local-branch"
+		s.gitProvider.AddLocalBranches(branchName)
+		s.branchProvider.SetBranchName(branchName)
+
+		s.uc.Cfg.IssueID = "3"
+		s.uc.Cfg.IsInteractive = false
+		s.uc.Cfg.OnCollision = use_cases.BranchCollisionReuse
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(result.Reused)
+		s.False(result.Created)
+		s.True(s.gitProvider.IsCurrentBranch(branchName))
+	})
+
+	s.Run("should create branch with numeric suffix with suffix strategy", func() {
+		branchName := "feature/GH-3-local-branch"
+		s.gitProvider.AddLocalBranches(branchName)
+		s.gitProvider.AddRemoteBranches(branchName + "-2")
+		s.branchProvider.SetBranchName(branchName)
+
+		s.uc.Cfg.IssueID = "3"
+		s.uc.Cfg.IsInteractive = false
+		s.uc.Cfg.OnCollision = use_cases.BranchCollisionSuffix
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal(branchName+"-3", result.BranchName)
+		s.True(s.gitProvider.BranchExists(context.Background(), branchName+"-3"))
+	})
+
+	s.Run("should ask the user what to do with ask strategy", func() {
+		branchName := "feature/GH-3-local-branch"
+		s.gitProvider.AddLocalBranches(branchName)
+		s.branchProvider.SetBranchName(branchName)
+
+		mocks.UnsetExpectedCall(&s.userInteractionProvider.Mock, s.userInteractionProvider.AskUserForConfirmation)
+		s.userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to continue?", true).Return(true, nil).Maybe()
+		s.userInteractionProvider.EXPECT().SelectOrInputPrompt("What do you want to do with the existing branch?", []string{"reuse", "suffix", "fail"}, mock.Anything, true).Run(func(message string, validValues []string, variable *string, required bool) {
+			*variable = "suffix"
+		}).Return(nil).Once()
+
+		s.uc.Cfg.IssueID = "3"
+		s.uc.Cfg.OnCollision = use_cases.BranchCollisionAsk
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal(branchName+"-2", result.BranchName)
+		s.userInteractionProvider.AssertExpectations(s.T())
+	})
+
+	s.Run("should carry the uncommitted changes to the new branch", func() {
+		s.gitProvider.UncommittedChanges = true
+
+		s.uc.Cfg.IssueID = "1"
+		s.uc.Cfg.IsInteractive = false
+		s.uc.Cfg.CarryChanges = true
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(result.CarriedChanges)
+		s.True(s.gitProvider.IsCurrentBranch(s.defaultBranchName))
+		s.True(s.gitProvider.UncommittedChanges)
+		s.Empty(s.gitProvider.Stashes)
+	})
+
+	s.Run("should error if the uncommitted changes prevent creating the branch", func() {
+		s.gitProvider.UncommittedChanges = true
+		s.gitProvider.BranchWithCheckoutConflict = []string{"main"}
+
+		s.uc.Cfg.IssueID = "1"
+		s.uc.Cfg.IsInteractive = false
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorIs(err, use_cases.ErrDirtyWorkingTree)
+		s.ErrorIs(err, domainFakes.ErrCheckoutConflict)
+		s.True(s.gitProvider.IsCurrentBranch("main"))
+	})
+
+	s.Run("should create the branch in a new worktree", func() {
+		s.gitProvider.RepositoryRoot = "/src/repo"
+
+		s.uc.Cfg.IssueID = "1"
+		s.uc.Cfg.IsInteractive = false
+		s.uc.Cfg.Worktree = true
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal("/src/repo-GH-1", result.Worktree)
+		s.True(s.gitProvider.BranchExists(context.Background(), s.defaultBranchName))
+		s.True(s.gitProvider.IsCurrentBranch("main"))
+		s.Equal([]domain.Worktree{{Path: "/src/repo-GH-1", Branch: s.defaultBranchName}}, s.gitProvider.Worktrees)
+	})
+
+	s.Run("should create the worktree at the given path pattern", func() {
+		s.gitProvider.RepositoryRoot = "/src/repo"
+
+		s.uc.Cfg.IssueID = "1"
+		s.uc.Cfg.IsInteractive = false
+		s.uc.Cfg.Worktree = true
+		s.uc.Cfg.WorktreePath = "/tmp/worktrees/{{repo}}/{{branch}}"
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal("/tmp/worktrees/repo/feature-GH-1-sample-issue", result.Worktree)
+	})
+
+	s.Run("should add a worktree for the existing branch with reuse strategy", func() {
+		s.gitProvider.RepositoryRoot = "/src/repo"
+		s.gitProvider.AddLocalBranches(s.defaultBranchName)
+
+		s.uc.Cfg.IssueID = "1"
+		s.uc.Cfg.IsInteractive = false
+		s.uc.Cfg.Worktree = true
+		s.uc.Cfg.OnCollision = use_cases.BranchCollisionReuse
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(result.Reused)
+		s.Equal([]domain.Worktree{{Path: "/src/repo-GH-1", Branch: s.defaultBranchName}}, s.gitProvider.Worktrees)
+	})
+
+	s.Run("should keep the stash if the carried changes conflict with the new branch", func() {
+		s.gitProvider.UncommittedChanges = true
+		s.gitProvider.BranchWithStashConflict = []string{s.defaultBranchName}
+
+		s.uc.Cfg.IssueID = "1"
+		s.uc.Cfg.IsInteractive = false
+		s.uc.Cfg.CarryChanges = true
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, fmt.Sprintf("the uncommitted changes carried to the branch %s conflict with it", s.defaultBranchName))
+		s.Equal([]string{"sherpa-carry: main"}, s.gitProvider.Stashes)
+	})
+
+	s.Run("should return the plan without creating the branch in dry-run mode", func() {
+		s.uc.Cfg.IssueID = "1"
+		s.uc.Cfg.DryRun = true
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(result.DryRun)
+		s.Equal([]use_cases.PlanStep{
+			{Action: use_cases.PlanActionFetch, Description: "Fetch the branch main", Branch: "main"},
+			{Action: use_cases.PlanActionCreateBranch, Description: "Create the branch feature/GH-1-sample-issue from main", Branch: s.defaultBranchName, Base: "main"},
+		}, result.Plan)
+		s.False(s.gitProvider.BranchExists(context.Background(), s.defaultBranchName))
+		s.userInteractionProvider.AssertNotCalled(s.T(), "AskUserForConfirmation", "Do you want to continue?", true)
+	})
+
+	s.Run("should plan to switch to the existing branch in dry-run mode", func() {
+		s.gitProvider.AddLocalBranches(s.defaultBranchName)
+
+		s.uc.Cfg.IssueID = "1"
+		s.uc.Cfg.DryRun = true
+		s.uc.Cfg.OnCollision = use_cases.BranchCollisionReuse
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal([]use_cases.PlanStep{
+			{Action: use_cases.PlanActionCheckoutBranch, Description: "Switch to the branch feature/GH-1-sample-issue", Branch: s.defaultBranchName},
+		}, result.Plan)
+		s.True(s.gitProvider.IsCurrentBranch("main"))
+	})
+
+	s.Run("should run the steps planned before the use case first", func() {
+		plan := &use_cases.Plan{}
+		plan.Add(use_cases.PlanStep{Action: use_cases.PlanActionSetupFork, Fork: "user/repo"}, func() error {
+			return nil
+		})
+		s.uc.Plan = plan
+
+		s.uc.Cfg.IssueID = "1"
+		s.uc.Cfg.DryRun = true
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Len(result.Plan, 3)
+		s.Equal("Set up the fork user/repo and its remotes", result.Plan[0].Description)
+	})
 }
 
 func (s *CreateGithubBranchExecutionTestSuite) initializeUserInteractionProvider() *domainMocks.MockUserInteractionProvider {
@@ -195,17 +469,17 @@ func (s *CreateJiraBranchExecutionTestSuite) TestCreateBranchExecution() {
 
 		s.uc.Cfg.IssueID = issueID
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.Error(err)
-		s.False(s.gitProvider.BranchExists(s.defaultBranchName))
+		s.False(s.gitProvider.BranchExists(context.Background(), s.defaultBranchName))
 	})
 
 	s.Run("should error if no issue flag is provided", func() {
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, "sherpa needs an valid issue identifier")
-		s.False(s.gitProvider.BranchExists(s.defaultBranchName))
+		s.False(s.gitProvider.BranchExists(context.Background(), s.defaultBranchName))
 	})
 
 	s.Run("should error if branch already exists with default flag", func() {
@@ -216,7 +490,7 @@ func (s *CreateJiraBranchExecutionTestSuite) TestCreateBranchExecution() {
 		s.uc.Cfg.IssueID = "PROJECTKEY-3"
 		s.uc.Cfg.IsInteractive = false
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, fmt.Sprintf("a local branch with the name %s already exists", branchName))
 	})
@@ -225,10 +499,10 @@ func (s *CreateJiraBranchExecutionTestSuite) TestCreateBranchExecution() {
 		s.uc.Cfg.IssueID = issueID
 		s.uc.Cfg.IsInteractive = false
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
-		s.True(s.gitProvider.BranchExists(s.defaultBranchName))
+		s.True(s.gitProvider.BranchExists(context.Background(), s.defaultBranchName))
 	})
 
 	s.Run("should create branch if not exists without default flag", func() {
@@ -236,10 +510,10 @@ func (s *CreateJiraBranchExecutionTestSuite) TestCreateBranchExecution() {
 		s.userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to continue?", true).Return(true, nil).Maybe()
 		s.uc.Cfg.IssueID = issueID
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
-		s.True(s.gitProvider.BranchExists(s.defaultBranchName))
+		s.True(s.gitProvider.BranchExists(context.Background(), s.defaultBranchName))
 	})
 
 	s.Run("should error if branch already exists without default flag", func() {
@@ -252,7 +526,7 @@ func (s *CreateJiraBranchExecutionTestSuite) TestCreateBranchExecution() {
 
 		s.uc.Cfg.IssueID = issueID
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, fmt.Sprintf("a local branch with the name %s already exists", branchName))
 	})
@@ -1,21 +1,39 @@
 package use_cases
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/InditexTech/gh-sherpa/internal/branches"
 	"github.com/InditexTech/gh-sherpa/internal/domain"
 	"github.com/InditexTech/gh-sherpa/internal/logging"
 )
 
+// DefaultInitialCommitMessage is the message of the empty commit when it is not configured
+const DefaultInitialCommitMessage = "chore: initial commit"
+
 // ErrRemoteBranchAlreadyExists is returned when the remote branch already exists
 func ErrRemoteBranchAlreadyExists(branchName string) error {
-	return fmt.Errorf("there is already a remote branch named %s for this issue. Please checkout that branch", branchName)
+	return domain.NewError(domain.ErrorCodeBranchExists,
+		fmt.Errorf("there is already a remote branch named %s for this issue. Please checkout that branch", branchName))
+}
+
+// ErrPullRequestAlreadyExists is returned when the branch already has an open pull request
+func ErrPullRequestAlreadyExists(url string) error {
+	return domain.NewError(domain.ErrorCodePullRequestExists, fmt.Errorf("a pull request %s for this branch already exists", url))
+}
+
+// ErrNoCommitsToPush is returned when the initial empty commit is skipped but the branch has no commits,
+// as GitHub does not accept pull requests without commits
+func ErrNoCommitsToPush(branchName string) error {
+	return fmt.Errorf("the branch %s has no commits and the initial empty commit is skipped, so the pull request cannot be created. Commit your changes or disable pull_requests.initial_commit.skip", branchName)
 }
 
 // ErrFetchBranch is returned when the branch could not be fetched
@@ -30,22 +48,43 @@ func ErrPushChanges(branch string, err error) error {
 
 // CreatePullRequestResult holds the outcome of a successful CreatePullRequest execution.
 type CreatePullRequestResult struct {
-	BranchName string `json:"branch"`
-	PRURL      string `json:"pr_url"`
-	Draft      bool   `json:"draft"`
+	BranchName string       `json:"branch"`
+	BaseBranch string       `json:"base"`
+	Issue      *StatusIssue `json:"issue,omitempty"`
+	PRURL      string       `json:"pr_url"`
+	PRNumber   int64        `json:"pr_number,omitempty"`
+	Draft      bool         `json:"draft"`
+	Labels     []string     `json:"labels"`
+	Reviewers  []string     `json:"reviewers"`
+	Assignees  []string     `json:"assignees"`
+	StackedOn  string       `json:"stacked_on,omitempty"`
+	Updated    bool         `json:"updated,omitempty"`
+	// Reused is set when the pull request is created from an existing branch and Created when the branch is new
+	Reused  bool        `json:"reused"`
+	Created bool        `json:"created"`
+	Fork    *ForkResult `json:"fork,omitempty"`
+	// CarriedChanges is set when the uncommitted changes were carried to the branch
+	CarriedChanges bool `json:"carried_changes,omitempty"`
+	// Warnings holds the problems that did not stop the creation of the pull request, like a reviewer
+	// that could not be requested
+	Warnings []string `json:"warnings,omitempty"`
+	// DryRun is set when nothing was done and Plan holds the steps that would have been done
+	DryRun bool       `json:"dry_run,omitempty"`
+	Plan   []PlanStep `json:"plan,omitempty"`
 }
 
 // CreatePullRequestConfiguration contains the arguments for the CreatePullRequest use case
 type CreatePullRequestConfiguration struct {
 	IssueID             string
 	BaseBranch          string
+	StackOn             string // --stack-on: create the branch from this branch and use it as base of the pull request
 	FetchFromOrigin     bool
 	DraftPR             bool
 	IsInteractive       bool
 	CloseIssue          bool
 	TemplatePath        string
 	BranchName          string   // --branch-name: bypass generation and use this name directly
-	DryRun              bool     // --dry-run: print what would happen without executing
+	DryRun              bool     // --dry-run: print the plan of the steps without doing them
 	OutputFormat        string   // --output: "" (default) or "json"
 	PRTitle             string   // --pr-title: override the auto-generated PR title
 	PRBody              string   // --pr-body: override the auto-generated PR body
@@ -54,6 +93,19 @@ type CreatePullRequestConfiguration struct {
 	ExtraLabels         []string // --label: additional labels to apply to the PR
 	Reviewers           []string // --reviewer: PR reviewers
 	Assignees           []string // --assignee: PR assignees
+	UpdateExisting      bool     // --update-existing: refresh the open pull request of the branch instead of failing
+	CarryChanges        bool     // --carry-changes: stash the uncommitted changes and apply them again on the branch
+	RollbackOnFailure   bool     // --rollback-on-failure: undo the changes in the repository without asking if it fails
+	TypeLabels          []string // all the labels mapped to an issue type, the stale ones are removed when updating
+	InitialCommit       InitialCommitConfiguration
+	OnCollision         BranchCollisionStrategy
+}
+
+// InitialCommitConfiguration contains the pull_requests.initial_commit settings
+type InitialCommitConfiguration struct {
+	Message string // template of the message with the {{issue}}, {{title}}, {{type}} and {{branch}} placeholders
+	Skip    bool   // never create the empty commit, failing if the branch has no commits
+	Signoff bool   // add the Signed-off-by trailer
 }
 
 type CreatePullRequest struct {
@@ -64,15 +116,23 @@ type CreatePullRequest struct {
 	UserInteractionProvider domain.UserInteractionProvider
 	PullRequestProvider     domain.PullRequestProvider
 	BranchProvider          domain.BranchProvider
+	// Plan holds the steps planned before the use case, like the fork setup. A new plan is used if it is nil.
+	Plan *Plan
+	// Fork is the fork set up with the plan, reported in the result
+	Fork *ForkResult
+	// changes records the changes done in the repository to undo them if the execution fails
+	changes *rollback
+	// carriedChanges is set when the uncommitted changes were carried to the branch of the pull request
+	carriedChanges bool
 }
 
-func normalizeTemplatePath(git domain.GitProvider, path string) (string, error) {
+func normalizeTemplatePath(ctx context.Context, git domain.GitProvider, path string) (string, error) {
 	if path == "" {
 		return "", nil
 	}
 
 	if !filepath.IsAbs(path) {
-		repoRoot, err := git.GetRepositoryRoot()
+		repoRoot, err := git.GetRepositoryRoot(ctx)
 		if err != nil {
 			return "", fmt.Errorf("failed to determine repository root: %w", err)
 		}
@@ -82,12 +142,12 @@ func normalizeTemplatePath(git domain.GitProvider, path string) (string, error)
 	return path, nil
 }
 
-func validateTemplateFile(templatePath string, git domain.GitProvider) error {
+func validateTemplateFile(ctx context.Context, templatePath string, git domain.GitProvider) error {
 	if templatePath == "" {
 		return nil // No template to validate
 	}
 
-	normalizedPath, err := normalizeTemplatePath(git, templatePath)
+	normalizedPath, err := normalizeTemplatePath(ctx, git, templatePath)
 	if err != nil {
 		return err
 	}
@@ -104,9 +164,9 @@ func validateTemplateFile(templatePath string, git domain.GitProvider) error {
 }
 
 // Execute executes the create pull request use case
-func (cpr CreatePullRequest) Execute() (result CreatePullRequestResult, err error) {
+func (cpr CreatePullRequest) Execute(ctx context.Context) (result CreatePullRequestResult, err error) {
 	// Validate template if specified
-	if err := validateTemplateFile(cpr.Cfg.TemplatePath, cpr.Git); err != nil {
+	if err := validateTemplateFile(ctx, cpr.Cfg.TemplatePath, cpr.Git); err != nil {
 		return result, err
 	}
 
@@ -117,53 +177,90 @@ func (cpr CreatePullRequest) Execute() (result CreatePullRequestResult, err erro
 	}
 	// Normalize so helper methods that read cpr.Cfg.IsInteractive also see the effective value.
 	cpr.Cfg.IsInteractive = isInteractive
+
+	cpr.changes = &rollback{}
+	if cpr.Plan == nil {
+		cpr.Plan = &Plan{}
+	}
+	defer func() {
+		if err != nil {
+			opts := rollbackOptions{auto: cpr.Cfg.RollbackOnFailure, isInteractive: isInteractive, quiet: cpr.Cfg.OutputFormat == "json"}
+			err = rollbackOnFailure(ctx, cpr.changes, err, opts, cpr.UserInteractionProvider)
+		}
+	}()
 	fromLocalBranch := cpr.Cfg.IssueID == ""
 
-	repo, err := cpr.RepositoryProvider.GetRepository()
+	repo, err := cpr.RepositoryProvider.GetRepository(ctx)
 	if err != nil {
 		logging.Debugf("error while getting repo: %s", err)
 		return result, err
 	}
 
 	baseBranch := cpr.Cfg.BaseBranch
+	if cpr.Cfg.StackOn != "" {
+		baseBranch = cpr.Cfg.StackOn
+	}
 	if baseBranch == "" {
 		baseBranch = repo.DefaultBranchRef
 	}
-	if err := cpr.fetchBranch(baseBranch); err != nil {
+	cpr.planFetch(ctx, baseBranch, false)
+	if err := cpr.runPlan(); err != nil {
 		return result, err
 	}
+	if cpr.Cfg.StackOn != "" && !cpr.Git.RemoteBranchExists(ctx, cpr.Cfg.StackOn) {
+		return result, ErrStackParentNotPushed(cpr.Cfg.StackOn)
+	}
 
-	currentBranch, err := cpr.Git.GetCurrentBranch()
+	currentBranch, err := cpr.Git.GetCurrentBranch(ctx)
 	if err != nil {
-		return result, fmt.Errorf("could not get the current branch name because %s", err)
+		return result, fmt.Errorf("could not get the current branch name because %w", err)
 	}
+	originalBranch := currentBranch
 
 	var issueID string
 	if fromLocalBranch {
 		issueID, err = cpr.extractIssueIdFromBranch(currentBranch)
 		if err != nil {
-			return result, err
+			if !isInteractive {
+				return result, err
+			}
+
+			// The current branch has no issue, so let the user choose one to create its branch
+			picker := issuePicker{
+				issueTrackerProvider:    cpr.IssueTrackerProvider,
+				userInteractionProvider: cpr.UserInteractionProvider,
+			}
+			pickedIssueID, pickErr := picker.pick(ctx)
+			if errors.Is(pickErr, ErrNoAssignedIssues) {
+				return result, err
+			}
+			if pickErr != nil {
+				return result, pickErr
+			}
+			issueID, fromLocalBranch = pickedIssueID, false
 		}
 	} else {
 		issueID = cpr.Cfg.IssueID
 	}
 
-	issue, err := cpr.IssueTrackerProvider.GetIssue(issueID)
+	issue, err := cpr.IssueTrackerProvider.GetIssue(ctx, issueID)
 	if err != nil {
 		return result, err
 	}
+	result.Issue = newResultIssue(issue)
 
 	var branchExists bool
 	if fromLocalBranch {
 		branchExists = true
 	} else {
 		formattedIssueID := issue.FormatID()
-		currentBranch, branchExists = cpr.Git.FindBranch(fmt.Sprintf("/%s-", formattedIssueID))
+		currentBranch, branchExists = cpr.Git.FindBranch(ctx, fmt.Sprintf("/%s-", formattedIssueID))
 
 		if branchExists {
 			if !isInteractive {
 				if cpr.Cfg.NoUseExistingBranch {
-					return result, fmt.Errorf("the branch %s already exists", logging.PaintWarning(currentBranch))
+					return result, domain.NewError(domain.ErrorCodeBranchExists,
+						fmt.Errorf("the branch %s already exists", logging.PaintWarning(currentBranch)))
 				}
 				// Default non-interactive behavior: reuse the existing branch silently
 				logging.Debugf("Reusing existing branch %s", currentBranch)
@@ -188,14 +285,11 @@ func (cpr CreatePullRequest) Execute() (result CreatePullRequestResult, err erro
 		}
 	}
 
-	// Fetch branch to get the latest changes. We don't check the error because
-	// the branch may not exist in the remote repository.
-	_ = cpr.fetchBranch(currentBranch)
-
+	var created bool
 	if branchExists && branchConfirmed {
-		if err := cpr.Git.CheckoutBranch(currentBranch); err != nil {
-			return result, fmt.Errorf("could not switch to the branch because %w", err)
-		}
+		// Fetch the branch to get the latest changes. It may not exist in the remote repository.
+		cpr.planFetch(ctx, currentBranch, true)
+		cpr.planCheckout(ctx, originalBranch, currentBranch)
 	} else {
 		currentBranch, err = cpr.BranchProvider.GetBranchName(issue, *repo)
 		if err != nil {
@@ -203,11 +297,14 @@ func (cpr CreatePullRequest) Execute() (result CreatePullRequestResult, err erro
 		}
 
 		// After stablishing the branch, fetch it to get the latest changes.
-		// We don't check the error because the branch may not exist in the
-		// remote repository.
-		_ = cpr.fetchBranch(currentBranch)
+		// It may not exist in the remote repository.
+		cpr.planFetch(ctx, currentBranch, true)
+		if err := cpr.runPlan(); err != nil {
+			return result, err
+		}
 
-		cancel, err := cpr.createBranch(currentBranch, baseBranch)
+		var cancel bool
+		currentBranch, created, cancel, err = cpr.createBranch(ctx, currentBranch, baseBranch, originalBranch)
 		if err != nil {
 			return result, err
 		}
@@ -215,18 +312,24 @@ func (cpr CreatePullRequest) Execute() (result CreatePullRequestResult, err erro
 			return result, nil
 		}
 	}
-
-	hasPendingCommits, err := cpr.hasPendingCommits(currentBranch)
-	if err != nil {
+	if err := cpr.runPlan(); err != nil {
 		return result, err
 	}
 
+	// A new branch has no commits to push yet, and in dry-run mode it does not exist
+	var hasPendingCommits bool
+	if !created {
+		if hasPendingCommits, err = cpr.hasPendingCommits(ctx, currentBranch); err != nil {
+			return result, err
+		}
+	}
+
 	if hasPendingCommits {
 		if cpr.Cfg.OutputFormat != "json" {
 			logging.PrintWarn("the branch contains commits that have not been pushed yet")
 		}
 
-		if isInteractive {
+		if isInteractive && !cpr.Cfg.DryRun {
 			confirmed, err := cpr.UserInteractionProvider.AskUserForConfirmation(
 				"Do you want to continue pushing all pending commits in this branch and create the pull request", true)
 			if err != nil {
@@ -238,65 +341,174 @@ func (cpr CreatePullRequest) Execute() (result CreatePullRequestResult, err erro
 		}
 	}
 
-	// Early exit for dry-run: report what would happen without touching the remote.
-	if cpr.Cfg.DryRun {
-		result.BranchName = currentBranch
-		result.Draft = cpr.Cfg.DraftPR
-		if cpr.Cfg.OutputFormat == "json" {
-			jsonBytes, jsonErr := json.Marshal(result)
-			if jsonErr != nil {
-				return result, fmt.Errorf("failed to serialize dry-run result: %w", jsonErr)
-			}
-			fmt.Println(string(jsonBytes))
-		} else {
-			fmt.Printf("[dry-run] Would create PR from %s to %s\n",
-				logging.PaintInfo(currentBranch), logging.PaintInfo(baseBranch))
+	if cpr.Cfg.StackOn != "" {
+		if err := cpr.planStackParent(ctx, currentBranch); err != nil {
+			return result, err
 		}
-		return result, nil
+		result.StackedOn = cpr.Cfg.StackOn
 	}
 
-	if !hasPendingCommits && !cpr.Git.RemoteBranchExists(currentBranch) {
-		if err = cpr.createEmptyCommit(); err != nil {
-			return result, fmt.Errorf("could not do the empty commit because %s", err)
+	remoteBranchExists := cpr.Git.RemoteBranchExists(ctx, currentBranch)
+	if !hasPendingCommits && !remoteBranchExists {
+		// The branch has no commits ahead of the base remote, so it needs the empty commit to open the pull request
+		if cpr.Cfg.InitialCommit.Skip {
+			return result, ErrNoCommitsToPush(currentBranch)
 		}
+		cpr.planEmptyCommit(ctx, issue, currentBranch)
 	}
 
-	if err := cpr.pushChanges(currentBranch); err != nil {
-		return result, err
-	}
+	cpr.planPush(ctx, currentBranch, remoteBranchExists)
 
-	pr, err := cpr.PullRequestProvider.GetPullRequestForBranch(currentBranch)
+	pr, err := cpr.PullRequestProvider.GetPullRequestForBranch(ctx, currentBranch)
 	if err != nil {
 		return result, fmt.Errorf("error while getting pull request for branch: %w", err)
 	}
 
+	result.BranchName = currentBranch
+	result.BaseBranch = baseBranch
+	result.Reused = !created
+	result.Created = created
+	result.Fork = cpr.Fork
 	if pr != nil && !pr.Closed {
-		return result, fmt.Errorf("a pull request %s for this branch already exists", pr.Url)
+		if !cpr.Cfg.UpdateExisting {
+			return result, ErrPullRequestAlreadyExists(pr.Url)
+		}
+		if err := cpr.planUpdatePullRequest(ctx, &result, *pr, issue, currentBranch); err != nil {
+			return result, err
+		}
+		result.PRURL = pr.Url
+		result.PRNumber = pr.Number
+		result.Draft = pr.IsDraft
+		result.Updated = true
+	} else {
+		if err := cpr.planCreatePullRequest(ctx, &result, issue, baseBranch, currentBranch); err != nil {
+			return result, err
+		}
+		result.Draft = cpr.Cfg.DraftPR
 	}
 
-	prURL, err := cpr.createPullRequestFromIssue(issue, baseBranch, currentBranch)
-	if err != nil {
-		return result, err
+	if cpr.Cfg.DryRun {
+		result.DryRun = true
+		result.Plan = cpr.Plan.Steps
+		if cpr.Cfg.OutputFormat == "json" {
+			return result, printJSONResult(result)
+		}
+		cpr.Plan.Print()
+		return result, nil
 	}
 
-	result.BranchName = currentBranch
-	result.PRURL = prURL
-	result.Draft = cpr.Cfg.DraftPR
+	if err := cpr.Plan.Run(); err != nil {
+		return result, err
+	}
+	result.CarriedChanges = cpr.carriedChanges
 
 	if cpr.Cfg.OutputFormat == "json" {
-		jsonBytes, jsonErr := json.Marshal(result)
-		if jsonErr != nil {
-			return result, fmt.Errorf("failed to serialize result: %w", jsonErr)
-		}
-		fmt.Println(string(jsonBytes))
-	} else {
-		fmt.Printf("\nThe pull request %s have been created!\nYou are now working on the branch %s\n",
-			logging.PaintInfo(prURL), logging.PaintInfo(currentBranch))
+		return result, printJSONResult(result)
 	}
 
+	action := "created"
+	if result.Updated {
+		action = "updated"
+	}
+	fmt.Fprintf(logging.Output(), "\nThe pull request %s have been %s!\nYou are now working on the branch %s\n",
+		logging.PaintInfo(result.PRURL), action, logging.PaintInfo(currentBranch))
+
 	return result, nil
 }
 
+// runPlan runs the steps planned since the last run, unless it is a dry run
+func (cpr *CreatePullRequest) runPlan() error {
+	if cpr.Cfg.DryRun {
+		return nil
+	}
+
+	return cpr.Plan.Run()
+}
+
+// planFetch plans the fetch of the branch if fetching is enabled. The fetch of an optional branch
+// may fail because the branch does not exist in the remote repository.
+func (cpr *CreatePullRequest) planFetch(ctx context.Context, branch string, optional bool) {
+	if !cpr.Cfg.FetchFromOrigin {
+		return
+	}
+
+	cpr.Plan.Add(PlanStep{Action: PlanActionFetch, Branch: branch, Optional: optional}, func() error {
+		if err := cpr.Git.FetchBranchFromOrigin(ctx, branch); err != nil {
+			return ErrFetchBranch(branch, err)
+		}
+		return nil
+	})
+}
+
+// planCheckout plans the switch from the original branch to the existing branch
+func (cpr *CreatePullRequest) planCheckout(ctx context.Context, originalBranch string, branch string) {
+	if branch == originalBranch {
+		return
+	}
+
+	cpr.Plan.Add(PlanStep{Action: PlanActionCheckoutBranch, Branch: branch, CarryChanges: cpr.Cfg.CarryChanges}, func() error {
+		if err := cpr.checkoutBranch(ctx, branch); err != nil {
+			return fmt.Errorf("could not switch to the branch because %w", err)
+		}
+		cpr.recordCheckout(ctx, originalBranch, branch, false)
+		return nil
+	})
+}
+
+// planStackParent plans to record the base of the pull request as the parent of the branch
+func (cpr *CreatePullRequest) planStackParent(ctx context.Context, branch string) error {
+	if branch == cpr.Cfg.StackOn {
+		return fmt.Errorf("the branch %s cannot be stacked on itself", branch)
+	}
+	previousParent, err := cpr.Git.GetBranchConfig(ctx, branch, stackParentConfigKey)
+	if err != nil {
+		return err
+	}
+
+	cpr.Plan.Add(PlanStep{Action: PlanActionSetStackParent, Branch: branch, Base: cpr.Cfg.StackOn}, func() error {
+		if err := cpr.Git.SetBranchConfig(ctx, branch, stackParentConfigKey, cpr.Cfg.StackOn); err != nil {
+			return err
+		}
+		cpr.changes.record(fmt.Sprintf("restore the parent branch of %s", branch), func(ctx context.Context) error {
+			return cpr.Git.SetBranchConfig(ctx, branch, stackParentConfigKey, previousParent)
+		})
+		return nil
+	})
+
+	return nil
+}
+
+// planEmptyCommit plans the initial empty commit of the branch
+func (cpr *CreatePullRequest) planEmptyCommit(ctx context.Context, issue domain.Issue, branch string) {
+	message := initialCommitMessage(cpr.Cfg.InitialCommit.Message, issue, branch)
+	signoff := cpr.Cfg.InitialCommit.Signoff
+
+	cpr.Plan.Add(PlanStep{Action: PlanActionEmptyCommit, Branch: branch, Message: message, Signoff: signoff}, func() error {
+		if err := cpr.Git.CommitEmpty(ctx, message, signoff); err != nil {
+			return fmt.Errorf("could not do the empty commit because %w", err)
+		}
+		cpr.changes.record("remove the initial empty commit", func(ctx context.Context) error {
+			return cpr.Git.ResetLastCommit(ctx)
+		})
+		return nil
+	})
+}
+
+// planPush plans the push of the branch, which is deleted on rollback if it did not exist in the remote
+func (cpr *CreatePullRequest) planPush(ctx context.Context, branch string, remoteBranchExists bool) {
+	cpr.Plan.Add(PlanStep{Action: PlanActionPush, Branch: branch}, func() error {
+		if err := cpr.pushChanges(ctx, branch); err != nil {
+			return err
+		}
+		if !remoteBranchExists {
+			cpr.changes.record(fmt.Sprintf("delete the remote branch %s", branch), func(ctx context.Context) error {
+				return cpr.Git.DeleteRemoteBranch(ctx, branch)
+			})
+		}
+		return nil
+	})
+}
+
 func (cpr *CreatePullRequest) extractIssueIdFromBranch(currentBranch string) (string, error) {
 	branchNameInfo := branches.ParseBranchName(currentBranch)
 	if branchNameInfo == nil || branchNameInfo.IssueId == "" {
@@ -310,13 +522,23 @@ func (cpr *CreatePullRequest) extractIssueIdFromBranch(currentBranch string) (st
 	return cpr.IssueTrackerProvider.ParseIssueId(branchNameInfo.IssueId), nil
 }
 
-func (cpr *CreatePullRequest) createEmptyCommit() error {
-	return cpr.Git.CommitEmpty("chore: initial commit")
+// initialCommitMessage renders the template of the initial commit message with the fields of the issue
+func initialCommitMessage(template string, issue domain.Issue, branch string) string {
+	if strings.TrimSpace(template) == "" {
+		return DefaultInitialCommitMessage
+	}
+
+	return strings.NewReplacer(
+		"{{issue}}", issue.FormatID(),
+		"{{title}}", issue.Title(),
+		"{{type}}", string(issue.Type()),
+		"{{branch}}", branch,
+	).Replace(template)
 }
 
-func (cpr *CreatePullRequest) pushChanges(branchName string) (err error) {
+func (cpr *CreatePullRequest) pushChanges(ctx context.Context, branchName string) (err error) {
 	// 19. PUSH CHANGES
-	err = cpr.Git.PushBranch(branchName)
+	err = cpr.Git.PushBranch(ctx, branchName)
 	if err != nil {
 		return ErrPushChanges(branchName, err)
 	}
@@ -324,11 +546,11 @@ func (cpr *CreatePullRequest) pushChanges(branchName string) (err error) {
 	return
 }
 
-func (cpr *CreatePullRequest) getPullRequestTitleAndBody(issue domain.Issue) (title string, body string, err error) {
+func (cpr *CreatePullRequest) getPullRequestTitleAndBody(ctx context.Context, issue domain.Issue) (title string, body string, err error) {
 	// --pr-title and --pr-body fully override auto-generation
 	if cpr.Cfg.PRTitle != "" {
 		title = cpr.Cfg.PRTitle
-		body = cpr.Cfg.PRBody
+		body = withWrittenBody(cpr.Cfg.PRBody)
 		return
 	}
 
@@ -339,7 +561,7 @@ func (cpr *CreatePullRequest) getPullRequestTitleAndBody(issue domain.Issue) (ti
 			err = fmt.Errorf("failed to read --pr-body-file: %w", readErr)
 			return
 		}
-		body = string(content)
+		body = withWrittenBody(string(content))
 		// Still auto-generate the title from the issue
 		switch issue.TrackerType() {
 		case domain.IssueTrackerTypeGithub:
@@ -373,7 +595,7 @@ func (cpr *CreatePullRequest) getPullRequestTitleAndBody(issue domain.Issue) (ti
 
 	// --pr-body overrides only the body (when --pr-title is not set)
 	if cpr.Cfg.PRBody != "" {
-		body = cpr.Cfg.PRBody
+		body = withWrittenBody(cpr.Cfg.PRBody)
 		return
 	}
 
@@ -383,7 +605,7 @@ func (cpr *CreatePullRequest) getPullRequestTitleAndBody(issue domain.Issue) (ti
 		var templateContent []byte
 
 		// Get normalized template path (absolute path)
-		templatePath, err := normalizeTemplatePath(cpr.Git, cpr.Cfg.TemplatePath)
+		templatePath, err := normalizeTemplatePath(ctx, cpr.Git, cpr.Cfg.TemplatePath)
 		if err != nil {
 			return "", "", err
 		}
@@ -398,11 +620,13 @@ func (cpr *CreatePullRequest) getPullRequestTitleAndBody(issue domain.Issue) (ti
 		body = body + "\n\n" + string(templateContent)
 	}
 
+	body = withUserContent(body, "")
+
 	return
 }
 
-func (cpr *CreatePullRequest) hasPendingCommits(currentBranch string) (bool, error) {
-	commitsToPush, err := cpr.Git.GetCommitsToPush(currentBranch)
+func (cpr *CreatePullRequest) hasPendingCommits(ctx context.Context, currentBranch string) (bool, error) {
+	commitsToPush, err := cpr.Git.GetCommitsToPush(ctx, currentBranch)
 	if err != nil {
 		return false, err
 	}
@@ -410,37 +634,76 @@ func (cpr *CreatePullRequest) hasPendingCommits(currentBranch string) (bool, err
 	return len(commitsToPush) > 0, nil
 }
 
-func (cpr *CreatePullRequest) createNewLocalBranch(currentBranch string, baseBranch string) error {
-	// Create the new branch from the base branch
-	if err := cpr.Git.CheckoutNewBranchFromOrigin(currentBranch, baseBranch); err != nil {
-		return fmt.Errorf("could not create the local branch because %s", err)
+// checkoutBranch switches to the existing branch, carrying the uncommitted changes if it is needed
+func (cpr *CreatePullRequest) checkoutBranch(ctx context.Context, branch string) error {
+	currentBranch, err := cpr.Git.GetCurrentBranch(ctx)
+	if err == nil && currentBranch == branch {
+		return nil
 	}
 
-	return nil
+	return cpr.carry(ctx, branch, func() error {
+		return cpr.Git.CheckoutBranch(ctx, branch)
+	})
 }
 
-func (cpr *CreatePullRequest) fetchBranch(branch string) error {
-	if cpr.Cfg.FetchFromOrigin {
-		if err := cpr.Git.FetchBranchFromOrigin(branch); err != nil {
-			return ErrFetchBranch(branch, err)
+// carry runs the checkout of the branch carrying the uncommitted changes if it is enabled or confirmed
+func (cpr *CreatePullRequest) carry(ctx context.Context, branch string, checkout func() error) error {
+	carried, err := carryChanges{
+		git:                     cpr.Git,
+		userInteractionProvider: cpr.UserInteractionProvider,
+		enabled:                 cpr.Cfg.CarryChanges,
+		isInteractive:           cpr.Cfg.IsInteractive,
+	}.checkout(ctx, branch, checkout)
+	if err != nil {
+		return err
+	}
+	if carried {
+		cpr.carriedChanges = true
+		if cpr.Cfg.OutputFormat != "json" {
+			logging.PrintInfo(fmt.Sprintf("The uncommitted changes have been carried to the branch %s", logging.PaintInfo(branch)))
 		}
 	}
 
 	return nil
 }
 
-func (cpr *CreatePullRequest) createBranch(branch string, baseBranch string) (cancel bool, err error) {
-	if cpr.Git.RemoteBranchExists(branch) {
-		err = ErrRemoteBranchAlreadyExists(branch)
+// switchBack switches to the given branch when undoing the changes, taking back the carried changes
+func (cpr *CreatePullRequest) switchBack(ctx context.Context, branch string) error {
+	if !cpr.carriedChanges {
+		return cpr.Git.CheckoutBranch(ctx, branch)
+	}
+
+	_, err := carryChanges{git: cpr.Git, enabled: true}.checkout(ctx, branch, func() error {
+		return cpr.Git.CheckoutBranch(ctx, branch)
+	})
+	return err
+}
+
+// createBranch resolves the collision of the new branch and plans to create it, or to switch to it
+// if it is reused. It returns whether the branch is created.
+func (cpr *CreatePullRequest) createBranch(ctx context.Context, branch string, baseBranch string, originalBranch string) (resolvedBranch string, created bool, cancel bool, err error) {
+	collision, err := branchCollision{
+		git:                     cpr.Git,
+		userInteractionProvider: cpr.UserInteractionProvider,
+		strategy:                cpr.Cfg.OnCollision,
+		isInteractive:           cpr.Cfg.IsInteractive,
+	}.resolve(ctx, branch)
+	if err != nil {
 		return
 	}
+	resolvedBranch = collision.BranchName
 
-	if cpr.Cfg.OutputFormat != "json" {
-		fmt.Printf("\nA new pull request is going to be created from %s to %s branch\n",
-			logging.PaintInfo(branch), logging.PaintInfo(baseBranch))
+	if collision.Reuse {
+		cpr.planCheckout(ctx, originalBranch, resolvedBranch)
+		return
 	}
 
-	if cpr.Cfg.IsInteractive {
+	if cpr.Cfg.OutputFormat != "json" && !cpr.Cfg.DryRun {
+		fmt.Fprintf(logging.Output(), "\nA new pull request is going to be created from %s to %s branch\n",
+			logging.PaintInfo(resolvedBranch), logging.PaintInfo(baseBranch))
+	}
+
+	if cpr.Cfg.IsInteractive && !cpr.Cfg.DryRun {
 		var confirmed bool
 		confirmed, err = cpr.UserInteractionProvider.AskUserForConfirmation("Do you want to continue?", true)
 		if err != nil {
@@ -452,17 +715,46 @@ func (cpr *CreatePullRequest) createBranch(branch string, baseBranch string) (ca
 		}
 	}
 
-	if err = cpr.createNewLocalBranch(branch, baseBranch); err != nil {
+	created = true
+	cpr.Plan.Add(PlanStep{Action: PlanActionCreateBranch, Branch: resolvedBranch, Base: baseBranch, CarryChanges: cpr.Cfg.CarryChanges}, func() error {
+		if err := cpr.carry(ctx, resolvedBranch, func() error {
+			return cpr.Git.CheckoutNewBranchFromOrigin(ctx, resolvedBranch, baseBranch)
+		}); err != nil {
+			return fmt.Errorf("could not create the local branch because %w", err)
+		}
+		cpr.recordCheckout(ctx, originalBranch, resolvedBranch, true)
+		return nil
+	})
+
+	return
+}
+
+// recordCheckout records the switch from the original branch to the branch, which was created if
+// created is set, so they can be undone
+func (cpr *CreatePullRequest) recordCheckout(ctx context.Context, originalBranch string, branch string, created bool) {
+	if created {
+		cpr.changes.record(fmt.Sprintf("switch back to the branch %s and delete the branch %s", originalBranch, branch), func(ctx context.Context) error {
+			if err := cpr.switchBack(ctx, originalBranch); err != nil {
+				return err
+			}
+			return cpr.Git.DeleteBranch(ctx, branch, true)
+		})
 		return
 	}
 
-	return
+	if branch != originalBranch {
+		cpr.changes.record(fmt.Sprintf("switch back to the branch %s", originalBranch), func(ctx context.Context) error {
+			return cpr.switchBack(ctx, originalBranch)
+		})
+	}
 }
 
-func (cpr *CreatePullRequest) createPullRequestFromIssue(issue domain.Issue, baseBranch string, headBranch string) (prURL string, err error) {
-	title, body, err := cpr.getPullRequestTitleAndBody(issue)
+// planCreatePullRequest plans the creation of the pull request from the issue. Its URL is set in the
+// result when it is created.
+func (cpr *CreatePullRequest) planCreatePullRequest(ctx context.Context, result *CreatePullRequestResult, issue domain.Issue, baseBranch string, headBranch string) error {
+	title, body, err := cpr.getPullRequestTitleAndBody(ctx, issue)
 	if err != nil {
-		return "", err
+		return err
 	}
 
 	labels := []string{}
@@ -472,10 +764,86 @@ func (cpr *CreatePullRequest) createPullRequestFromIssue(issue domain.Issue, bas
 	}
 	labels = append(labels, cpr.Cfg.ExtraLabels...)
 
-	prURL, err = cpr.PullRequestProvider.CreatePullRequest(title, body, baseBranch, headBranch, cpr.Cfg.DraftPR, labels, cpr.Cfg.Reviewers, cpr.Cfg.Assignees)
+	step := PlanStep{
+		Action:    PlanActionCreatePullRequest,
+		Branch:    headBranch,
+		Base:      baseBranch,
+		Title:     title,
+		Body:      body,
+		Draft:     cpr.Cfg.DraftPR,
+		Labels:    labels,
+		Reviewers: cpr.Cfg.Reviewers,
+		Assignees: cpr.Cfg.Assignees,
+	}
+	result.Labels = labels
+	result.Reviewers = orEmpty(cpr.Cfg.Reviewers)
+	result.Assignees = orEmpty(cpr.Cfg.Assignees)
+	cpr.Plan.Add(step, func() error {
+		prURL, err := cpr.PullRequestProvider.CreatePullRequest(ctx, title, body, baseBranch, headBranch, cpr.Cfg.DraftPR, labels, cpr.Cfg.Reviewers, cpr.Cfg.Assignees)
+		var metadataErr *domain.PullRequestMetadataError
+		if errors.As(err, &metadataErr) {
+			prURL, err = metadataErr.URL, nil
+			result.Warnings = append(result.Warnings, metadataErr.Error())
+			if cpr.Cfg.OutputFormat != "json" {
+				logging.PrintWarn(metadataErr.Error())
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("could not create the pull request because %w", err)
+		}
+		// Deleting the pushed branch would close the pull request, so the changes are kept from now on
+		cpr.changes.forget()
+		result.PRURL = prURL
+		result.PRNumber = pullRequestNumber(prURL)
+		return nil
+	})
+
+	return nil
+}
+
+// planUpdatePullRequest plans to refresh the title, body and type label of the open pull request of
+// the branch. Its labels are set in the result when it is updated.
+func (cpr *CreatePullRequest) planUpdatePullRequest(ctx context.Context, result *CreatePullRequestResult, pr domain.PullRequest, issue domain.Issue, branch string) error {
+	title, body, err := cpr.getPullRequestTitleAndBody(ctx, issue)
+	if err != nil {
+		return err
+	}
+
+	refresh := RefreshPullRequest{
+		Cfg: RefreshPullRequestConfiguration{
+			TypeLabels:    cpr.Cfg.TypeLabels,
+			IsInteractive: cpr.Cfg.IsInteractive,
+			OutputFormat:  cpr.Cfg.OutputFormat,
+		},
+		UserInteractionProvider: cpr.UserInteractionProvider,
+		PullRequestProvider:     cpr.PullRequestProvider,
+	}
+
+	step := PlanStep{Action: PlanActionUpdatePullRequest, Branch: branch, URL: pr.Url, Title: title, Body: body}
+	result.Labels = labelNames(pr.Labels)
+	result.Reviewers = []string{}
+	result.Assignees = []string{}
+	cpr.Plan.Add(step, func() error {
+		refreshed, err := refresh.apply(ctx, pr, branch, issue, title, body)
+		if err != nil {
+			return err
+		}
+		result.Labels = slices.DeleteFunc(append(result.Labels, refreshed.AddedLabels...), func(label string) bool {
+			return slices.Contains(refreshed.RemovedLabels, label)
+		})
+		return nil
+	})
+
+	return nil
+}
+
+// printJSONResult prints the result of the use case as JSON
+func printJSONResult(result any) error {
+	jsonBytes, err := json.Marshal(result)
 	if err != nil {
-		return "", fmt.Errorf("could not create the pull request because %s", err)
+		return fmt.Errorf("failed to serialize result: %w", err)
 	}
+	fmt.Println(string(jsonBytes))
 
-	return prURL, nil
+	return nil
 }
@@ -1,9 +1,12 @@
 package use_cases_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/InditexTech/gh-sherpa/internal/config"
@@ -83,7 +86,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 	s.Run("should error if could not get git repository", func() {
 		s.repositoryProvider.Repository = nil
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorIs(err, domainFakes.ErrRepositoryNotFound)
 	})
@@ -91,7 +94,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 	s.Run("should error if could not get current branch", func() {
 		s.gitProvider.CurrentBranch = ""
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, "could not get the current branch name")
 		s.False(s.pullRequestProvider.HasPullRequestForBranch(s.gitProvider.CurrentBranch))
@@ -104,7 +107,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 		s.gitProvider.AddLocalBranches(branchName)
 		s.branchProvider.SetBranchName(branchName)
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		expectedError := fmt.Sprintf("could not find an issue identifier in the current branch named %s", branchName)
 		s.EqualError(err, expectedError)
@@ -117,7 +120,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 		mocks.UnsetExpectedCall(&s.userInteractionProvider.Mock, s.userInteractionProvider.AskUserForConfirmation)
 		s.userInteractionProvider.EXPECT().AskUserForConfirmation(mock.Anything, mock.Anything).Return(false, nil).Once()
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.False(s.pullRequestProvider.HasPullRequestForBranch(s.defaultBranchName))
@@ -130,10 +133,86 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 		s.pullRequestProvider.AddPullRequest(branchName, domain.PullRequest{})
 		s.branchProvider.SetBranchName(branchName)
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, "pull request")
 		s.ErrorContains(err, "already exists")
+		s.ErrorIs(err, domain.ErrPullRequestExists)
+	})
+
+	s.Run("should update the existing pull request with the update existing flag", func() {
+		branchName := "feature/GH-3-pull-request-sample"
+		s.gitProvider.CurrentBranch = branchName
+		s.gitProvider.AddLocalBranches(branchName)
+		s.pullRequestProvider.AddPullRequest(branchName, domain.PullRequest{
+			Number: 3,
+			Title:  "old title",
+			Url:    "https://github.com/inditextech/gh-sherpa-test-repo/pulls/3",
+			Body:   "Closes #3\n\n" + use_cases.PullRequestBodyMarker + "\nnotes",
+			Labels: []domain.Label{{Name: "kind/feature"}},
+		})
+		s.branchProvider.SetBranchName(branchName)
+		s.uc.Cfg.UpdateExisting = true
+		s.uc.Cfg.TypeLabels = []string{"kind/feature", "kind/documentation"}
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(result.Updated)
+		s.True(result.Reused)
+		s.Equal(int64(3), result.PRNumber)
+		s.Equal([]string{"kind/documentation"}, result.Labels)
+		pr := s.pullRequestProvider.PullRequests[branchName]
+		s.Equal("fake title", pr.Title)
+		s.Equal([]domain.Label{{Id: "kind/documentation", Name: "kind/documentation"}}, pr.Labels)
+		s.True(strings.HasSuffix(pr.Body, use_cases.PullRequestBodyMarker+"\nnotes"))
+	})
+
+	s.Run("should write the body of the pr-body flag below the marker", func() {
+		branchName := "feature/GH-3-local-branch"
+		s.gitProvider.CurrentBranch = branchName
+		s.gitProvider.AddLocalBranches(branchName)
+		s.branchProvider.SetBranchName(branchName)
+		s.uc.Cfg.PRBody = "My description"
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal(use_cases.PullRequestBodyMarker+"\nMy description", s.pullRequestProvider.PullRequests[branchName].Body)
+	})
+
+	s.Run("should replace the content below the marker with the pr-body flag when updating the pull request", func() {
+		branchName := "feature/GH-3-pull-request-sample"
+		s.gitProvider.CurrentBranch = branchName
+		s.gitProvider.AddLocalBranches(branchName)
+		s.pullRequestProvider.AddPullRequest(branchName, domain.PullRequest{
+			Number: 3,
+			Url:    "https://github.com/inditextech/gh-sherpa-test-repo/pulls/3",
+			Body:   use_cases.PullRequestBodyMarker + "\nold description",
+		})
+		s.branchProvider.SetBranchName(branchName)
+		s.uc.Cfg.UpdateExisting = true
+		s.uc.Cfg.PRBody = "new description"
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal(use_cases.PullRequestBodyMarker+"\nnew description", s.pullRequestProvider.PullRequests[branchName].Body)
+	})
+
+	s.Run("should write the body of the pr-body-file flag below the marker", func() {
+		branchName := "feature/GH-3-local-branch"
+		s.gitProvider.CurrentBranch = branchName
+		s.gitProvider.AddLocalBranches(branchName)
+		s.branchProvider.SetBranchName(branchName)
+		bodyFile := filepath.Join(s.T().TempDir(), "body.md")
+		s.Require().NoError(os.WriteFile(bodyFile, []byte("My description from a file"), 0o600))
+		s.uc.Cfg.PRBodyFile = bodyFile
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal(use_cases.PullRequestBodyMarker+"\nMy description from a file", s.pullRequestProvider.PullRequests[branchName].Body)
 	})
 
 	s.Run("should not ask the user for branch confirmation if default flag is used", func() {
@@ -143,7 +222,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 		s.branchProvider.SetBranchName(branchName)
 		s.uc.Cfg.IsInteractive = false
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.userInteractionProvider.AssertNotCalled(s.T(), "AskUserForConfirmation")
@@ -155,9 +234,66 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 		s.gitProvider.AddLocalBranches(branchName)
 		s.branchProvider.SetBranchName(branchName)
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+	})
+
+	s.Run("should create the empty commit with the configured message", func() {
+		branchName := "feature/GH-3-local-branch"
+		s.gitProvider.CurrentBranch = branchName
+		s.gitProvider.AddLocalBranches(branchName)
+		s.branchProvider.SetBranchName(branchName)
+		s.uc.Cfg.InitialCommit.Message = "chore({{issue}}): start {{title}} on {{branch}}"
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal([]string{"chore(GH-3): start fake title on feature/GH-3-local-branch"}, s.gitProvider.CommitsToPush[branchName])
+	})
+
+	s.Run("should create the empty commit with the Signed-off-by trailer if signoff is enabled", func() {
+		branchName := "feature/GH-3-local-branch"
+		s.gitProvider.CurrentBranch = branchName
+		s.gitProvider.AddLocalBranches(branchName)
+		s.branchProvider.SetBranchName(branchName)
+		s.uc.Cfg.InitialCommit.Signoff = true
+
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
+		s.Equal([]string{use_cases.DefaultInitialCommitMessage}, s.gitProvider.SignedOffCommits)
+	})
+
+	s.Run("should fail before pushing if the empty commit is skipped and the branch has no commits", func() {
+		branchName := "feature/GH-3-local-branch"
+		s.gitProvider.CurrentBranch = branchName
+		s.gitProvider.AddLocalBranches(branchName)
+		s.branchProvider.SetBranchName(branchName)
+		s.uc.Cfg.InitialCommit.Skip = true
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, "has no commits and the initial empty commit is skipped")
+		s.Empty(s.gitProvider.CommitsToPush[branchName])
+		s.False(s.gitProvider.RemoteBranchExists(context.Background(), branchName))
+		s.False(s.pullRequestProvider.HasPullRequestForBranch(branchName))
+	})
+
+	s.Run("should not create the empty commit if it is skipped and the branch has commits", func() {
+		branchName := "feature/GH-3-local-branch"
+		s.gitProvider.CurrentBranch = branchName
+		s.gitProvider.AddLocalBranches(branchName)
+		s.branchProvider.SetBranchName(branchName)
+		s.gitProvider.CommitsToPush[branchName] = []string{"first change"}
+		s.uc.Cfg.InitialCommit.Skip = true
+		s.uc.Cfg.IsInteractive = false
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal([]string{"first change"}, s.gitProvider.CommitsToPush[branchName])
+		s.True(s.pullRequestProvider.HasPullRequestForBranch(branchName))
 	})
 
 	s.Run("should return error if remote branch already exists", func() {
@@ -166,7 +302,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 		s.gitProvider.AddLocalBranches(branchName)
 		s.branchProvider.SetBranchName(branchName)
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, "could not push to remote branch "+branchName)
 		s.False(s.pullRequestProvider.HasPullRequestForBranch(branchName))
@@ -181,7 +317,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 
 		s.userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to continue pushing all pending commits in this branch and create the pull request", true).Return(false, nil).Once()
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.userInteractionProvider.AssertExpectations(s.T())
@@ -196,7 +332,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 		s.gitProvider.CommitsToPush[branchName] = []string{"commit 1", "commit 2"}
 		s.uc.Cfg.IsInteractive = false
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.userInteractionProvider.AssertNotCalled(s.T(), "AskUserForConfirmation")
@@ -209,7 +345,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 		s.gitProvider.BranchWithCommitError = []string{s.defaultBranchName}
 		s.branchProvider.SetBranchName(s.defaultBranchName)
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorIs(err, domainFakes.ErrGetCommitsToPush)
 	})
@@ -220,7 +356,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 		s.branchProvider.SetBranchName(s.defaultBranchName)
 		s.gitProvider.BranchWithPushError = []string{s.defaultBranchName}
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, "could not push to remote branch "+s.defaultBranchName)
 	})
@@ -232,11 +368,113 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 		s.pullRequestProvider.PullRequestsWithErrors = []string{branchName}
 		s.branchProvider.SetBranchName(branchName)
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, "could not create the pull request because")
 	})
 
+	s.Run("should undo the changes if the pull request cannot be created with the rollback on failure flag", func() {
+		branchName := "feature/GH-6-refactoring-issue"
+		s.uc.Cfg.IsInteractive = false
+		s.uc.Cfg.IssueID = "6"
+		s.uc.Cfg.RollbackOnFailure = true
+		s.pullRequestProvider.PullRequestsWithErrors = []string{branchName}
+		s.branchProvider.SetBranchName(branchName)
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, "could not create the pull request because")
+		s.Equal("main", s.gitProvider.CurrentBranch)
+		s.NotContains(s.gitProvider.LocalBranches, branchName)
+		s.NotContains(s.gitProvider.RemoteBranches, branchName)
+		s.Empty(s.gitProvider.CommitsToPush[branchName])
+	})
+
+	s.Run("should keep the pull request and its branch if its reviewers cannot be requested", func() {
+		branchName := "feature/GH-6-refactoring-issue"
+		s.uc.Cfg.IsInteractive = false
+		s.uc.Cfg.IssueID = "6"
+		s.uc.Cfg.RollbackOnFailure = true
+		s.pullRequestProvider.MetadataErrors = map[string]error{branchName: errors.New("could not request the reviewers of the pull request")}
+		s.branchProvider.SetBranchName(branchName)
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal("https://github.com/inditextech/gh-sherpa-test-repo/pulls/5", result.PRURL)
+		s.Len(result.Warnings, 1)
+		s.Contains(result.Warnings[0], "could not request the reviewers of the pull request")
+		s.Equal(branchName, s.gitProvider.CurrentBranch)
+		s.Contains(s.gitProvider.RemoteBranches, branchName)
+	})
+
+	s.Run("should carry the uncommitted changes to the branch of the pull request", func() {
+		branchName := "feature/GH-6-refactoring-issue"
+		s.uc.Cfg.IsInteractive = false
+		s.uc.Cfg.IssueID = "6"
+		s.uc.Cfg.CarryChanges = true
+		s.gitProvider.UncommittedChanges = true
+		s.branchProvider.SetBranchName(branchName)
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(result.CarriedChanges)
+		s.Equal(branchName, s.gitProvider.CurrentBranch)
+		s.True(s.gitProvider.UncommittedChanges)
+		s.Empty(s.gitProvider.Stashes)
+	})
+
+	s.Run("should take back the carried changes when undoing them", func() {
+		branchName := "feature/GH-6-refactoring-issue"
+		s.uc.Cfg.IsInteractive = false
+		s.uc.Cfg.IssueID = "6"
+		s.uc.Cfg.CarryChanges = true
+		s.uc.Cfg.RollbackOnFailure = true
+		s.gitProvider.UncommittedChanges = true
+		s.pullRequestProvider.PullRequestsWithErrors = []string{branchName}
+		s.branchProvider.SetBranchName(branchName)
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, "could not create the pull request because")
+		s.Equal("main", s.gitProvider.CurrentBranch)
+		s.True(s.gitProvider.UncommittedChanges)
+		s.Empty(s.gitProvider.Stashes)
+	})
+
+	s.Run("should keep the changes if the pull request cannot be created in non-interactive mode", func() {
+		branchName := "feature/GH-6-refactoring-issue"
+		s.uc.Cfg.IsInteractive = false
+		s.uc.Cfg.IssueID = "6"
+		s.pullRequestProvider.PullRequestsWithErrors = []string{branchName}
+		s.branchProvider.SetBranchName(branchName)
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, "could not create the pull request because")
+		s.Equal(branchName, s.gitProvider.CurrentBranch)
+		s.Contains(s.gitProvider.RemoteBranches, branchName)
+	})
+
+	s.Run("should undo the changes if the user confirms it after the pull request cannot be created", func() {
+		branchName := "feature/GH-6-with-no-remote-branch"
+		s.gitProvider.CurrentBranch = branchName
+		s.gitProvider.AddLocalBranches(branchName)
+		s.pullRequestProvider.PullRequestsWithErrors = []string{branchName}
+		s.branchProvider.SetBranchName(branchName)
+		mocks.UnsetExpectedCall(&s.userInteractionProvider.Mock, s.userInteractionProvider.AskUserForConfirmation)
+		s.userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to use this branch to create the pull request", true).Return(true, nil).Once()
+		s.userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to undo them", true).Return(true, nil).Once()
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, "could not create the pull request because")
+		s.Contains(s.gitProvider.LocalBranches, branchName)
+		s.NotContains(s.gitProvider.RemoteBranches, branchName)
+		s.Empty(s.gitProvider.CommitsToPush[branchName])
+	})
+
 	s.Run("should checkout local branch if branch exists and user confirms branch usage without default flag and issue flag", func() {
 		s.gitProvider.ResetRemoteBranches()
 		mocks.UnsetExpectedCall(&s.userInteractionProvider.Mock, s.userInteractionProvider.AskUserForConfirmation)
@@ -244,7 +482,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 
 		s.uc.Cfg.IssueID = "1"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.userInteractionProvider.AssertExpectations(s.T())
@@ -255,7 +493,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 		s.uc.Cfg.IsInteractive = false
 		s.uc.Cfg.IssueID = "1"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 	})
@@ -265,7 +503,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 		s.uc.Cfg.IssueID = "1"
 		s.uc.Cfg.NoUseExistingBranch = true
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, "the branch feature/GH-1-sample-issue already exists")
 		s.uc.Cfg.NoUseExistingBranch = false
@@ -277,13 +515,28 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 
 		s.uc.Cfg.IssueID = "1"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, use_cases.ErrRemoteBranchAlreadyExists("feature/GH-1-sample-issue").Error())
 		s.userInteractionProvider.AssertExpectations(s.T())
 		s.False(s.pullRequestProvider.HasPullRequestForBranch(s.gitProvider.CurrentBranch))
 	})
 
+	s.Run("should create a suffixed branch if remote branch already exists with suffix strategy", func() {
+		mocks.UnsetExpectedCall(&s.userInteractionProvider.Mock, s.userInteractionProvider.AskUserForConfirmation)
+		s.userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to use this branch to create the pull request", true).Return(false, nil).Once()
+		s.userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to continue?", true).Return(true, nil).Once()
+
+		s.uc.Cfg.IssueID = "1"
+		s.uc.Cfg.OnCollision = use_cases.BranchCollisionSuffix
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal("feature/GH-1-sample-issue-2", result.BranchName)
+		s.True(s.pullRequestProvider.HasPullRequestForBranch("feature/GH-1-sample-issue-2"))
+	})
+
 	s.Run("should create new branch name if user doesn't confirm default branch name when using issue flags", func() {
 		s.gitProvider.LocalBranches = []string{"main", "develop"}
 		s.gitProvider.RemoteBranches = []string{"main", "develop"}
@@ -293,7 +546,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 
 		s.uc.Cfg.IssueID = "1"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.userInteractionProvider.AssertExpectations(s.T())
@@ -308,7 +561,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 
 		s.uc.Cfg.IssueID = "1"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.userInteractionProvider.AssertExpectations(s.T())
@@ -323,7 +576,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 
 		s.uc.Cfg.IssueID = "1"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.userInteractionProvider.AssertExpectations(s.T())
@@ -336,7 +589,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 
 		s.branchProvider.SetBranchName(branchName)
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.True(s.pullRequestProvider.HasPullRequestForBranch(branchName))
@@ -355,12 +608,42 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 
 		s.uc.Cfg.IssueID = "1"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.True(s.pullRequestProvider.HasPullRequestForBranch(s.gitProvider.CurrentBranch))
 	})
 
+	s.Run("should stack the pull request on another branch", func() {
+		parentBranch := "feature/GH-3-parent-branch"
+		s.gitProvider.AddLocalBranches(parentBranch)
+		s.gitProvider.ResetRemoteBranches()
+		s.gitProvider.AddRemoteBranches(parentBranch)
+		s.gitProvider.CurrentBranch = s.defaultBranchName
+
+		s.uc.Cfg.StackOn = parentBranch
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal(parentBranch, result.StackedOn)
+		s.Equal(parentBranch, s.pullRequestProvider.PullRequests[s.defaultBranchName].BaseRefName)
+		s.Equal(parentBranch, s.gitProvider.BranchConfig[s.defaultBranchName+".sherpa-parent"])
+	})
+
+	s.Run("should error if the branch to stack on is not pushed", func() {
+		parentBranch := "feature/GH-3-parent-branch"
+		s.gitProvider.AddLocalBranches(parentBranch)
+		s.gitProvider.CurrentBranch = s.defaultBranchName
+		s.uc.Cfg.StackOn = parentBranch
+		s.uc.Cfg.FetchFromOrigin = false
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, "the branch feature/GH-3-parent-branch must be pushed before stacking a pull request on it")
+		s.False(s.pullRequestProvider.HasPullRequestForBranch(s.defaultBranchName))
+	})
+
 	s.Run("should create pull request with no close issue flag", func() {
 		branchName := "feature/GH-3-local-branch"
 		s.gitProvider.CurrentBranch = branchName
@@ -369,7 +652,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 
 		s.uc.Cfg.CloseIssue = false
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.True(s.pullRequestProvider.HasPullRequestForBranch(branchName))
@@ -385,7 +668,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 		// Set invalid template path
 		s.uc.Cfg.TemplatePath = "non_existent_template.md"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		// Should error early with template not found error
 		s.Error(err)
@@ -403,7 +686,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 		// Usar una ruta relativa
 		s.uc.Cfg.TemplatePath = "testdata/test_template.md"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.True(s.pullRequestProvider.HasPullRequestForBranch(branchName))
@@ -425,7 +708,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 		dir, _ := os.Getwd()
 		s.uc.Cfg.TemplatePath = filepath.Join(dir, "testdata", "test_template.md")
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.True(s.pullRequestProvider.HasPullRequestForBranch(branchName))
@@ -449,7 +732,7 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 		s.uc.Cfg.TemplatePath = templatePath
 		s.uc.Cfg.CloseIssue = false
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.True(s.pullRequestProvider.HasPullRequestForBranch(branchName))
@@ -467,17 +750,107 @@ func (s *CreateGithubPullRequestExecutionTestSuite) TestCreatePullRequestExecuti
 		s.gitProvider.CurrentBranch = branchName
 		s.branchProvider.SetBranchName(branchName)
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.Error(err)
 		s.False(s.pullRequestProvider.HasPullRequestForBranch(branchName))
 	})
+
+	s.Run("should return the plan without doing anything in dry-run mode", func() {
+		branchName := "feature/GH-3-new-branch"
+		s.branchProvider.SetBranchName(branchName)
+		s.uc.Cfg.IssueID = "3"
+		s.uc.Cfg.DryRun = true
+		s.uc.Cfg.Reviewers = []string{"alice"}
+		s.uc.Cfg.InitialCommit.Signoff = true
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(result.DryRun)
+		s.Equal(branchName, result.BranchName)
+		actions := []use_cases.PlanAction{}
+		for _, step := range result.Plan {
+			actions = append(actions, step.Action)
+		}
+		s.Equal([]use_cases.PlanAction{
+			use_cases.PlanActionFetch,
+			use_cases.PlanActionFetch,
+			use_cases.PlanActionCreateBranch,
+			use_cases.PlanActionEmptyCommit,
+			use_cases.PlanActionPush,
+			use_cases.PlanActionCreatePullRequest,
+		}, actions)
+		s.True(result.Plan[1].Optional)
+		s.Equal(use_cases.PlanStep{
+			Action: use_cases.PlanActionEmptyCommit, Description: `Create the empty commit "chore: initial commit" signed off`,
+			Branch: branchName, Message: use_cases.DefaultInitialCommitMessage, Signoff: true,
+		}, result.Plan[3])
+		pullRequest := result.Plan[5]
+		s.Equal("fake title", pullRequest.Title)
+		s.Equal("main", pullRequest.Base)
+		s.True(pullRequest.Draft)
+		s.Equal([]string{"alice"}, pullRequest.Reviewers)
+		s.False(s.gitProvider.BranchExists(context.Background(), branchName))
+		s.False(s.pullRequestProvider.HasPullRequestForBranch(branchName))
+	})
+
+	s.Run("should plan to update the existing pull request in dry-run mode", func() {
+		branchName := "feature/GH-3-pull-request-sample"
+		s.gitProvider.CurrentBranch = branchName
+		s.gitProvider.AddLocalBranches(branchName)
+		s.gitProvider.AddRemoteBranches(branchName)
+		s.pullRequestProvider.AddPullRequest(branchName, domain.PullRequest{
+			Title: "old title",
+			Url:   "https://github.com/inditextech/gh-sherpa-test-repo/pulls/3",
+		})
+		s.branchProvider.SetBranchName(branchName)
+		s.uc.Cfg.UpdateExisting = true
+		s.uc.Cfg.DryRun = true
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(result.Updated)
+		last := result.Plan[len(result.Plan)-1]
+		s.Equal(use_cases.PlanActionUpdatePullRequest, last.Action)
+		s.Equal("https://github.com/inditextech/gh-sherpa-test-repo/pulls/3", last.URL)
+		s.Equal("old title", s.pullRequestProvider.PullRequests[branchName].Title)
+	})
+
+	s.Run("should return the issue, branches and pull request details in the result", func() {
+		branchName := "feature/GH-3-new-branch"
+		s.branchProvider.SetBranchName(branchName)
+		s.uc.Cfg.IssueID = "3"
+		s.uc.Cfg.IsInteractive = false
+		s.uc.Cfg.ExtraLabels = []string{"team/sherpa"}
+		s.uc.Cfg.Reviewers = []string{"alice"}
+		s.uc.Fork = &use_cases.ForkResult{Name: "octocat/gh-sherpa-test-repo", Upstream: "inditextech/gh-sherpa-test-repo"}
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal(&use_cases.StatusIssue{
+			ID: "GH-3", Title: "fake title", Type: "documentation", Tracker: "github", State: "open", URL: "fake url",
+		}, result.Issue)
+		s.Equal(branchName, result.BranchName)
+		s.Equal("main", result.BaseBranch)
+		s.True(result.Created)
+		s.False(result.Reused)
+		s.Equal("https://github.com/inditextech/gh-sherpa-test-repo/pulls/5", result.PRURL)
+		s.Equal(int64(5), result.PRNumber)
+		s.Equal([]string{"kind/documentation", "team/sherpa"}, result.Labels)
+		s.Equal([]string{"alice"}, result.Reviewers)
+		s.Equal([]string{}, result.Assignees)
+		s.Equal(s.uc.Fork, result.Fork)
+	})
 }
 
 func (s *CreateGithubPullRequestExecutionTestSuite) initializeUserInteractionProvider() *domainMocks.MockUserInteractionProvider {
 	userInteractionProvider := &domainMocks.MockUserInteractionProvider{}
 
 	userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to use this branch to create the pull request", true).Return(true, nil).Maybe()
+	userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to undo them", true).Return(false, nil).Maybe()
 	userInteractionProvider.EXPECT().SelectOrInputPrompt("Label 'kind/feature' found. What type of branch name do you want to create?", []string{"feature", "other"}, mock.Anything, true).Return(nil).Maybe()
 	userInteractionProvider.EXPECT().SelectOrInput(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
@@ -556,7 +929,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 	s.Run("should error if could not get git repository", func() {
 		s.repositoryProvider.Repository = nil
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorIs(err, domainFakes.ErrRepositoryNotFound)
 	})
@@ -564,7 +937,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 	s.Run("should error if could not get current branch", func() {
 		s.gitProvider.CurrentBranch = ""
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, "could not get the current branch name")
 		s.False(s.pullRequestProvider.HasPullRequestForBranch(s.gitProvider.CurrentBranch))
@@ -575,7 +948,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 
 		s.gitProvider.CurrentBranch = branchName
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		expectedError := fmt.Sprintf("could not find an issue identifier in the current branch named %s", branchName)
 		s.EqualError(err, expectedError)
@@ -588,7 +961,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 		mocks.UnsetExpectedCall(&s.userInteractionProvider.Mock, s.userInteractionProvider.AskUserForConfirmation)
 		s.userInteractionProvider.EXPECT().AskUserForConfirmation(mock.Anything, mock.Anything).Return(false, nil).Once()
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.False(s.pullRequestProvider.HasPullRequestForBranch(s.defaultBranchName))
@@ -601,7 +974,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 		s.pullRequestProvider.AddPullRequest(branchName, domain.PullRequest{})
 		s.branchProvider.SetBranchName(branchName)
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, "pull request")
 		s.ErrorContains(err, "already exists")
@@ -614,7 +987,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 		s.branchProvider.SetBranchName(branchName)
 		s.uc.Cfg.IsInteractive = false
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.userInteractionProvider.AssertNotCalled(s.T(), "AskUserForConfirmation")
@@ -626,7 +999,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 		s.gitProvider.AddLocalBranches(branchName)
 		s.branchProvider.SetBranchName(branchName)
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 	})
@@ -636,7 +1009,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 		s.gitProvider.CurrentBranch = branchName
 		s.branchProvider.SetBranchName(branchName)
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, "could not push to remote branch "+branchName)
 		s.False(s.pullRequestProvider.HasPullRequestForBranch(branchName))
@@ -651,7 +1024,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 
 		s.userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to continue pushing all pending commits in this branch and create the pull request", true).Return(false, nil).Once()
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.userInteractionProvider.AssertExpectations(s.T())
@@ -666,7 +1039,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 		s.gitProvider.CommitsToPush[branchName] = []string{"commit 1", "commit 2"}
 		s.uc.Cfg.IsInteractive = false
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.userInteractionProvider.AssertNotCalled(s.T(), "AskUserForConfirmation")
@@ -679,7 +1052,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 		s.gitProvider.BranchWithCommitError = []string{s.defaultBranchName}
 		s.branchProvider.SetBranchName(s.defaultBranchName)
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorIs(err, domainFakes.ErrGetCommitsToPush)
 	})
@@ -690,7 +1063,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 		s.gitProvider.CurrentBranch = s.defaultBranchName
 		s.branchProvider.SetBranchName(s.defaultBranchName)
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, "could not push to remote branch "+s.defaultBranchName)
 	})
@@ -702,7 +1075,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 		s.pullRequestProvider.PullRequestsWithErrors = []string{branchName}
 		s.branchProvider.SetBranchName(branchName)
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, "could not create the pull request because")
 	})
@@ -715,7 +1088,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 
 		s.uc.Cfg.IssueID = "PROJECTKEY-1"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.userInteractionProvider.AssertExpectations(s.T())
@@ -726,7 +1099,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 		s.uc.Cfg.IssueID = "PROJECTKEY-1"
 		s.uc.Cfg.IsInteractive = false
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 	})
@@ -736,7 +1109,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 		s.uc.Cfg.IsInteractive = false
 		s.uc.Cfg.NoUseExistingBranch = true
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, "the branch feature/PROJECTKEY-1-sample-issue already exists")
 		s.uc.Cfg.NoUseExistingBranch = false
@@ -748,7 +1121,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 
 		s.uc.Cfg.IssueID = "PROJECTKEY-1"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.ErrorContains(err, use_cases.ErrRemoteBranchAlreadyExists("feature/PROJECTKEY-1-sample-issue").Error())
 		s.userInteractionProvider.AssertExpectations(s.T())
@@ -764,7 +1137,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 
 		s.uc.Cfg.IssueID = "PROJECTKEY-1"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.userInteractionProvider.AssertExpectations(s.T())
@@ -780,7 +1153,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 
 		s.uc.Cfg.IssueID = "PROJECTKEY-1"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.userInteractionProvider.AssertExpectations(s.T())
@@ -795,7 +1168,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 
 		s.uc.Cfg.IssueID = "PROJECTKEY-1"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.userInteractionProvider.AssertExpectations(s.T())
@@ -805,7 +1178,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 		s.gitProvider.RemoteBranches = []string{"main", "develop"}
 		s.gitProvider.CurrentBranch = "feature/PROJECTKEY-1-sample-issue"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.True(s.pullRequestProvider.HasPullRequestForBranch(s.gitProvider.CurrentBranch))
@@ -824,7 +1197,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 
 		s.uc.Cfg.IssueID = "PROJECTKEY-1"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.True(s.pullRequestProvider.HasPullRequestForBranch(s.gitProvider.CurrentBranch))
@@ -840,7 +1213,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 
 		s.uc.Cfg.CloseIssue = false
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 	})
@@ -855,7 +1228,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 		// Set invalid template path
 		s.uc.Cfg.TemplatePath = "non_existent_template.md"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		// Should error early with template not found error
 		s.Error(err)
@@ -872,7 +1245,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 
 		s.uc.Cfg.TemplatePath = "testdata/test_template.md"
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.True(s.pullRequestProvider.HasPullRequestForBranch(branchName))
@@ -892,7 +1265,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 		dir, _ := os.Getwd()
 		s.uc.Cfg.TemplatePath = filepath.Join(dir, "testdata", "test_template.md")
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.True(s.pullRequestProvider.HasPullRequestForBranch(branchName))
@@ -914,7 +1287,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 		templatePath := filepath.Join(dir, "testdata", "test_template.md")
 		s.uc.Cfg.TemplatePath = templatePath
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.NoError(err)
 		s.True(s.pullRequestProvider.HasPullRequestForBranch(branchName))
@@ -932,7 +1305,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) TestCreatePullRequestExecution
 		s.gitProvider.CurrentBranch = branchName
 		s.branchProvider.SetBranchName(branchName)
 
-		_, err := s.uc.Execute()
+		_, err := s.uc.Execute(context.Background())
 
 		s.Error(err)
 		s.False(s.pullRequestProvider.HasPullRequestForBranch(branchName))
@@ -950,6 +1323,7 @@ func (s *CreateJiraPullRequestExecutionTestSuite) initializeUserInteractionProvi
 	userInteractionProvider := &domainMocks.MockUserInteractionProvider{}
 
 	userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to use this branch to create the pull request", true).Return(true, nil).Maybe()
+	userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to undo them", true).Return(false, nil).Maybe()
 	userInteractionProvider.EXPECT().SelectOrInputPrompt("Issue type 'feature' found. What type of branch name do you want to create?", []string{"feature", "other"}, mock.Anything, true).Return(nil).Maybe()
 	userInteractionProvider.EXPECT().SelectOrInput(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
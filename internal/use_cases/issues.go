@@ -0,0 +1,128 @@
+package use_cases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+)
+
+// ErrNoAssignedIssues is returned when an issue has to be picked but there are no open issues assigned to the user
+var ErrNoAssignedIssues = errors.New("there are no open issues assigned to you")
+
+// ErrNoQueryIssues is returned when an issue has to be picked but the saved query has no results
+func ErrNoQueryIssues(queryName string) error {
+	return fmt.Errorf("there are no issues matching the query %s", queryName)
+}
+
+// IssuesResult holds the outcome of a successful Issues execution.
+type IssuesResult struct {
+	Issues []StatusIssue `json:"issues"`
+}
+
+// IssuesConfiguration contains the arguments for the Issues use case
+type IssuesConfiguration struct {
+	Query        string // --query: name of the saved query to run instead of listing the assigned issues
+	OutputFormat string // --output: "" (default) or "json"
+}
+
+// Issues lists the open issues assigned to the current user or the ones matching a saved query
+type Issues struct {
+	Cfg                  IssuesConfiguration
+	IssueTrackerProvider domain.IssueTrackerProvider
+}
+
+// Execute executes the issues use case
+func (i Issues) Execute(ctx context.Context) (result IssuesResult, err error) {
+	issues, err := searchIssues(ctx, i.IssueTrackerProvider, i.Cfg.Query)
+	if err != nil {
+		return result, err
+	}
+
+	result.Issues = make([]StatusIssue, len(issues))
+	for idx, issue := range issues {
+		result.Issues[idx] = newStatusIssue(issue)
+	}
+
+	if i.Cfg.OutputFormat == "json" {
+		jsonBytes, jsonErr := json.Marshal(result)
+		if jsonErr != nil {
+			return result, fmt.Errorf("failed to serialize result: %w", jsonErr)
+		}
+		fmt.Println(string(jsonBytes))
+	} else {
+		printIssues(result, i.Cfg.Query)
+	}
+
+	return result, nil
+}
+
+func printIssues(result IssuesResult, queryName string) {
+	if len(result.Issues) == 0 {
+		if queryName != "" {
+			fmt.Fprintf(logging.Output(), "There are no issues matching the query %s\n", queryName)
+		} else {
+			fmt.Fprintln(logging.Output(), "There are no open issues assigned to you")
+		}
+		return
+	}
+
+	w := tabwriter.NewWriter(logging.Output(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ISSUE\tTYPE\tSTATUS\tTITLE")
+	for _, issue := range result.Issues {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", issue.ID, issue.Type, issue.State, issue.Title)
+	}
+	w.Flush()
+}
+
+// searchIssues returns the issues matching the saved query or, if there is no query, the open
+// issues assigned to the current user
+func searchIssues(ctx context.Context, issueTrackerProvider domain.IssueTrackerProvider, queryName string) ([]domain.Issue, error) {
+	if queryName != "" {
+		return issueTrackerProvider.SearchIssues(ctx, queryName)
+	}
+	return issueTrackerProvider.GetAssignedIssues(ctx)
+}
+
+// issuePicker lets the user choose one of the open issues assigned to them or one of the
+// issues matching a saved query
+type issuePicker struct {
+	issueTrackerProvider    domain.IssueTrackerProvider
+	userInteractionProvider domain.UserInteractionProvider
+	query                   string
+}
+
+// pick returns the identifier of the issue selected by the user
+func (p issuePicker) pick(ctx context.Context) (issueID string, err error) {
+	issues, err := searchIssues(ctx, p.issueTrackerProvider, p.query)
+	if err != nil {
+		return "", err
+	}
+
+	if len(issues) == 0 {
+		if p.query != "" {
+			return "", ErrNoQueryIssues(p.query)
+		}
+		return "", ErrNoAssignedIssues
+	}
+
+	options := make([]string, len(issues))
+	for i, issue := range issues {
+		options[i] = fmt.Sprintf("%s %s", issue.FormatID(), issue.Title())
+	}
+
+	selected := 0
+	if err := p.userInteractionProvider.SelectWithFilter("Select the issue to work on (type to filter):", options, &selected); err != nil {
+		return "", err
+	}
+
+	if selected < 0 || selected >= len(issues) {
+		return "", fmt.Errorf("invalid issue selected")
+	}
+
+	return issues[selected].ID(), nil
+}
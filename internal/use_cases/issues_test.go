@@ -0,0 +1,80 @@
+package use_cases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/domain/issue_types"
+	domainFakes "github.com/InditexTech/gh-sherpa/internal/fakes/domain"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/stretchr/testify/suite"
+)
+
+type IssuesExecutionTestSuite struct {
+	suite.Suite
+	uc                   use_cases.Issues
+	issueTrackerProvider *domainFakes.FakeIssueTrackerProvider
+}
+
+func TestIssuesExecutionTestSuite(t *testing.T) {
+	suite.Run(t, new(IssuesExecutionTestSuite))
+}
+
+func (s *IssuesExecutionTestSuite) SetupSubTest() {
+	s.issueTrackerProvider = domainFakes.NewFakeIssueTrackerProvider()
+
+	s.uc = use_cases.Issues{
+		Cfg:                  use_cases.IssuesConfiguration{OutputFormat: "json"},
+		IssueTrackerProvider: s.issueTrackerProvider,
+	}
+}
+
+func (s *IssuesExecutionTestSuite) TestIssuesExecution() {
+	s.Run("should return an empty list if there are no assigned issues", func() {
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Empty(result.Issues)
+	})
+
+	s.Run("should return the assigned issues", func() {
+		s.issueTrackerProvider.AssignedIssues = []domain.Issue{
+			domainFakes.NewFakeIssue("1", issue_types.Feature, domain.IssueTrackerTypeGithub),
+			domainFakes.NewFakeIssue("PROJ-2", issue_types.Bug, domain.IssueTrackerTypeJira),
+		}
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Require().Len(result.Issues, 2)
+		s.Equal("GH-1", result.Issues[0].ID)
+		s.Equal("github", result.Issues[0].Tracker)
+		s.Equal("PROJ-2", result.Issues[1].ID)
+		s.Equal("bug", result.Issues[1].Type)
+	})
+
+	s.Run("should return the issues matching the saved query", func() {
+		s.uc.Cfg.Query = "sprint"
+		s.issueTrackerProvider.AssignedIssues = []domain.Issue{
+			domainFakes.NewFakeIssue("1", issue_types.Feature, domain.IssueTrackerTypeGithub),
+		}
+		s.issueTrackerProvider.QueryIssues = map[string][]domain.Issue{
+			"sprint": {domainFakes.NewFakeIssue("PROJ-3", issue_types.Feature, domain.IssueTrackerTypeJira)},
+		}
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Require().Len(result.Issues, 1)
+		s.Equal("PROJ-3", result.Issues[0].ID)
+	})
+
+	s.Run("should return error if the saved query does not exist", func() {
+		s.uc.Cfg.Query = "unknown"
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorIs(err, domainFakes.ErrNoQuery)
+	})
+}
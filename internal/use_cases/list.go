@@ -0,0 +1,221 @@
+package use_cases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/InditexTech/gh-sherpa/internal/branches"
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+)
+
+// DefaultListConcurrency is the default number of branches whose issue and pull request are fetched at the same time
+const DefaultListConcurrency = 4
+
+// pullRequestStateMerged is the state of a merged pull request as returned by GitHub
+const pullRequestStateMerged = "MERGED"
+
+// ListItem holds the information of an issue branch
+type ListItem struct {
+	BranchName     string             `json:"branch"`
+	Local          bool               `json:"local"`
+	Remote         bool               `json:"remote"`
+	LastCommitDate time.Time          `json:"last_commit_date"`
+	Issue          *StatusIssue       `json:"issue"`
+	PullRequest    *StatusPullRequest `json:"pull_request"`
+	Error          string             `json:"error,omitempty"`
+}
+
+// ListResult holds the outcome of a successful List execution.
+type ListResult struct {
+	Branches []ListItem `json:"branches"`
+}
+
+// ListConfiguration contains the arguments for the List use case
+type ListConfiguration struct {
+	Tracker      string        // --tracker: only list branches of issues from this tracker ("github" or "jira")
+	Merged       bool          // --merged: only list branches whose pull request has been merged
+	Stale        time.Duration // --stale: only list branches without commits for at least this duration
+	OutputFormat string        // --output: "" (default) or "json"
+	Concurrency  int           // maximum number of branches fetched at the same time. DefaultListConcurrency if not set
+}
+
+type List struct {
+	Cfg                  ListConfiguration
+	Git                  domain.GitProvider
+	IssueTrackerProvider domain.IssueTrackerProvider
+	PullRequestProvider  domain.PullRequestProvider
+}
+
+// Execute executes the list use case
+func (l List) Execute(ctx context.Context) (result ListResult, err error) {
+	items, err := l.collect(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	result.Branches = []ListItem{}
+	for _, item := range items {
+		if l.matches(item) {
+			result.Branches = append(result.Branches, item)
+		}
+	}
+
+	if l.Cfg.OutputFormat == "json" {
+		jsonBytes, jsonErr := json.Marshal(result)
+		if jsonErr != nil {
+			return result, fmt.Errorf("failed to serialize result: %w", jsonErr)
+		}
+		fmt.Println(string(jsonBytes))
+	} else {
+		printList(result)
+	}
+
+	return result, nil
+}
+
+// collect returns all the issue branches that are not filtered out by age,
+// with their issue and pull request
+func (l List) collect(ctx context.Context) ([]ListItem, error) {
+	allBranches, err := l.Git.ListBranches(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []ListItem{}
+	for _, branch := range allBranches {
+		branchNameInfo := branches.ParseBranchName(branch.Name)
+		if branchNameInfo == nil || branchNameInfo.IssueId == "" {
+			continue
+		}
+
+		if l.Cfg.Stale > 0 && time.Since(branch.LastCommitDate) < l.Cfg.Stale {
+			continue
+		}
+
+		items = append(items, ListItem{
+			BranchName:     branch.Name,
+			Local:          branch.Local,
+			Remote:         branch.Remote,
+			LastCommitDate: branch.LastCommitDate,
+		})
+	}
+
+	l.fetchAll(ctx, items)
+
+	return items, nil
+}
+
+// fetchAll fills the issue and pull request of every item, fetching at most
+// Cfg.Concurrency items at the same time
+func (l List) fetchAll(ctx context.Context, items []ListItem) {
+	concurrency := l.Cfg.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultListConcurrency
+	}
+
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range items {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(item *ListItem) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			l.fetch(ctx, item)
+		}(&items[i])
+	}
+	wg.Wait()
+}
+
+func (l List) fetch(ctx context.Context, item *ListItem) {
+	issueId := branches.ParseBranchName(item.BranchName).IssueId
+
+	issue, err := l.IssueTrackerProvider.GetIssue(ctx, l.IssueTrackerProvider.ParseIssueId(issueId))
+	if err != nil {
+		logging.Debugf("could not get issue %s for branch %s: %s", issueId, item.BranchName, err)
+		item.Error = err.Error()
+	} else {
+		statusIssue := newStatusIssue(issue)
+		item.Issue = &statusIssue
+	}
+
+	pr, err := l.PullRequestProvider.GetPullRequestForBranch(ctx, item.BranchName)
+	if err != nil {
+		logging.Debugf("could not get pull request for branch %s: %s", item.BranchName, err)
+		if item.Error == "" {
+			item.Error = err.Error()
+		}
+		return
+	}
+	item.PullRequest = newStatusPullRequest(pr)
+}
+
+func (l List) matches(item ListItem) bool {
+	if l.Cfg.Tracker != "" && (item.Issue == nil || item.Issue.Tracker != l.Cfg.Tracker) {
+		return false
+	}
+
+	if l.Cfg.Merged && (item.PullRequest == nil || item.PullRequest.State != pullRequestStateMerged) {
+		return false
+	}
+
+	return true
+}
+
+func printList(result ListResult) {
+	if len(result.Branches) == 0 {
+		fmt.Fprintln(logging.Output(), "No issue branches found")
+		return
+	}
+
+	w := tabwriter.NewWriter(logging.Output(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "BRANCH\tISSUE\tSTATUS\tPULL REQUEST")
+	for _, item := range result.Branches {
+		title, state := "-", "-"
+		if item.Issue != nil {
+			title, state = item.Issue.Title, item.Issue.State
+		}
+
+		pr := "-"
+		if item.PullRequest != nil {
+			pr = fmt.Sprintf("#%d %s", item.PullRequest.Number, item.PullRequest.State)
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", item.BranchName, title, state, pr)
+	}
+	w.Flush()
+}
+
+// ParseAge parses a duration like "30d", "2w" or any value accepted by time.ParseDuration
+func ParseAge(value string) (time.Duration, error) {
+	units := map[string]time.Duration{
+		"d": 24 * time.Hour,
+		"w": 7 * 24 * time.Hour,
+	}
+
+	for suffix, unit := range units {
+		if number, found := strings.CutSuffix(value, suffix); found {
+			n, err := strconv.Atoi(number)
+			if err != nil || n < 0 {
+				return 0, fmt.Errorf("invalid duration %q", value)
+			}
+			return time.Duration(n) * unit, nil
+		}
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	return duration, nil
+}
@@ -0,0 +1,147 @@
+package use_cases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/domain/issue_types"
+	domainFakes "github.com/InditexTech/gh-sherpa/internal/fakes/domain"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListExecutionTestSuite struct {
+	suite.Suite
+	uc                   use_cases.List
+	gitProvider          *domainFakes.FakeGitProvider
+	issueTrackerProvider *domainFakes.FakeIssueTrackerProvider
+	pullRequestProvider  *domainFakes.FakePullRequestProvider
+}
+
+func TestListExecutionTestSuite(t *testing.T) {
+	suite.Run(t, new(ListExecutionTestSuite))
+}
+
+func (s *ListExecutionTestSuite) SetupSubTest() {
+	s.gitProvider = domainFakes.NewFakeGitProvider()
+	s.gitProvider.AddLocalBranches("feature/GH-1-local-issue", "bugfix/PROJ-2-jira-issue")
+	s.gitProvider.AddRemoteBranches("feature/GH-1-local-issue", "feature/GH-3-remote-issue")
+
+	now := time.Now()
+	s.gitProvider.LastCommitDates["feature/GH-1-local-issue"] = now
+	s.gitProvider.LastCommitDates["bugfix/PROJ-2-jira-issue"] = now.Add(-40 * 24 * time.Hour)
+	s.gitProvider.LastCommitDates["feature/GH-3-remote-issue"] = now.Add(-10 * 24 * time.Hour)
+
+	s.issueTrackerProvider = domainFakes.NewFakeIssueTrackerProvider()
+	s.issueTrackerProvider.AddIssue(domainFakes.NewFakeIssue("1", issue_types.Feature, domain.IssueTrackerTypeGithub))
+	s.issueTrackerProvider.AddIssue(domainFakes.NewFakeIssue("PROJ-2", issue_types.Bug, domain.IssueTrackerTypeJira))
+	s.issueTrackerProvider.AddIssue(domainFakes.NewFakeIssue("3", issue_types.Feature, domain.IssueTrackerTypeGithub))
+
+	s.pullRequestProvider = domainFakes.NewFakePullRequestProvider()
+	s.pullRequestProvider.AddPullRequest("feature/GH-3-remote-issue", domain.PullRequest{Number: 3, State: "MERGED", Closed: true})
+
+	s.uc = use_cases.List{
+		Cfg:                  use_cases.ListConfiguration{OutputFormat: "json"},
+		Git:                  s.gitProvider,
+		IssueTrackerProvider: s.issueTrackerProvider,
+		PullRequestProvider:  s.pullRequestProvider,
+	}
+}
+
+func (s *ListExecutionTestSuite) branchNames(result use_cases.ListResult) []string {
+	names := []string{}
+	for _, item := range result.Branches {
+		names = append(names, item.BranchName)
+	}
+	return names
+}
+
+func (s *ListExecutionTestSuite) TestListExecution() {
+	s.Run("should list local and remote issue branches", func() {
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal([]string{"feature/GH-1-local-issue", "bugfix/PROJ-2-jira-issue", "feature/GH-3-remote-issue"}, s.branchNames(result))
+
+		first := result.Branches[0]
+		s.True(first.Local)
+		s.True(first.Remote)
+		s.Require().NotNil(first.Issue)
+		s.Equal("GH-1", first.Issue.ID)
+		s.Nil(first.PullRequest)
+
+		last := result.Branches[2]
+		s.False(last.Local)
+		s.Require().NotNil(last.PullRequest)
+		s.Equal(int64(3), last.PullRequest.Number)
+	})
+
+	s.Run("should report the error if the issue could not be found", func() {
+		s.gitProvider.AddLocalBranches("feature/GH-4-unknown-issue")
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Len(result.Branches, 4)
+		s.Nil(result.Branches[2].Issue)
+		s.Equal(domainFakes.ErrNoIssue.Error(), result.Branches[2].Error)
+	})
+
+	s.Run("should filter by tracker", func() {
+		s.uc.Cfg.Tracker = "jira"
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal([]string{"bugfix/PROJ-2-jira-issue"}, s.branchNames(result))
+	})
+
+	s.Run("should filter merged branches", func() {
+		s.uc.Cfg.Merged = true
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal([]string{"feature/GH-3-remote-issue"}, s.branchNames(result))
+	})
+
+	s.Run("should filter stale branches", func() {
+		s.uc.Cfg.Stale = 30 * 24 * time.Hour
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal([]string{"bugfix/PROJ-2-jira-issue"}, s.branchNames(result))
+	})
+}
+
+func TestParseAge(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "days", value: "30d", want: 30 * 24 * time.Hour},
+		{name: "weeks", value: "2w", want: 14 * 24 * time.Hour},
+		{name: "go duration", value: "12h", want: 12 * time.Hour},
+		{name: "invalid days", value: "xd", wantErr: true},
+		{name: "invalid value", value: "soon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := use_cases.ParseAge(tt.value)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
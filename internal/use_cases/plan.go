@@ -0,0 +1,168 @@
+package use_cases
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+)
+
+// PlanAction identifies the kind of change done by a step of a plan
+type PlanAction string
+
+const (
+	PlanActionSetupFork         PlanAction = "setup_fork"
+	PlanActionFetch             PlanAction = "fetch"
+	PlanActionCreateBranch      PlanAction = "create_branch"
+	PlanActionCheckoutBranch    PlanAction = "checkout_branch"
+	PlanActionAddWorktree       PlanAction = "add_worktree"
+	PlanActionSetStackParent    PlanAction = "set_stack_parent"
+	PlanActionEmptyCommit       PlanAction = "empty_commit"
+	PlanActionPush              PlanAction = "push"
+	PlanActionCreatePullRequest PlanAction = "create_pull_request"
+	PlanActionUpdatePullRequest PlanAction = "update_pull_request"
+)
+
+// PlanStep is a change that a use case is going to do in the repository or in GitHub
+type PlanStep struct {
+	Action       PlanAction `json:"action"`
+	Description  string     `json:"description"`
+	Fork         string     `json:"fork,omitempty"`
+	Branch       string     `json:"branch,omitempty"`
+	Base         string     `json:"base,omitempty"`
+	Path         string     `json:"path,omitempty"`
+	CarryChanges bool       `json:"carry_changes,omitempty"`
+	Message      string     `json:"message,omitempty"`
+	Signoff      bool       `json:"signoff,omitempty"`
+	URL          string     `json:"url,omitempty"`
+	Title        string     `json:"title,omitempty"`
+	Body         string     `json:"body,omitempty"`
+	Draft        bool       `json:"draft,omitempty"`
+	Labels       []string   `json:"labels,omitempty"`
+	Reviewers    []string   `json:"reviewers,omitempty"`
+	Assignees    []string   `json:"assignees,omitempty"`
+	// Optional is set when a failure of the step does not stop the plan
+	Optional bool `json:"optional,omitempty"`
+}
+
+// Plan is the ordered list of changes of a use case. The steps are added as the use case decides
+// them and run in stages, so the decisions that depend on a change, like a fetch, are made after
+// it is done. In dry-run mode the steps are only printed.
+type Plan struct {
+	Steps []PlanStep
+	// runs holds the functions that do the steps, in the same order
+	runs []func() error
+	// next is the index of the first step that has not been run yet
+	next int
+}
+
+// Add appends the step to the plan. The run function does it when the plan is run.
+func (p *Plan) Add(step PlanStep, run func() error) {
+	if step.Description == "" {
+		step.Description = step.describe()
+	}
+	p.Steps = append(p.Steps, step)
+	p.runs = append(p.runs, run)
+}
+
+// Run runs, in order, the steps added since the last run. It stops at the first failed step unless
+// the step is optional.
+func (p *Plan) Run() error {
+	for p.next < len(p.Steps) {
+		step, run := p.Steps[p.next], p.runs[p.next]
+		p.next++
+
+		logging.Debugf("Running: %s", step.Description)
+		if err := run(); err != nil {
+			if step.Optional {
+				logging.Debugf("Ignoring the failure of the optional step %q: %s", step.Description, err)
+				continue
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Print prints the steps of the plan in dry-run mode
+func (p *Plan) Print() {
+	if len(p.Steps) == 0 {
+		fmt.Fprintln(logging.Output(), "[dry-run] Nothing would be done")
+		return
+	}
+
+	fmt.Fprintln(logging.Output(), "[dry-run] The following steps would be done:")
+	for i, step := range p.Steps {
+		fmt.Fprintf(logging.Output(), "  %d. %s\n", i+1, step.Description)
+	}
+}
+
+func (s PlanStep) describe() string {
+	switch s.Action {
+	case PlanActionSetupFork:
+		if s.Fork == "" {
+			return "Create a fork of the repository and set up its remotes"
+		}
+		return fmt.Sprintf("Set up the fork %s and its remotes", s.Fork)
+	case PlanActionFetch:
+		description := fmt.Sprintf("Fetch the branch %s", s.Branch)
+		if s.Optional {
+			description += " if it exists"
+		}
+		return description
+	case PlanActionCreateBranch:
+		return fmt.Sprintf("Create the branch %s from %s%s", s.Branch, s.Base, s.carrying())
+	case PlanActionCheckoutBranch:
+		return fmt.Sprintf("Switch to the branch %s%s", s.Branch, s.carrying())
+	case PlanActionAddWorktree:
+		if s.Base != "" {
+			return fmt.Sprintf("Add the worktree %s with the new branch %s from %s", s.Path, s.Branch, s.Base)
+		}
+		return fmt.Sprintf("Add the worktree %s for the branch %s", s.Path, s.Branch)
+	case PlanActionSetStackParent:
+		return fmt.Sprintf("Stack the branch %s on %s", s.Branch, s.Base)
+	case PlanActionEmptyCommit:
+		description := fmt.Sprintf("Create the empty commit %q", s.Message)
+		if s.Signoff {
+			description += " signed off"
+		}
+		return description
+	case PlanActionPush:
+		return fmt.Sprintf("Push the branch %s", s.Branch)
+	case PlanActionCreatePullRequest:
+		kind := "pull request"
+		if s.Draft {
+			kind = "draft pull request"
+		}
+		return fmt.Sprintf("Create the %s %q from %s to %s%s", kind, s.Title, s.Branch, s.Base, s.pullRequestDetails())
+	case PlanActionUpdatePullRequest:
+		return fmt.Sprintf("Update the title, body and type label of the pull request %s to %q", s.URL, s.Title)
+	default:
+		return string(s.Action)
+	}
+}
+
+func (s PlanStep) carrying() string {
+	if s.CarryChanges {
+		return " carrying the uncommitted changes"
+	}
+	return ""
+}
+
+func (s PlanStep) pullRequestDetails() string {
+	details := []string{}
+	if len(s.Labels) > 0 {
+		details = append(details, "labels "+strings.Join(s.Labels, ", "))
+	}
+	if len(s.Reviewers) > 0 {
+		details = append(details, "reviewers "+strings.Join(s.Reviewers, ", "))
+	}
+	if len(s.Assignees) > 0 {
+		details = append(details, "assignees "+strings.Join(s.Assignees, ", "))
+	}
+	if len(details) == 0 {
+		return ""
+	}
+	return " with the " + strings.Join(details, "; ")
+}
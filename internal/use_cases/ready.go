@@ -0,0 +1,302 @@
+package use_cases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/InditexTech/gh-sherpa/internal/branches"
+	"github.com/InditexTech/gh-sherpa/internal/codeowners"
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+)
+
+// defaultChecksPollInterval is the time between two queries of the required checks while waiting for them
+const defaultChecksPollInterval = 15 * time.Second
+
+// ErrBranchNotPushed is returned when the local branch is not the same as its remote branch
+func ErrBranchNotPushed(branch string) error {
+	return fmt.Errorf("the branch %s has changes that have not been pushed, push them before marking the pull request as ready for review", branch)
+}
+
+// ErrRequiredChecksFailed is returned when a required check of the pull request failed
+var ErrRequiredChecksFailed = errors.New("some required checks of the pull request failed, fix them before marking it as ready for review")
+
+// ErrRequiredChecksTimeout is returned when the required checks did not finish in time
+func ErrRequiredChecksTimeout(timeout time.Duration) error {
+	return fmt.Errorf("the required checks of the pull request did not finish after waiting %s", timeout)
+}
+
+// ReadyResult holds the outcome of a successful Ready execution.
+type ReadyResult struct {
+	BranchName      string   `json:"branch"`
+	PRURL           string   `json:"pr_url"`
+	ChecksStatus    string   `json:"checks_status"`
+	Reviewers       []string `json:"reviewers"`
+	MarkedReady     bool     `json:"marked_ready"`
+	IssueTransition string   `json:"issue_transition,omitempty"`
+}
+
+// ReadyConfiguration contains the arguments for the Ready use case
+type ReadyConfiguration struct {
+	WaitForChecks     bool          // --wait-checks: wait for the required checks to pass
+	ChecksTimeout     time.Duration // --checks-timeout: maximum time to wait for the required checks
+	PollInterval      time.Duration // time between two queries of the required checks, 15 seconds if it is not set
+	Reviewers         []string      // --reviewer or the pull_requests.reviewers setting
+	RequestCodeOwners bool          // pull_requests.request_codeowners setting
+	JiraTransition    string        // jira.transitions.ready_for_review setting, empty to not transition the issue
+	OutputFormat      string        // --output: "" (default) or "json"
+}
+
+// Ready marks the draft pull request of the current branch as ready for review
+type Ready struct {
+	Cfg                  ReadyConfiguration
+	Git                  domain.GitProvider
+	IssueTrackerProvider domain.IssueTrackerProvider
+	PullRequestProvider  domain.PullRequestProvider
+}
+
+// Execute executes the ready use case
+func (r Ready) Execute(ctx context.Context) (result ReadyResult, err error) {
+	branch, err := r.Git.GetCurrentBranch(ctx)
+	if err != nil {
+		return result, fmt.Errorf("could not get the current branch name because %w", err)
+	}
+
+	pr, err := r.PullRequestProvider.GetPullRequestForBranch(ctx, branch)
+	if err != nil {
+		return result, fmt.Errorf("error while getting pull request for branch: %w", err)
+	}
+	if pr == nil || pr.Closed {
+		return result, ErrNoOpenPullRequest(branch)
+	}
+
+	result = ReadyResult{BranchName: branch, PRURL: pr.Url, Reviewers: []string{}}
+
+	if err := r.checkPushed(ctx, branch); err != nil {
+		return result, err
+	}
+
+	checksStatus, err := r.checksStatus(ctx, branch)
+	if err != nil {
+		return result, err
+	}
+	result.ChecksStatus = string(checksStatus)
+
+	reviewers, err := r.reviewers(ctx, *pr, branch)
+	if err != nil {
+		return result, err
+	}
+	if len(reviewers) > 0 {
+		if err := r.PullRequestProvider.EditPullRequest(ctx, branch, domain.PullRequestEdit{AddReviewers: reviewers}); err != nil {
+			return result, err
+		}
+		result.Reviewers = reviewers
+	}
+
+	if pr.IsDraft {
+		if err := r.PullRequestProvider.MarkPullRequestReady(ctx, branch); err != nil {
+			return result, err
+		}
+		result.MarkedReady = true
+	}
+
+	if result.IssueTransition, err = r.transitionIssue(ctx, branch); err != nil {
+		return result, fmt.Errorf("the pull request is ready for review but its issue could not be transitioned: %w", err)
+	}
+
+	return result, r.printResult(result)
+}
+
+// checkPushed verifies the local branch points to the same commit as its remote branch
+func (r Ready) checkPushed(ctx context.Context, branch string) error {
+	if !r.Git.RemoteBranchExists(ctx, branch) {
+		return ErrBranchNotPushed(branch)
+	}
+
+	local, err := r.Git.ResolveRef(ctx, branch)
+	if err != nil {
+		return err
+	}
+	remote, err := r.Git.ResolveRef(ctx, r.Git.GetRemotes(ctx).Push+"/"+branch)
+	if err != nil {
+		return err
+	}
+	if local != remote {
+		return ErrBranchNotPushed(branch)
+	}
+
+	return nil
+}
+
+// checksStatus returns the status of the required checks, waiting for them to finish if it is configured
+func (r Ready) checksStatus(ctx context.Context, branch string) (domain.ChecksStatus, error) {
+	status, err := r.PullRequestProvider.GetRequiredChecksStatus(ctx, branch)
+	if err != nil {
+		return status, err
+	}
+
+	if !r.Cfg.WaitForChecks {
+		if status == domain.ChecksStatusFailure && r.Cfg.OutputFormat != "json" {
+			logging.PrintWarn("some required checks of the pull request failed")
+		}
+		return status, nil
+	}
+
+	pollInterval := r.Cfg.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultChecksPollInterval
+	}
+
+	deadline := time.Now().Add(r.Cfg.ChecksTimeout)
+	for status == domain.ChecksStatusPending {
+		if !time.Now().Before(deadline) {
+			return status, ErrRequiredChecksTimeout(r.Cfg.ChecksTimeout)
+		}
+		if r.Cfg.OutputFormat != "json" {
+			logging.PrintInfo("Waiting for the required checks of the pull request to finish...")
+		}
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-time.After(min(pollInterval, time.Until(deadline))):
+		}
+
+		if status, err = r.PullRequestProvider.GetRequiredChecksStatus(ctx, branch); err != nil {
+			return status, err
+		}
+	}
+
+	if status == domain.ChecksStatusFailure {
+		return status, ErrRequiredChecksFailed
+	}
+
+	return status, nil
+}
+
+// reviewers returns the configured reviewers and, if it is enabled, the code owners of the files
+// changed in the branch, leaving out the author of the pull request
+func (r Ready) reviewers(ctx context.Context, pr domain.PullRequest, branch string) ([]string, error) {
+	candidates := slices.Clone(r.Cfg.Reviewers)
+
+	if r.Cfg.RequestCodeOwners {
+		owners, err := r.codeOwners(ctx, pr, branch)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, owners...)
+	}
+
+	reviewers := []string{}
+	for _, reviewer := range candidates {
+		// Owners can be emails, which cannot be requested as reviewers
+		if !strings.HasPrefix(reviewer, "@") && strings.Contains(reviewer, "@") {
+			continue
+		}
+		reviewer = strings.TrimPrefix(reviewer, "@")
+		if reviewer == "" || strings.EqualFold(reviewer, pr.Author) || slices.Contains(reviewers, reviewer) {
+			continue
+		}
+		reviewers = append(reviewers, reviewer)
+	}
+
+	return reviewers, nil
+}
+
+// codeOwners returns the owners of the files changed in the branch, as defined in the CODEOWNERS file
+func (r Ready) codeOwners(ctx context.Context, pr domain.PullRequest, branch string) ([]string, error) {
+	root, err := r.Git.GetRepositoryRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path, found := codeowners.Find(root)
+	if !found {
+		logging.Debugf("the repository has no CODEOWNERS file")
+		return nil, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the CODEOWNERS file: %w", err)
+	}
+	defer file.Close()
+
+	rules, err := codeowners.Parse(file)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the CODEOWNERS file: %w", err)
+	}
+
+	changedFiles, err := r.Git.GetChangedFiles(ctx, branch, pr.BaseRefName)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := []string{}
+	for _, changedFile := range changedFiles {
+		for _, owner := range rules.Owners(changedFile) {
+			if !slices.Contains(owners, owner) {
+				owners = append(owners, owner)
+			}
+		}
+	}
+
+	return owners, nil
+}
+
+// transitionIssue applies the configured transition to the Jira issue of the branch and returns its name
+func (r Ready) transitionIssue(ctx context.Context, branch string) (string, error) {
+	if r.Cfg.JiraTransition == "" {
+		return "", nil
+	}
+
+	branchNameInfo := branches.ParseBranchName(branch)
+	if branchNameInfo == nil || branchNameInfo.IssueId == "" {
+		logging.Debugf("the branch %s has no issue to transition", branch)
+		return "", nil
+	}
+
+	issue, err := r.IssueTrackerProvider.GetIssue(ctx, r.IssueTrackerProvider.ParseIssueId(branchNameInfo.IssueId))
+	if err != nil {
+		return "", err
+	}
+	if issue.TrackerType() != domain.IssueTrackerTypeJira {
+		return "", nil
+	}
+
+	if err := r.IssueTrackerProvider.TransitionIssue(ctx, issue.ID(), r.Cfg.JiraTransition); err != nil {
+		return "", err
+	}
+
+	return r.Cfg.JiraTransition, nil
+}
+
+func (r Ready) printResult(result ReadyResult) error {
+	if r.Cfg.OutputFormat == "json" {
+		jsonBytes, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("failed to serialize result: %w", err)
+		}
+		fmt.Println(string(jsonBytes))
+		return nil
+	}
+
+	if result.MarkedReady {
+		fmt.Fprintf(logging.Output(), "The pull request %s is now ready for review\n", logging.PaintInfo(result.PRURL))
+	} else {
+		fmt.Fprintf(logging.Output(), "The pull request %s was already ready for review\n", logging.PaintInfo(result.PRURL))
+	}
+	if len(result.Reviewers) > 0 {
+		fmt.Fprintf(logging.Output(), "  requested reviewers: %s\n", strings.Join(result.Reviewers, ", "))
+	}
+	if result.IssueTransition != "" {
+		fmt.Fprintf(logging.Output(), "  issue transitioned: %s\n", result.IssueTransition)
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package use_cases_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/domain/issue_types"
+	domainFakes "github.com/InditexTech/gh-sherpa/internal/fakes/domain"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	readyBranchName     = "feature/GH-1-sample-issue"
+	readyJiraBranchName = "feature/PROJECTKEY-1-sample-issue"
+	readyPullRequestURL = "https://github.com/inditextech/gh-sherpa-test-repo/pulls/1"
+)
+
+type ReadyExecutionTestSuite struct {
+	suite.Suite
+	uc                   use_cases.Ready
+	gitProvider          *domainFakes.FakeGitProvider
+	issueTrackerProvider *domainFakes.FakeIssueTrackerProvider
+	pullRequestProvider  *domainFakes.FakePullRequestProvider
+}
+
+func TestReadyExecutionTestSuite(t *testing.T) {
+	suite.Run(t, new(ReadyExecutionTestSuite))
+}
+
+func (s *ReadyExecutionTestSuite) SetupSubTest() {
+	s.gitProvider = domainFakes.NewFakeGitProvider()
+	s.gitProvider.AddLocalBranches(readyBranchName, readyJiraBranchName)
+	s.gitProvider.AddRemoteBranches(readyBranchName, readyJiraBranchName)
+	s.gitProvider.CurrentBranch = readyBranchName
+
+	s.issueTrackerProvider = domainFakes.NewFakeIssueTrackerProvider()
+	s.issueTrackerProvider.AddIssue(domainFakes.NewFakeIssue("1", issue_types.Feature, domain.IssueTrackerTypeGithub))
+	s.issueTrackerProvider.AddIssue(domainFakes.NewFakeIssue("PROJECTKEY-1", issue_types.Feature, domain.IssueTrackerTypeJira))
+
+	s.pullRequestProvider = domainFakes.NewFakePullRequestProvider()
+	for _, branch := range []string{readyBranchName, readyJiraBranchName} {
+		s.pullRequestProvider.AddPullRequest(branch, domain.PullRequest{
+			Url:         readyPullRequestURL,
+			State:       "OPEN",
+			IsDraft:     true,
+			BaseRefName: "main",
+			Author:      "octocat",
+		})
+	}
+
+	s.uc = use_cases.Ready{
+		Cfg: use_cases.ReadyConfiguration{
+			ChecksTimeout: time.Second,
+			PollInterval:  time.Millisecond,
+			OutputFormat:  "json",
+		},
+		Git:                  s.gitProvider,
+		IssueTrackerProvider: s.issueTrackerProvider,
+		PullRequestProvider:  s.pullRequestProvider,
+	}
+}
+
+func (s *ReadyExecutionTestSuite) TestReadyExecution() {
+	s.Run("should error if there is no open pull request", func() {
+		s.pullRequestProvider.PullRequests[readyBranchName].Closed = true
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, "there is no open pull request for the branch feature/GH-1-sample-issue")
+	})
+
+	s.Run("should error if the branch does not exist in the remote", func() {
+		s.gitProvider.ResetRemoteBranches()
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, "the branch feature/GH-1-sample-issue has changes that have not been pushed")
+		s.True(s.pullRequestProvider.PullRequests[readyBranchName].IsDraft)
+	})
+
+	s.Run("should error if the branch has changes that have not been pushed", func() {
+		s.gitProvider.Commits[readyBranchName] = "sha-local-commit"
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, "the branch feature/GH-1-sample-issue has changes that have not been pushed")
+	})
+
+	s.Run("should mark the pull request as ready and request the configured reviewers", func() {
+		s.uc.Cfg.Reviewers = []string{"@reviewer", "octocat", "my-org/my-team", "reviewer"}
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal(use_cases.ReadyResult{
+			BranchName:  readyBranchName,
+			PRURL:       readyPullRequestURL,
+			Reviewers:   []string{"reviewer", "my-org/my-team"},
+			MarkedReady: true,
+		}, result)
+		s.False(s.pullRequestProvider.PullRequests[readyBranchName].IsDraft)
+		s.Equal([]string{"reviewer", "my-org/my-team"}, s.pullRequestProvider.Reviewers[readyBranchName])
+	})
+
+	s.Run("should not mark the pull request again if it is already ready", func() {
+		s.pullRequestProvider.PullRequests[readyBranchName].IsDraft = false
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.False(result.MarkedReady)
+		s.Empty(s.pullRequestProvider.Reviewers[readyBranchName])
+	})
+
+	s.Run("should request the code owners of the changed files", func() {
+		root := s.T().TempDir()
+		s.Require().NoError(os.WriteFile(filepath.Join(root, "CODEOWNERS"), []byte("* @octocat\n/docs/ @doc-writer docs@example.com\n*.go @gopher\n"), 0o644))
+		s.gitProvider.RepositoryRoot = root
+		s.gitProvider.ChangedFiles[readyBranchName] = []string{"README.md", "docs/USAGE.md", "cmd/root.go"}
+		s.uc.Cfg.RequestCodeOwners = true
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal([]string{"doc-writer", "gopher"}, result.Reviewers)
+	})
+
+	s.Run("should wait for the required checks to pass", func() {
+		s.uc.Cfg.WaitForChecks = true
+		s.pullRequestProvider.RequiredChecks[readyBranchName] = []domain.ChecksStatus{
+			domain.ChecksStatusPending,
+			domain.ChecksStatusPending,
+			domain.ChecksStatusSuccess,
+		}
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal(string(domain.ChecksStatusSuccess), result.ChecksStatus)
+		s.True(result.MarkedReady)
+	})
+
+	s.Run("should error and keep the draft if a required check fails", func() {
+		s.uc.Cfg.WaitForChecks = true
+		s.pullRequestProvider.RequiredChecks[readyBranchName] = []domain.ChecksStatus{
+			domain.ChecksStatusPending,
+			domain.ChecksStatusFailure,
+		}
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorIs(err, use_cases.ErrRequiredChecksFailed)
+		s.True(s.pullRequestProvider.PullRequests[readyBranchName].IsDraft)
+	})
+
+	s.Run("should error if the required checks do not finish in time", func() {
+		s.uc.Cfg.WaitForChecks = true
+		s.uc.Cfg.ChecksTimeout = 5 * time.Millisecond
+		s.pullRequestProvider.RequiredChecks[readyBranchName] = []domain.ChecksStatus{domain.ChecksStatusPending}
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, "the required checks of the pull request did not finish after waiting 5ms")
+		s.True(s.pullRequestProvider.PullRequests[readyBranchName].IsDraft)
+	})
+
+	s.Run("should stop waiting for the required checks if the context is cancelled", func() {
+		s.uc.Cfg.WaitForChecks = true
+		s.uc.Cfg.PollInterval = time.Minute
+		s.pullRequestProvider.RequiredChecks[readyBranchName] = []domain.ChecksStatus{domain.ChecksStatusPending}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		defer cancel()
+
+		_, err := s.uc.Execute(ctx)
+
+		s.ErrorIs(err, context.DeadlineExceeded)
+		s.True(s.pullRequestProvider.PullRequests[readyBranchName].IsDraft)
+	})
+
+	s.Run("should not wait for the pending checks if it is not enabled", func() {
+		s.pullRequestProvider.RequiredChecks[readyBranchName] = []domain.ChecksStatus{domain.ChecksStatusPending}
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal(string(domain.ChecksStatusPending), result.ChecksStatus)
+		s.True(result.MarkedReady)
+	})
+
+	s.Run("should transition the Jira issue of the branch", func() {
+		s.gitProvider.CurrentBranch = readyJiraBranchName
+		s.uc.Cfg.JiraTransition = "In Review"
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal("In Review", result.IssueTransition)
+		s.Equal("In Review", s.issueTrackerProvider.Transitions["PROJECTKEY-1"])
+	})
+
+	s.Run("should not transition GitHub issues", func() {
+		s.uc.Cfg.JiraTransition = "In Review"
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Empty(result.IssueTransition)
+		s.Empty(s.issueTrackerProvider.Transitions)
+	})
+}
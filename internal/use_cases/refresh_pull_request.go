@@ -0,0 +1,224 @@
+package use_cases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/InditexTech/gh-sherpa/internal/branches"
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+)
+
+// PullRequestBodyMarker separates the body generated by sherpa from the content written by the
+// user, which is kept when the pull request is refreshed
+const PullRequestBodyMarker = "<!-- gh-sherpa: write below this line, it is kept when the pull request is refreshed -->"
+
+// ErrNoOpenPullRequest is returned when the branch has no open pull request to refresh
+func ErrNoOpenPullRequest(branch string) error {
+	return fmt.Errorf("there is no open pull request for the branch %s", branch)
+}
+
+// withUserContent appends the marker and the content written by the user to a generated body
+func withUserContent(body string, userContent string) string {
+	if body == "" {
+		return PullRequestBodyMarker + userContent
+	}
+	return body + "\n\n" + PullRequestBodyMarker + userContent
+}
+
+// withWrittenBody returns the body given by the user, with --pr-body or --pr-body-file, below the
+// marker, as it is content written by the user that must be kept when the pull request is refreshed
+func withWrittenBody(body string) string {
+	if body == "" {
+		return withUserContent("", "")
+	}
+	return withUserContent("", "\n"+body)
+}
+
+// userContent returns the content written by the user below the marker of the body
+func userContent(body string) (content string, found bool) {
+	_, content, found = strings.Cut(body, PullRequestBodyMarker)
+	return content, found
+}
+
+// RefreshPullRequestResult holds the outcome of a successful RefreshPullRequest execution.
+type RefreshPullRequestResult struct {
+	BranchName    string   `json:"branch"`
+	PRURL         string   `json:"pr_url"`
+	Title         string   `json:"title"`
+	TitleUpdated  bool     `json:"title_updated"`
+	BodyUpdated   bool     `json:"body_updated"`
+	AddedLabels   []string `json:"added_labels"`
+	RemovedLabels []string `json:"removed_labels"`
+}
+
+// RefreshPullRequestConfiguration contains the arguments for the RefreshPullRequest use case
+type RefreshPullRequestConfiguration struct {
+	BranchName    string // --branch: branch of the pull request, the current one if it is not set
+	CloseIssue    bool
+	TemplatePath  string
+	TypeLabels    []string // all the labels mapped to an issue type, the stale ones are removed
+	IsInteractive bool
+	OutputFormat  string // --output: "" (default) or "json"
+}
+
+// RefreshPullRequest renders again the title, body and type label of a pull request from its issue
+type RefreshPullRequest struct {
+	Cfg                     RefreshPullRequestConfiguration
+	Git                     domain.GitProvider
+	IssueTrackerProvider    domain.IssueTrackerProvider
+	UserInteractionProvider domain.UserInteractionProvider
+	PullRequestProvider     domain.PullRequestProvider
+}
+
+// Execute executes the refresh pull request use case
+func (r RefreshPullRequest) Execute(ctx context.Context) (result RefreshPullRequestResult, err error) {
+	if err := validateTemplateFile(ctx, r.Cfg.TemplatePath, r.Git); err != nil {
+		return result, err
+	}
+
+	if r.Cfg.OutputFormat == "json" {
+		r.Cfg.IsInteractive = false
+	}
+
+	branch := r.Cfg.BranchName
+	if branch == "" {
+		if branch, err = r.Git.GetCurrentBranch(ctx); err != nil {
+			return result, fmt.Errorf("could not get the current branch name because %w", err)
+		}
+	}
+
+	pr, err := r.PullRequestProvider.GetPullRequestForBranch(ctx, branch)
+	if err != nil {
+		return result, fmt.Errorf("error while getting pull request for branch: %w", err)
+	}
+	if pr == nil || pr.Closed {
+		return result, ErrNoOpenPullRequest(branch)
+	}
+
+	branchNameInfo := branches.ParseBranchName(branch)
+	if branchNameInfo == nil || branchNameInfo.IssueId == "" {
+		return result, fmt.Errorf("could not find an issue identifier in the branch named %s", logging.PaintWarning(branch))
+	}
+
+	issue, err := r.IssueTrackerProvider.GetIssue(ctx, r.IssueTrackerProvider.ParseIssueId(branchNameInfo.IssueId))
+	if err != nil {
+		return result, err
+	}
+
+	content := CreatePullRequest{
+		Cfg: CreatePullRequestConfiguration{CloseIssue: r.Cfg.CloseIssue, TemplatePath: r.Cfg.TemplatePath},
+		Git: r.Git,
+	}
+	title, body, err := content.getPullRequestTitleAndBody(ctx, issue)
+	if err != nil {
+		return result, err
+	}
+
+	result, err = r.apply(ctx, *pr, branch, issue, title, body)
+	if err != nil {
+		return result, err
+	}
+
+	return result, r.printResult(result)
+}
+
+// apply updates the pull request with the given title and generated body, keeping the content
+// written by the user below the marker unless the body brings its own, and replaces its stale type labels
+func (r RefreshPullRequest) apply(ctx context.Context, pr domain.PullRequest, branch string, issue domain.Issue, title string, body string) (result RefreshPullRequestResult, err error) {
+	result = RefreshPullRequestResult{
+		BranchName:    branch,
+		PRURL:         pr.Url,
+		Title:         title,
+		AddedLabels:   []string{},
+		RemovedLabels: []string{},
+	}
+
+	edit := domain.PullRequestEdit{}
+
+	if title != pr.Title {
+		edit.Title = title
+	}
+
+	generated, writtenContent, _ := strings.Cut(body, PullRequestBodyMarker)
+	generated = strings.TrimSuffix(generated, "\n\n")
+	if writtenContent != "" {
+		// The body given with --pr-body or --pr-body-file replaces the content written before
+		edit.Body = withUserContent(generated, writtenContent)
+	} else if existingUserContent, found := userContent(pr.Body); found {
+		edit.Body = withUserContent(generated, existingUserContent)
+	} else if pr.Body == "" {
+		edit.Body = withUserContent(generated, "")
+	} else if r.Cfg.IsInteractive {
+		logging.PrintWarn("the body of the pull request has no sherpa marker, so it is not possible to know which content was written by you")
+		replace, err := r.UserInteractionProvider.AskUserForConfirmation("Do you want to replace the whole body of the pull request?", false)
+		if err != nil {
+			return result, err
+		}
+		if replace {
+			edit.Body = withUserContent(generated, "")
+		}
+	} else if r.Cfg.OutputFormat != "json" {
+		logging.PrintWarn("the body of the pull request has no sherpa marker, so it is kept as it is")
+	}
+	if edit.Body == pr.Body {
+		edit.Body = ""
+	}
+
+	typeLabel := issue.TypeLabel()
+	for _, label := range pr.Labels {
+		if label.Name != typeLabel && slices.Contains(r.Cfg.TypeLabels, label.Name) {
+			edit.RemoveLabels = append(edit.RemoveLabels, label.Name)
+		}
+	}
+	hasTypeLabel := slices.ContainsFunc(pr.Labels, func(label domain.Label) bool { return label.Name == typeLabel })
+	if typeLabel != "" && !hasTypeLabel {
+		edit.AddLabels = append(edit.AddLabels, typeLabel)
+	}
+
+	if err := r.PullRequestProvider.EditPullRequest(ctx, branch, edit); err != nil {
+		return result, err
+	}
+
+	result.TitleUpdated = edit.Title != ""
+	result.BodyUpdated = edit.Body != ""
+	result.AddedLabels = append(result.AddedLabels, edit.AddLabels...)
+	result.RemovedLabels = append(result.RemovedLabels, edit.RemoveLabels...)
+
+	return result, nil
+}
+
+func (r RefreshPullRequest) printResult(result RefreshPullRequestResult) error {
+	if r.Cfg.OutputFormat == "json" {
+		jsonBytes, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("failed to serialize result: %w", err)
+		}
+		fmt.Println(string(jsonBytes))
+		return nil
+	}
+
+	if !result.TitleUpdated && !result.BodyUpdated && len(result.AddedLabels) == 0 && len(result.RemovedLabels) == 0 {
+		fmt.Fprintf(logging.Output(), "The pull request %s is up to date\n", logging.PaintInfo(result.PRURL))
+		return nil
+	}
+
+	fmt.Fprintf(logging.Output(), "The pull request %s has been refreshed\n", logging.PaintInfo(result.PRURL))
+	if result.TitleUpdated {
+		fmt.Fprintf(logging.Output(), "  title: %s\n", result.Title)
+	}
+	if result.BodyUpdated {
+		fmt.Fprintln(logging.Output(), "  body: updated")
+	}
+	if len(result.AddedLabels) > 0 {
+		fmt.Fprintf(logging.Output(), "  added labels: %s\n", strings.Join(result.AddedLabels, ", "))
+	}
+	if len(result.RemovedLabels) > 0 {
+		fmt.Fprintf(logging.Output(), "  removed labels: %s\n", strings.Join(result.RemovedLabels, ", "))
+	}
+
+	return nil
+}
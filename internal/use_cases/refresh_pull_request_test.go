@@ -0,0 +1,147 @@
+package use_cases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/domain/issue_types"
+	domainFakes "github.com/InditexTech/gh-sherpa/internal/fakes/domain"
+	domainMocks "github.com/InditexTech/gh-sherpa/internal/mocks/domain"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/stretchr/testify/suite"
+)
+
+const refreshBranchName = "feature/GH-1-sample-issue"
+
+type RefreshPullRequestExecutionTestSuite struct {
+	suite.Suite
+	uc                      use_cases.RefreshPullRequest
+	gitProvider             *domainFakes.FakeGitProvider
+	pullRequestProvider     *domainFakes.FakePullRequestProvider
+	userInteractionProvider *domainMocks.MockUserInteractionProvider
+}
+
+func TestRefreshPullRequestExecutionTestSuite(t *testing.T) {
+	suite.Run(t, new(RefreshPullRequestExecutionTestSuite))
+}
+
+func (s *RefreshPullRequestExecutionTestSuite) SetupSubTest() {
+	s.gitProvider = domainFakes.NewFakeGitProvider()
+	s.gitProvider.AddLocalBranches(refreshBranchName)
+	s.gitProvider.CurrentBranch = refreshBranchName
+
+	issueTrackerProvider := domainFakes.NewFakeIssueTrackerProvider()
+	issue := domainFakes.NewFakeIssue("1", issue_types.Bug, domain.IssueTrackerTypeGithub)
+	issue.SetTitle("new title")
+	issueTrackerProvider.AddIssue(issue)
+
+	s.pullRequestProvider = domainFakes.NewFakePullRequestProvider()
+	s.pullRequestProvider.AddPullRequest(refreshBranchName, domain.PullRequest{
+		Title:  "old title",
+		Url:    "https://github.com/inditextech/gh-sherpa-test-repo/pulls/1",
+		Body:   "Related to #1\n\n" + use_cases.PullRequestBodyMarker + "\n\nMy notes",
+		Labels: []domain.Label{{Name: "kind/feature"}, {Name: "priority/high"}},
+	})
+
+	s.userInteractionProvider = &domainMocks.MockUserInteractionProvider{}
+
+	s.uc = use_cases.RefreshPullRequest{
+		Cfg: use_cases.RefreshPullRequestConfiguration{
+			CloseIssue:   true,
+			TypeLabels:   []string{"kind/bug", "kind/feature"},
+			OutputFormat: "json",
+		},
+		Git:                     s.gitProvider,
+		IssueTrackerProvider:    issueTrackerProvider,
+		UserInteractionProvider: s.userInteractionProvider,
+		PullRequestProvider:     s.pullRequestProvider,
+	}
+}
+
+func (s *RefreshPullRequestExecutionTestSuite) TestRefreshPullRequestExecution() {
+	s.Run("should error if there is no open pull request", func() {
+		s.pullRequestProvider.PullRequests[refreshBranchName].Closed = true
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, "there is no open pull request for the branch feature/GH-1-sample-issue")
+	})
+
+	s.Run("should error if the branch has no issue", func() {
+		s.uc.Cfg.BranchName = "no-issue-branch"
+		s.pullRequestProvider.AddPullRequest("no-issue-branch", domain.PullRequest{})
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, "could not find an issue identifier")
+	})
+
+	s.Run("should update the title, body and type labels keeping the content below the marker", func() {
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal(use_cases.RefreshPullRequestResult{
+			BranchName:    refreshBranchName,
+			PRURL:         "https://github.com/inditextech/gh-sherpa-test-repo/pulls/1",
+			Title:         "new title",
+			TitleUpdated:  true,
+			BodyUpdated:   true,
+			AddedLabels:   []string{"kind/bug"},
+			RemovedLabels: []string{"kind/feature"},
+		}, result)
+
+		pr := s.pullRequestProvider.PullRequests[refreshBranchName]
+		s.Equal("new title", pr.Title)
+		s.Equal("Closes #1\n\n"+use_cases.PullRequestBodyMarker+"\n\nMy notes", pr.Body)
+		s.Equal([]domain.Label{{Name: "priority/high"}, {Id: "kind/bug", Name: "kind/bug"}}, pr.Labels)
+	})
+
+	s.Run("should not change anything if the pull request is up to date", func() {
+		pr := s.pullRequestProvider.PullRequests[refreshBranchName]
+		pr.Title = "new title"
+		pr.Body = "Closes #1\n\n" + use_cases.PullRequestBodyMarker + "\n\nMy notes"
+		pr.Labels = []domain.Label{{Name: "kind/bug"}}
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.False(result.TitleUpdated)
+		s.False(result.BodyUpdated)
+		s.Empty(result.AddedLabels)
+		s.Empty(result.RemovedLabels)
+	})
+
+	s.Run("should keep the body given with the pr-body flag when the pull request was created", func() {
+		s.pullRequestProvider.PullRequests[refreshBranchName].Body = use_cases.PullRequestBodyMarker + "\nMy description"
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(result.BodyUpdated)
+		s.Equal("Closes #1\n\n"+use_cases.PullRequestBodyMarker+"\nMy description", s.pullRequestProvider.PullRequests[refreshBranchName].Body)
+	})
+
+	s.Run("should keep the body without marker in non-interactive mode", func() {
+		s.pullRequestProvider.PullRequests[refreshBranchName].Body = "Written by hand"
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.False(result.BodyUpdated)
+		s.Equal("Written by hand", s.pullRequestProvider.PullRequests[refreshBranchName].Body)
+	})
+
+	s.Run("should replace the body without marker if the user confirms it", func() {
+		s.uc.Cfg.OutputFormat = ""
+		s.uc.Cfg.IsInteractive = true
+		s.pullRequestProvider.PullRequests[refreshBranchName].Body = "Written by hand"
+		s.userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to replace the whole body of the pull request?", false).Return(true, nil).Once()
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(result.BodyUpdated)
+		s.Equal("Closes #1\n\n"+use_cases.PullRequestBodyMarker, s.pullRequestProvider.PullRequests[refreshBranchName].Body)
+	})
+}
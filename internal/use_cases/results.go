@@ -0,0 +1,50 @@
+package use_cases
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+)
+
+// ForkResult holds the fork set up with --fork for the create-branch and create-pr commands
+type ForkResult struct {
+	Name     string `json:"name"`
+	Upstream string `json:"upstream,omitempty"`
+	Created  bool   `json:"created"`
+}
+
+// newResultIssue returns the issue information reported in the result of a use case
+func newResultIssue(issue domain.Issue) *StatusIssue {
+	resultIssue := newStatusIssue(issue)
+	return &resultIssue
+}
+
+// pullRequestNumber returns the number of the pull request with the given URL, or 0 if the URL has no number
+func pullRequestNumber(url string) int64 {
+	number, err := strconv.ParseInt(url[strings.LastIndex(url, "/")+1:], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return number
+}
+
+// labelNames returns the names of the labels
+func labelNames(labels []domain.Label) []string {
+	names := make([]string, 0, len(labels))
+	for _, label := range labels {
+		names = append(names, label.Name)
+	}
+
+	return names
+}
+
+// orEmpty returns the values, or an empty list if they are nil, so they are not null in the JSON results
+func orEmpty(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
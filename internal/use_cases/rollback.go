@@ -0,0 +1,109 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+)
+
+// ErrRollback is returned when some changes could not be undone after a failure
+func ErrRollback(cause error, rollbackErr error) error {
+	return fmt.Errorf("%w\n\nThe changes could not be fully undone: %s", cause, rollbackErr)
+}
+
+// rollbackStep is a change done in the repository and the way to undo it
+type rollbackStep struct {
+	description string
+	undo        func(ctx context.Context) error
+}
+
+// rollback records the changes done in the repository so they can be undone, in reverse order,
+// if the operation fails before finishing
+type rollback struct {
+	steps []rollbackStep
+}
+
+func (r *rollback) record(description string, undo func(ctx context.Context) error) {
+	r.steps = append(r.steps, rollbackStep{description: description, undo: undo})
+}
+
+// forget drops the recorded changes, once they must be kept even if the operation fails later
+func (r *rollback) forget() {
+	r.steps = nil
+}
+
+func (r *rollback) empty() bool {
+	return len(r.steps) == 0
+}
+
+// descriptions returns the descriptions of the steps in the order they are undone
+func (r *rollback) descriptions() []string {
+	descriptions := make([]string, 0, len(r.steps))
+	for i := len(r.steps) - 1; i >= 0; i-- {
+		descriptions = append(descriptions, r.steps[i].description)
+	}
+	return descriptions
+}
+
+// run undoes the recorded steps in reverse order. It goes on when a step fails so as much as
+// possible is undone, and returns all the errors.
+func (r *rollback) run(ctx context.Context) error {
+	errs := []error{}
+	for i := len(r.steps) - 1; i >= 0; i-- {
+		step := r.steps[i]
+		logging.Debugf("Rolling back: %s", step.description)
+		if err := step.undo(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("could not %s: %w", step.description, err))
+		}
+	}
+	r.steps = nil
+
+	return errors.Join(errs...)
+}
+
+// rollbackOptions set how the changes are undone after a failure
+type rollbackOptions struct {
+	auto          bool // undo the changes without asking
+	isInteractive bool // ask whether to undo the changes
+	quiet         bool // do not print anything, e.g. with JSON output
+}
+
+// rollbackOnFailure undoes the recorded changes after the operation failed with cause. They are
+// undone without asking if it is automatic, after confirming it in interactive mode, and otherwise
+// they are only reported. The changes are undone even if the context of the operation has been
+// cancelled or has timed out, as it may be the cause of the failure.
+func rollbackOnFailure(ctx context.Context, r *rollback, cause error, opts rollbackOptions, userInteraction domain.UserInteractionProvider) error {
+	if r.empty() {
+		return cause
+	}
+
+	undo := opts.auto
+	if !undo && opts.isInteractive {
+		fmt.Fprintf(logging.Output(), "\nThe operation failed after making these changes:\n  - %s\n", strings.Join(r.descriptions(), "\n  - "))
+		confirmed, err := userInteraction.AskUserForConfirmation("Do you want to undo them", true)
+		if err != nil {
+			return cause
+		}
+		undo = confirmed
+	}
+
+	if !undo {
+		if !opts.quiet {
+			logging.PrintWarn(fmt.Sprintf("the operation failed, these changes were kept: %s", strings.Join(r.descriptions(), ", ")))
+		}
+		return cause
+	}
+
+	if err := r.run(context.WithoutCancel(ctx)); err != nil {
+		return ErrRollback(cause, err)
+	}
+
+	if !opts.quiet {
+		logging.PrintInfo("The changes were undone")
+	}
+	return cause
+}
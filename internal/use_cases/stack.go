@@ -0,0 +1,327 @@
+package use_cases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+)
+
+// stackParentConfigKey is the key of the git branch configuration that stores the parent of a stacked branch
+const stackParentConfigKey = "sherpa-parent"
+
+// ErrStackParentNotPushed is returned when a branch is stacked on a branch that does not exist in the remote
+func ErrStackParentNotPushed(branch string) error {
+	return fmt.Errorf("the branch %s must be pushed before stacking a pull request on it", branch)
+}
+
+// ErrStackDirtyWorkingTree is returned when the stack cannot be synced because of the uncommitted changes
+var ErrStackDirtyWorkingTree = domain.NewError(domain.ErrorCodeDirtyWorkingTree,
+	errors.New("the working tree has uncommitted changes that prevent rebasing the stacked branches, commit or stash them before syncing the stack"))
+
+// ErrStackRebase is returned when a stacked branch could not be rebased onto its new parent
+func ErrStackRebase(branch string, newBase string, upstream string, newParent string, err error) error {
+	return fmt.Errorf("could not rebase the branch %s onto %s: %w\n\nResolve it running `git rebase --onto %s %s %s` and `git config branch.%s.%s %s`, then run the sync again",
+		branch, newBase, err, newBase, upstream, branch, branch, stackParentConfigKey, newParent)
+}
+
+// StackSyncBranch is a stacked branch processed by the StackSync use case
+type StackSyncBranch struct {
+	BranchName     string `json:"branch"`
+	Parent         string `json:"parent"`
+	PreviousParent string `json:"previous_parent,omitempty"`
+	Rebased        bool   `json:"rebased"`
+	Pushed         bool   `json:"pushed"`
+	Retargeted     bool   `json:"retargeted"`
+}
+
+// StackSyncResult holds the outcome of a successful StackSync execution.
+type StackSyncResult struct {
+	Branches []StackSyncBranch `json:"branches"`
+}
+
+// StackSyncConfiguration contains the arguments for the StackSync use case
+type StackSyncConfiguration struct {
+	FetchFromOrigin bool
+	NoPush          bool   // --no-push: do not push the rebased branches
+	OutputFormat    string // --output: "" (default) or "json"
+}
+
+// StackSync rebases the stacked branches whose parent has been merged or rebased,
+// and retargets their pull requests to the new parent
+type StackSync struct {
+	Cfg                 StackSyncConfiguration
+	Git                 domain.GitProvider
+	RepositoryProvider  domain.RepositoryProvider
+	PullRequestProvider domain.PullRequestProvider
+}
+
+// Execute executes the stack sync use case
+func (s StackSync) Execute(ctx context.Context) (result StackSyncResult, err error) {
+	result.Branches = []StackSyncBranch{}
+
+	repo, err := s.RepositoryProvider.GetRepository(ctx)
+	if err != nil {
+		return result, err
+	}
+	trunk := repo.DefaultBranchRef
+
+	currentBranch, err := s.Git.GetCurrentBranch(ctx)
+	if err != nil {
+		return result, fmt.Errorf("could not get the current branch name because %w", err)
+	}
+
+	parents, err := s.stackParents(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	if len(parents) == 0 {
+		return result, s.printResult(result)
+	}
+
+	// The sync switches to the stacked branches to rebase them, which the changes would block or follow
+	dirty, err := s.Git.HasUncommittedChanges(ctx)
+	if err != nil {
+		return result, err
+	}
+	if dirty {
+		return result, ErrStackDirtyWorkingTree
+	}
+
+	if s.Cfg.FetchFromOrigin {
+		if err := s.Git.FetchBranchFromOrigin(ctx, trunk); err != nil {
+			return result, ErrFetchBranch(trunk, err)
+		}
+	}
+
+	ordered := stackOrder(parents)
+
+	remotes := s.Git.GetRemotes(ctx)
+
+	// The commits of the branches before rebasing them are the upstream of their children
+	previousCommits := map[string]string{}
+	for _, branch := range ordered {
+		for _, b := range []string{branch, parents[branch]} {
+			if _, ok := previousCommits[b]; ok || b == trunk {
+				continue
+			}
+			if sha, err := s.Git.ResolveRef(ctx, b); err == nil {
+				previousCommits[b] = sha
+			} else if sha, err := s.Git.ResolveRef(ctx, remotes.Push+"/"+b); err == nil {
+				previousCommits[b] = sha
+			}
+		}
+	}
+
+	merged := map[string]bool{}
+	isMerged := func(branch string) (bool, error) {
+		if value, ok := merged[branch]; ok {
+			return value, nil
+		}
+		pr, err := s.PullRequestProvider.GetPullRequestForBranch(ctx, branch)
+		if err != nil {
+			return false, err
+		}
+		merged[branch] = pr != nil && pr.State == pullRequestStateMerged
+		return merged[branch], nil
+	}
+
+	defer func() {
+		if checkoutErr := s.Git.CheckoutBranch(ctx, currentBranch); checkoutErr != nil && err == nil {
+			err = checkoutErr
+		}
+	}()
+
+	rebased := map[string]bool{}
+	for _, branch := range ordered {
+		// Merged branches are left as they are, they can be deleted with the cleanup command
+		isBranchMerged, err := isMerged(branch)
+		if err != nil {
+			return result, err
+		}
+		if isBranchMerged {
+			continue
+		}
+
+		parent := parents[branch]
+
+		// Stack the branch on the first ancestor that has not been merged yet
+		newParent := parent
+		for newParent != trunk {
+			isParentMerged, err := isMerged(newParent)
+			if err != nil {
+				return result, err
+			}
+			if !isParentMerged {
+				break
+			}
+			newParent = parents[newParent]
+			if newParent == "" {
+				newParent = trunk
+			}
+		}
+
+		item := StackSyncBranch{BranchName: branch, Parent: newParent}
+		if newParent != parent {
+			item.PreviousParent = parent
+		}
+
+		if newParent != parent || rebased[newParent] {
+			upstream, ok := previousCommits[parent]
+			if !ok {
+				return result, fmt.Errorf("could not find the commit of the parent branch %s of %s", parent, branch)
+			}
+
+			newBase := newParent
+			if newParent == trunk {
+				newBase = remotes.Base + "/" + trunk
+			}
+
+			if s.Cfg.OutputFormat != "json" {
+				logging.PrintInfo(fmt.Sprintf("Rebasing %s onto %s", logging.PaintInfo(branch), logging.PaintInfo(newBase)))
+			}
+			if err := s.Git.RebaseOnto(ctx, branch, newBase, upstream); err != nil {
+				return result, ErrStackRebase(branch, newBase, upstream, newParent, err)
+			}
+			item.Rebased, rebased[branch] = true, true
+
+			if newParent != parent {
+				if err := s.Git.SetBranchConfig(ctx, branch, stackParentConfigKey, newParent); err != nil {
+					return result, err
+				}
+				parents[branch] = newParent
+			}
+
+			if !s.Cfg.NoPush && s.Git.RemoteBranchExists(ctx, branch) {
+				if err := s.Git.ForcePushBranch(ctx, branch); err != nil {
+					return result, ErrPushChanges(branch, err)
+				}
+				item.Pushed = true
+			}
+		}
+
+		if item.Retargeted, err = s.retarget(ctx, branch, newParent); err != nil {
+			return result, err
+		}
+
+		result.Branches = append(result.Branches, item)
+	}
+
+	return result, s.printResult(result)
+}
+
+// stackParents returns the parent of each local branch that has been stacked on another one
+func (s StackSync) stackParents(ctx context.Context) (map[string]string, error) {
+	branches, err := s.Git.ListBranches(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parents := map[string]string{}
+	for _, branch := range branches {
+		if !branch.Local {
+			continue
+		}
+		parent, err := s.Git.GetBranchConfig(ctx, branch.Name, stackParentConfigKey)
+		if err != nil {
+			return nil, err
+		}
+		if parent != "" {
+			parents[branch.Name] = parent
+		}
+	}
+
+	return parents, nil
+}
+
+// retarget changes the base of the open pull request of the branch if it is not the parent
+func (s StackSync) retarget(ctx context.Context, branch string, parent string) (bool, error) {
+	pr, err := s.PullRequestProvider.GetPullRequestForBranch(ctx, branch)
+	if err != nil {
+		return false, err
+	}
+
+	if pr == nil || pr.Closed || pr.BaseRefName == parent {
+		return false, nil
+	}
+
+	if err := s.PullRequestProvider.UpdatePullRequestBase(ctx, branch, parent); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// stackOrder sorts the stacked branches so every branch comes after its parent
+func stackOrder(parents map[string]string) []string {
+	depth := func(branch string) int {
+		d := 0
+		// The number of branches limits the depth in case there is a cycle
+		for parent, ok := parents[branch]; ok && d < len(parents); parent, ok = parents[parent] {
+			d++
+		}
+		return d
+	}
+
+	ordered := make([]string, 0, len(parents))
+	for branch := range parents {
+		ordered = append(ordered, branch)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		di, dj := depth(ordered[i]), depth(ordered[j])
+		if di != dj {
+			return di < dj
+		}
+		return ordered[i] < ordered[j]
+	})
+
+	return ordered
+}
+
+func (s StackSync) printResult(result StackSyncResult) error {
+	if s.Cfg.OutputFormat == "json" {
+		jsonBytes, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("failed to serialize result: %w", err)
+		}
+		fmt.Println(string(jsonBytes))
+		return nil
+	}
+
+	if len(result.Branches) == 0 {
+		fmt.Fprintln(logging.Output(), "There are no stacked branches")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(logging.Output(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "BRANCH\tPARENT\tCHANGES")
+	for _, branch := range result.Branches {
+		changes := []string{}
+		if branch.PreviousParent != "" {
+			changes = append(changes, fmt.Sprintf("%s merged", branch.PreviousParent))
+		}
+		if branch.Rebased {
+			changes = append(changes, "rebased")
+		}
+		if branch.Pushed {
+			changes = append(changes, "pushed")
+		}
+		if branch.Retargeted {
+			changes = append(changes, "pull request retargeted")
+		}
+		if len(changes) == 0 {
+			changes = append(changes, "up to date")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", branch.BranchName, branch.Parent, strings.Join(changes, ", "))
+	}
+	w.Flush()
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package use_cases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	domainFakes "github.com/InditexTech/gh-sherpa/internal/fakes/domain"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	stackFirstBranch  = "feature/GH-1-first"
+	stackSecondBranch = "feature/GH-2-second"
+	stackThirdBranch  = "feature/GH-3-third"
+)
+
+type StackSyncExecutionTestSuite struct {
+	suite.Suite
+	uc                  use_cases.StackSync
+	gitProvider         *domainFakes.FakeGitProvider
+	pullRequestProvider *domainFakes.FakePullRequestProvider
+}
+
+func TestStackSyncExecutionTestSuite(t *testing.T) {
+	suite.Run(t, new(StackSyncExecutionTestSuite))
+}
+
+func (s *StackSyncExecutionTestSuite) SetupSubTest() {
+	// main <- first <- second <- third
+	s.gitProvider = domainFakes.NewFakeGitProvider()
+	s.gitProvider.AddLocalBranches(stackFirstBranch, stackSecondBranch, stackThirdBranch)
+	s.gitProvider.AddRemoteBranches(stackFirstBranch, stackSecondBranch, stackThirdBranch)
+	s.gitProvider.BranchConfig[stackFirstBranch+".sherpa-parent"] = "main"
+	s.gitProvider.BranchConfig[stackSecondBranch+".sherpa-parent"] = stackFirstBranch
+	s.gitProvider.BranchConfig[stackThirdBranch+".sherpa-parent"] = stackSecondBranch
+	s.gitProvider.CurrentBranch = stackThirdBranch
+
+	s.pullRequestProvider = domainFakes.NewFakePullRequestProvider()
+	s.pullRequestProvider.AddPullRequest(stackFirstBranch, domain.PullRequest{State: "OPEN", BaseRefName: "main"})
+	s.pullRequestProvider.AddPullRequest(stackSecondBranch, domain.PullRequest{State: "OPEN", BaseRefName: stackFirstBranch})
+	s.pullRequestProvider.AddPullRequest(stackThirdBranch, domain.PullRequest{State: "OPEN", BaseRefName: stackSecondBranch})
+
+	s.uc = use_cases.StackSync{
+		Cfg:                 use_cases.StackSyncConfiguration{FetchFromOrigin: true, OutputFormat: "json"},
+		Git:                 s.gitProvider,
+		RepositoryProvider:  domainFakes.NewRepositoryProvider(),
+		PullRequestProvider: s.pullRequestProvider,
+	}
+}
+
+func (s *StackSyncExecutionTestSuite) TestStackSyncExecution() {
+	s.Run("should do nothing if there are no stacked branches", func() {
+		s.gitProvider.BranchConfig = map[string]string{}
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Empty(result.Branches)
+		s.Empty(s.gitProvider.Rebases)
+	})
+
+	s.Run("should do nothing if no parent has been merged", func() {
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal([]use_cases.StackSyncBranch{
+			{BranchName: stackFirstBranch, Parent: "main"},
+			{BranchName: stackSecondBranch, Parent: stackFirstBranch},
+			{BranchName: stackThirdBranch, Parent: stackSecondBranch},
+		}, result.Branches)
+		s.Empty(s.gitProvider.Rebases)
+	})
+
+	s.Run("should rebase the chain and retarget the pull request after the parent merges", func() {
+		s.pullRequestProvider.PullRequests[stackFirstBranch].State = "MERGED"
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal([]use_cases.StackSyncBranch{
+			{BranchName: stackSecondBranch, Parent: "main", PreviousParent: stackFirstBranch, Rebased: true, Pushed: true, Retargeted: true},
+			{BranchName: stackThirdBranch, Parent: stackSecondBranch, Rebased: true, Pushed: true},
+		}, result.Branches)
+		s.Equal([]string{
+			"feature/GH-2-second onto origin/main from sha-feature/GH-1-first",
+			"feature/GH-3-third onto feature/GH-2-second from sha-feature/GH-2-second",
+		}, s.gitProvider.Rebases)
+		s.Equal("main", s.gitProvider.BranchConfig[stackSecondBranch+".sherpa-parent"])
+		s.Equal("main", s.pullRequestProvider.PullRequests[stackSecondBranch].BaseRefName)
+		s.Equal([]string{stackSecondBranch, stackThirdBranch}, s.gitProvider.ForcePushed)
+		s.Equal(stackThirdBranch, s.gitProvider.CurrentBranch)
+	})
+
+	s.Run("should skip all the merged ancestors", func() {
+		s.pullRequestProvider.PullRequests[stackFirstBranch].State = "MERGED"
+		s.pullRequestProvider.PullRequests[stackSecondBranch].State = "MERGED"
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal([]use_cases.StackSyncBranch{
+			{BranchName: stackThirdBranch, Parent: "main", PreviousParent: stackSecondBranch, Rebased: true, Pushed: true, Retargeted: true},
+		}, result.Branches)
+		s.Equal([]string{"feature/GH-3-third onto origin/main from sha-feature/GH-2-second"}, s.gitProvider.Rebases)
+	})
+
+	s.Run("should not push the rebased branches with the no push flag", func() {
+		s.pullRequestProvider.PullRequests[stackFirstBranch].State = "MERGED"
+		s.uc.Cfg.NoPush = true
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Len(s.gitProvider.Rebases, 2)
+		s.Empty(s.gitProvider.ForcePushed)
+	})
+
+	s.Run("should not rebase anything if the working tree has uncommitted changes", func() {
+		s.pullRequestProvider.PullRequests[stackFirstBranch].State = "MERGED"
+		s.gitProvider.UncommittedChanges = true
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorIs(err, use_cases.ErrStackDirtyWorkingTree)
+		s.Equal(domain.ErrorCodeDirtyWorkingTree, domain.ErrorCodeOf(err))
+		s.Empty(s.gitProvider.Rebases)
+		s.Equal(stackThirdBranch, s.gitProvider.CurrentBranch)
+	})
+
+	s.Run("should stop and keep the parent if the rebase fails", func() {
+		s.pullRequestProvider.PullRequests[stackFirstBranch].State = "MERGED"
+		s.gitProvider.BranchWithRebaseError = []string{stackSecondBranch}
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorIs(err, domainFakes.ErrRebase)
+		s.Equal(stackFirstBranch, s.gitProvider.BranchConfig[stackSecondBranch+".sherpa-parent"])
+		s.Equal(stackFirstBranch, s.pullRequestProvider.PullRequests[stackSecondBranch].BaseRefName)
+		s.Equal(stackThirdBranch, s.gitProvider.CurrentBranch)
+	})
+}
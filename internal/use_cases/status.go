@@ -0,0 +1,201 @@
+package use_cases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/InditexTech/gh-sherpa/internal/branches"
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+)
+
+// StatusIssue holds the issue information of the current branch
+type StatusIssue struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Type    string `json:"type"`
+	Tracker string `json:"tracker"`
+	State   string `json:"state"`
+	Closed  bool   `json:"closed"`
+	URL     string `json:"url"`
+}
+
+// StatusPullRequest holds the pull request information of the current branch
+type StatusPullRequest struct {
+	Number         int64  `json:"number"`
+	URL            string `json:"url"`
+	State          string `json:"state"`
+	Draft          bool   `json:"draft"`
+	Checks         string `json:"checks"`
+	ReviewDecision string `json:"review_decision"`
+	// headCommit is the last commit of the head branch of the pull request
+	headCommit string
+}
+
+// StatusResult holds the outcome of a successful Status execution.
+type StatusResult struct {
+	BranchName      string             `json:"branch"`
+	BaseBranch      string             `json:"base"`
+	Issue           StatusIssue        `json:"issue"`
+	PullRequest     *StatusPullRequest `json:"pull_request"`
+	UnpushedCommits []string           `json:"unpushed_commits"`
+	Ahead           int                `json:"ahead"`
+	Behind          int                `json:"behind"`
+}
+
+// StatusConfiguration contains the arguments for the Status use case
+type StatusConfiguration struct {
+	BaseBranch   string // --base: branch to compare with. Use the pull request base or the default branch if not set
+	OutputFormat string // --output: "" (default) or "json"
+}
+
+type Status struct {
+	Cfg                  StatusConfiguration
+	Git                  domain.GitProvider
+	RepositoryProvider   domain.RepositoryProvider
+	IssueTrackerProvider domain.IssueTrackerProvider
+	PullRequestProvider  domain.PullRequestProvider
+}
+
+// Execute executes the status use case
+func (s Status) Execute(ctx context.Context) (result StatusResult, err error) {
+	currentBranch, err := s.Git.GetCurrentBranch(ctx)
+	if err != nil {
+		return result, fmt.Errorf("could not get the current branch name because %w", err)
+	}
+	result.BranchName = currentBranch
+
+	branchNameInfo := branches.ParseBranchName(currentBranch)
+	if branchNameInfo == nil || branchNameInfo.IssueId == "" {
+		return result, fmt.Errorf("could not find an issue identifier in the current branch named %s", logging.PaintWarning(currentBranch))
+	}
+
+	issue, err := s.IssueTrackerProvider.GetIssue(ctx, s.IssueTrackerProvider.ParseIssueId(branchNameInfo.IssueId))
+	if err != nil {
+		return result, err
+	}
+	result.Issue = newStatusIssue(issue)
+
+	pr, err := s.PullRequestProvider.GetPullRequestForBranch(ctx, currentBranch)
+	if err != nil {
+		return result, fmt.Errorf("error while getting pull request for branch: %w", err)
+	}
+	result.PullRequest = newStatusPullRequest(pr)
+
+	result.BaseBranch, err = s.getBaseBranch(ctx, pr)
+	if err != nil {
+		return result, err
+	}
+
+	commits, err := s.Git.GetCommitsToPush(ctx, currentBranch)
+	if err != nil {
+		return result, err
+	}
+	result.UnpushedCommits = make([]string, len(commits))
+	for i, commit := range commits {
+		result.UnpushedCommits[i] = strings.Trim(commit, "'")
+	}
+
+	result.Ahead, result.Behind, err = s.Git.GetAheadBehind(ctx, currentBranch, result.BaseBranch)
+	if err != nil {
+		// The base branch may not have been fetched yet, so this is not a blocking error
+		logging.Debugf("could not get ahead/behind counts: %s", err)
+	}
+
+	if s.Cfg.OutputFormat == "json" {
+		jsonBytes, jsonErr := json.Marshal(result)
+		if jsonErr != nil {
+			return result, fmt.Errorf("failed to serialize result: %w", jsonErr)
+		}
+		fmt.Println(string(jsonBytes))
+	} else {
+		printStatus(result)
+	}
+
+	return result, nil
+}
+
+func newStatusIssue(issue domain.Issue) StatusIssue {
+	return StatusIssue{
+		ID:      issue.FormatID(),
+		Title:   issue.Title(),
+		Type:    issue.Type().String(),
+		Tracker: issue.TrackerType().String(),
+		State:   issue.State(),
+		Closed:  issue.IsClosed(),
+		URL:     issue.URL(),
+	}
+}
+
+func newStatusPullRequest(pr *domain.PullRequest) *StatusPullRequest {
+	if pr == nil {
+		return nil
+	}
+
+	return &StatusPullRequest{
+		Number:         pr.Number,
+		URL:            pr.Url,
+		State:          pr.State,
+		Draft:          pr.IsDraft,
+		Checks:         string(pr.ChecksStatus),
+		ReviewDecision: pr.ReviewDecision,
+		headCommit:     pr.HeadRefOid,
+	}
+}
+
+func (s Status) getBaseBranch(ctx context.Context, pr *domain.PullRequest) (string, error) {
+	if s.Cfg.BaseBranch != "" {
+		return s.Cfg.BaseBranch, nil
+	}
+
+	if pr != nil && pr.BaseRefName != "" {
+		return pr.BaseRefName, nil
+	}
+
+	repo, err := s.RepositoryProvider.GetRepository(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return repo.DefaultBranchRef, nil
+}
+
+func printStatus(result StatusResult) {
+	fmt.Fprintf(logging.Output(), "Branch: %s (%d ahead, %d behind %s)\n",
+		logging.PaintInfo(result.BranchName), result.Ahead, result.Behind, logging.PaintInfo(result.BaseBranch))
+
+	fmt.Fprintf(logging.Output(), "Issue:  %s %s\n", logging.PaintInfo(result.Issue.ID), result.Issue.Title)
+	fmt.Fprintf(logging.Output(), "        %s, %s\n", valueOrNone(result.Issue.State), result.Issue.URL)
+
+	if result.PullRequest == nil {
+		fmt.Fprintln(logging.Output(), "Pull request: none")
+	} else {
+		pr := result.PullRequest
+		state := pr.State
+		if pr.Draft {
+			state += " (draft)"
+		}
+		fmt.Fprintf(logging.Output(), "Pull request: #%d %s\n", pr.Number, pr.URL)
+		fmt.Fprintf(logging.Output(), "        %s, checks: %s, review: %s\n", state, valueOrNone(pr.Checks), valueOrNone(pr.ReviewDecision))
+	}
+
+	if len(result.UnpushedCommits) == 0 {
+		fmt.Fprintln(logging.Output(), "Unpushed commits: none")
+		return
+	}
+
+	fmt.Fprintf(logging.Output(), "Unpushed commits: %d\n", len(result.UnpushedCommits))
+	for _, commit := range result.UnpushedCommits {
+		fmt.Fprintf(logging.Output(), "        %s\n", commit)
+	}
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return logging.PaintHint("none")
+	}
+
+	return value
+}
@@ -0,0 +1,103 @@
+package use_cases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/domain/issue_types"
+	domainFakes "github.com/InditexTech/gh-sherpa/internal/fakes/domain"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusExecutionTestSuite struct {
+	suite.Suite
+	defaultBranchName    string
+	uc                   use_cases.Status
+	gitProvider          *domainFakes.FakeGitProvider
+	issueTrackerProvider *domainFakes.FakeIssueTrackerProvider
+	pullRequestProvider  *domainFakes.FakePullRequestProvider
+	repositoryProvider   *domainFakes.FakeRepositoryProvider
+}
+
+func TestStatusExecutionTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusExecutionTestSuite))
+}
+
+func (s *StatusExecutionTestSuite) SetupSuite() {
+	s.defaultBranchName = "feature/GH-1-sample-issue"
+}
+
+func (s *StatusExecutionTestSuite) SetupSubTest() {
+	s.gitProvider = domainFakes.NewFakeGitProvider()
+	s.gitProvider.AddLocalBranches(s.defaultBranchName)
+	s.gitProvider.CurrentBranch = s.defaultBranchName
+
+	s.issueTrackerProvider = domainFakes.NewFakeIssueTrackerProvider()
+	s.issueTrackerProvider.AddIssue(domainFakes.NewFakeIssue("1", issue_types.Feature, domain.IssueTrackerTypeGithub))
+
+	s.pullRequestProvider = domainFakes.NewFakePullRequestProvider()
+	s.repositoryProvider = domainFakes.NewRepositoryProvider()
+
+	s.uc = use_cases.Status{
+		Cfg:                  use_cases.StatusConfiguration{OutputFormat: "json"},
+		Git:                  s.gitProvider,
+		RepositoryProvider:   s.repositoryProvider,
+		IssueTrackerProvider: s.issueTrackerProvider,
+		PullRequestProvider:  s.pullRequestProvider,
+	}
+}
+
+func (s *StatusExecutionTestSuite) TestStatusExecution() {
+	s.Run("should error if the current branch has no issue", func() {
+		s.gitProvider.CurrentBranch = "main"
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, "could not find an issue identifier in the current branch")
+	})
+
+	s.Run("should error if the issue could not be found", func() {
+		s.gitProvider.CurrentBranch = "feature/GH-2-unknown-issue"
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorIs(err, domainFakes.ErrNoIssue)
+	})
+
+	s.Run("should return status without pull request", func() {
+		s.gitProvider.CommitsToPush[s.defaultBranchName] = []string{"'abc123 first commit'"}
+		s.gitProvider.AheadBehind[s.defaultBranchName] = [2]int{1, 2}
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal("GH-1", result.Issue.ID)
+		s.Equal("main", result.BaseBranch)
+		s.Nil(result.PullRequest)
+		s.Equal([]string{"abc123 first commit"}, result.UnpushedCommits)
+		s.Equal(1, result.Ahead)
+		s.Equal(2, result.Behind)
+	})
+
+	s.Run("should return status with pull request and use its base branch", func() {
+		s.pullRequestProvider.AddPullRequest(s.defaultBranchName, domain.PullRequest{
+			Number:         5,
+			State:          "OPEN",
+			IsDraft:        true,
+			BaseRefName:    "develop",
+			ChecksStatus:   domain.ChecksStatusSuccess,
+			ReviewDecision: "APPROVED",
+		})
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal("develop", result.BaseBranch)
+		s.Require().NotNil(result.PullRequest)
+		s.True(result.PullRequest.Draft)
+		s.Equal("SUCCESS", result.PullRequest.Checks)
+		s.Equal("APPROVED", result.PullRequest.ReviewDecision)
+	})
+}
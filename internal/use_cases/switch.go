@@ -0,0 +1,204 @@
+package use_cases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+)
+
+// switchStashPrefix identifies the stashes saved by the switch use case
+const switchStashPrefix = "sherpa-switch: "
+
+// ErrNoBranchForIssue is returned when there is no branch for the issue and it has not been created
+func ErrNoBranchForIssue(issueID string) error {
+	return fmt.Errorf("there is no branch for the issue %s", issueID)
+}
+
+// SwitchResult holds the outcome of a successful Switch execution.
+type SwitchResult struct {
+	BranchName string `json:"branch"`
+	Tracked    bool   `json:"tracked"`
+	Created    bool   `json:"created"`
+	Stashed    bool   `json:"stashed"`
+	Unstashed  bool   `json:"unstashed"`
+	// CarriedChanges is set when the uncommitted changes were carried to the branch
+	CarriedChanges bool `json:"carried_changes"`
+}
+
+// SwitchConfiguration contains the arguments for the Switch use case
+type SwitchConfiguration struct {
+	IssueID       string
+	Stash         bool // --stash: stash the changes of the current branch and restore the ones of the target branch
+	CarryChanges  bool // --carry-changes: take the uncommitted changes to the target branch
+	IsInteractive bool
+	OutputFormat  string // --output: "" (default) or "json"
+}
+
+type Switch struct {
+	Cfg                     SwitchConfiguration
+	Git                     domain.GitProvider
+	IssueTrackerProvider    domain.IssueTrackerProvider
+	UserInteractionProvider domain.UserInteractionProvider
+	// CreateBranch is used to create the branch when it does not exist yet
+	CreateBranch CreateBranch
+}
+
+// Execute executes the switch use case
+func (s Switch) Execute(ctx context.Context) (result SwitchResult, err error) {
+	if s.Cfg.IssueID == "" {
+		return result, fmt.Errorf("sherpa needs an valid issue identifier")
+	}
+
+	issue, err := s.IssueTrackerProvider.GetIssue(ctx, s.Cfg.IssueID)
+	if err != nil {
+		return result, err
+	}
+
+	currentBranch, err := s.Git.GetCurrentBranch(ctx)
+	if err != nil {
+		return result, fmt.Errorf("could not get the current branch name because %w", err)
+	}
+
+	issueBranchPattern := fmt.Sprintf("/%s-", issue.FormatID())
+
+	branch, localExists := s.Git.FindBranch(ctx, issueBranchPattern)
+	remoteExists := false
+	if !localExists {
+		if branch, remoteExists, err = s.findRemoteBranch(ctx, issueBranchPattern); err != nil {
+			return result, err
+		}
+	}
+
+	if branch != "" && branch == currentBranch {
+		result.BranchName = branch
+		return result, s.printResult(result, fmt.Sprintf("Already on the branch %s", logging.PaintInfo(branch)))
+	}
+
+	if !localExists && !remoteExists && s.Cfg.OutputFormat != "json" {
+		// The confirmation to create it is asked by the create branch use case
+		logging.PrintWarn(fmt.Sprintf("there is no branch for the issue %s", logging.PaintInfo(issue.FormatID())))
+	}
+
+	if s.Cfg.Stash {
+		if result.Stashed, err = s.Git.Stash(ctx, switchStashPrefix+currentBranch); err != nil {
+			return result, err
+		}
+	}
+
+	switch {
+	case localExists:
+		result.CarriedChanges, err = s.carryChanges().checkout(ctx, branch, func() error {
+			return s.Git.CheckoutBranch(ctx, branch)
+		})
+	case remoteExists:
+		result.CarriedChanges, err = s.carryChanges().checkout(ctx, branch, func() error {
+			return s.Git.CheckoutRemoteBranch(ctx, branch)
+		})
+		result.Tracked = true
+	default:
+		branch, result.CarriedChanges, err = s.createBranch(ctx)
+		result.Created = true
+	}
+	if err != nil {
+		return result, s.restoreStash(ctx, result, currentBranch, err)
+	}
+	result.BranchName = branch
+
+	if s.Cfg.Stash {
+		if result.Unstashed, err = s.Git.StashPop(ctx, switchStashPrefix+branch); err != nil {
+			return result, err
+		}
+	}
+
+	message := fmt.Sprintf("Switched to the branch %s", logging.PaintInfo(branch))
+	if result.Stashed {
+		message += fmt.Sprintf("\nThe changes of %s have been stashed", logging.PaintInfo(currentBranch))
+	}
+	if result.Unstashed {
+		message += fmt.Sprintf("\nThe stashed changes of %s have been restored", logging.PaintInfo(branch))
+	}
+	if result.CarriedChanges {
+		message += fmt.Sprintf("\nThe uncommitted changes have been carried to %s", logging.PaintInfo(branch))
+	}
+
+	return result, s.printResult(result, message)
+}
+
+// findRemoteBranch returns the first branch containing the given pattern that only exists in the remote
+func (s Switch) findRemoteBranch(ctx context.Context, pattern string) (branch string, exists bool, err error) {
+	allBranches, err := s.Git.ListBranches(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	for _, b := range allBranches {
+		if b.Remote && !b.Local && strings.Contains(b.Name, pattern) {
+			return b.Name, true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// carryChanges returns the way to take the uncommitted changes to the target branch, there are none left with --stash
+func (s Switch) carryChanges() carryChanges {
+	return carryChanges{
+		git:                     s.Git,
+		userInteractionProvider: s.UserInteractionProvider,
+		enabled:                 s.Cfg.CarryChanges,
+		isInteractive:           s.Cfg.IsInteractive,
+	}
+}
+
+func (s Switch) createBranch(ctx context.Context) (branch string, carried bool, err error) {
+	cb := s.CreateBranch
+	cb.Cfg.IssueID = s.Cfg.IssueID
+	cb.Cfg.IsInteractive = s.Cfg.IsInteractive
+	cb.Cfg.OutputFormat = s.Cfg.OutputFormat
+	cb.Cfg.CarryChanges = s.Cfg.CarryChanges
+	cb.Cfg.quiet = true
+
+	result, err := cb.Execute(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	// The branch is not created if the user does not confirm it
+	if result.BranchName == "" || !s.Git.BranchExists(ctx, result.BranchName) {
+		return "", false, ErrNoBranchForIssue(s.Cfg.IssueID)
+	}
+
+	return result.BranchName, result.CarriedChanges, nil
+}
+
+// restoreStash applies again the changes stashed from the current branch after a failed switch
+func (s Switch) restoreStash(ctx context.Context, result SwitchResult, currentBranch string, switchErr error) error {
+	if !result.Stashed {
+		return switchErr
+	}
+
+	if _, err := s.Git.StashPop(ctx, switchStashPrefix+currentBranch); err != nil {
+		return fmt.Errorf("%w (the stashed changes could not be restored: %s)", switchErr, err)
+	}
+
+	return switchErr
+}
+
+func (s Switch) printResult(result SwitchResult, message string) error {
+	if s.Cfg.OutputFormat != "json" {
+		fmt.Fprintln(logging.Output(), message)
+		return nil
+	}
+
+	jsonBytes, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to serialize result: %w", err)
+	}
+	fmt.Println(string(jsonBytes))
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package use_cases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/domain/issue_types"
+	domainFakes "github.com/InditexTech/gh-sherpa/internal/fakes/domain"
+	domainMocks "github.com/InditexTech/gh-sherpa/internal/mocks/domain"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/stretchr/testify/suite"
+)
+
+type SwitchExecutionTestSuite struct {
+	suite.Suite
+	uc                      use_cases.Switch
+	gitProvider             *domainFakes.FakeGitProvider
+	userInteractionProvider *domainMocks.MockUserInteractionProvider
+	branchProvider          *domainFakes.FakeBranchProvider
+}
+
+func TestSwitchExecutionTestSuite(t *testing.T) {
+	suite.Run(t, new(SwitchExecutionTestSuite))
+}
+
+func (s *SwitchExecutionTestSuite) SetupSubTest() {
+	s.gitProvider = domainFakes.NewFakeGitProvider()
+	s.gitProvider.AddLocalBranches("feature/GH-1-local-issue")
+	s.gitProvider.AddRemoteBranches("feature/GH-2-remote-issue")
+
+	issueTrackerProvider := domainFakes.NewFakeIssueTrackerProvider()
+	for _, id := range []string{"1", "2", "3"} {
+		issueTrackerProvider.AddIssue(domainFakes.NewFakeIssue(id, issue_types.Feature, domain.IssueTrackerTypeGithub))
+	}
+
+	s.userInteractionProvider = &domainMocks.MockUserInteractionProvider{}
+
+	s.branchProvider = domainFakes.NewFakeBranchProvider()
+	s.branchProvider.SetBranchName("feature/GH-3-new-issue")
+
+	s.uc = use_cases.Switch{
+		Cfg:                     use_cases.SwitchConfiguration{OutputFormat: "json"},
+		Git:                     s.gitProvider,
+		IssueTrackerProvider:    issueTrackerProvider,
+		UserInteractionProvider: s.userInteractionProvider,
+		CreateBranch: use_cases.CreateBranch{
+			Git:                     s.gitProvider,
+			RepositoryProvider:      domainFakes.NewRepositoryProvider(),
+			IssueTrackerProvider:    issueTrackerProvider,
+			UserInteractionProvider: s.userInteractionProvider,
+			BranchProvider:          s.branchProvider,
+		},
+	}
+}
+
+func (s *SwitchExecutionTestSuite) TestSwitchExecution() {
+	s.Run("should error if the issue is not found", func() {
+		s.uc.Cfg.IssueID = "4"
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorIs(err, domainFakes.ErrNoIssue)
+	})
+
+	s.Run("should switch to the local branch of the issue", func() {
+		s.uc.Cfg.IssueID = "1"
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal(use_cases.SwitchResult{BranchName: "feature/GH-1-local-issue"}, result)
+		s.Equal("feature/GH-1-local-issue", s.gitProvider.CurrentBranch)
+	})
+
+	s.Run("should do nothing if already on the branch of the issue", func() {
+		s.uc.Cfg.IssueID = "1"
+		s.gitProvider.CurrentBranch = "feature/GH-1-local-issue"
+		s.uc.Cfg.Stash = true
+		s.gitProvider.UncommittedChanges = true
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.False(result.Stashed)
+		s.Empty(s.gitProvider.Stashes)
+	})
+
+	s.Run("should track the remote branch if there is no local branch", func() {
+		s.uc.Cfg.IssueID = "2"
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal(use_cases.SwitchResult{BranchName: "feature/GH-2-remote-issue", Tracked: true}, result)
+		s.True(s.gitProvider.BranchExists(context.Background(), "feature/GH-2-remote-issue"))
+		s.Equal("feature/GH-2-remote-issue", s.gitProvider.CurrentBranch)
+	})
+
+	s.Run("should create the branch if it does not exist", func() {
+		s.uc.Cfg.IssueID = "3"
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal(use_cases.SwitchResult{BranchName: "feature/GH-3-new-issue", Created: true}, result)
+		s.Equal("feature/GH-3-new-issue", s.gitProvider.CurrentBranch)
+	})
+
+	s.Run("should error if the user does not confirm to create the branch", func() {
+		s.uc.Cfg.IssueID = "3"
+		s.uc.Cfg.IsInteractive = true
+		s.userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to continue?", true).Return(false, nil).Once()
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, "there is no branch for the issue 3")
+		s.Equal("main", s.gitProvider.CurrentBranch)
+	})
+
+	s.Run("should stash the changes of the current branch and restore the ones of the target branch", func() {
+		s.uc.Cfg.IssueID = "1"
+		s.uc.Cfg.Stash = true
+		s.gitProvider.UncommittedChanges = true
+		s.gitProvider.Stashes = []string{"sherpa-switch: feature/GH-1-local-issue"}
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(result.Stashed)
+		s.True(result.Unstashed)
+		s.Equal([]string{"sherpa-switch: main"}, s.gitProvider.Stashes)
+	})
+
+	s.Run("should restore the stashed changes if the switch fails", func() {
+		s.uc.Cfg.IssueID = "3"
+		s.uc.Cfg.Stash = true
+		s.gitProvider.UncommittedChanges = true
+		s.branchProvider.SetBranchName("")
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorIs(err, domainFakes.ErrGetBranchName)
+		s.Empty(s.gitProvider.Stashes)
+		s.True(s.gitProvider.UncommittedChanges)
+	})
+
+	s.Run("should carry the uncommitted changes to the target branch", func() {
+		s.uc.Cfg.IssueID = "2"
+		s.uc.Cfg.CarryChanges = true
+		s.gitProvider.UncommittedChanges = true
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal(use_cases.SwitchResult{BranchName: "feature/GH-2-remote-issue", Tracked: true, CarriedChanges: true}, result)
+		s.True(s.gitProvider.UncommittedChanges)
+		s.Empty(s.gitProvider.Stashes)
+	})
+
+	s.Run("should ask to carry the uncommitted changes in interactive mode", func() {
+		s.uc.Cfg.IssueID = "1"
+		s.uc.Cfg.IsInteractive = true
+		s.gitProvider.UncommittedChanges = true
+		s.userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to carry them to the branch feature/GH-1-local-issue", true).Return(true, nil).Once()
+
+		result, err := s.uc.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(result.CarriedChanges)
+		s.userInteractionProvider.AssertExpectations(s.T())
+	})
+
+	s.Run("should error if the uncommitted changes prevent the switch", func() {
+		s.uc.Cfg.IssueID = "1"
+		s.gitProvider.UncommittedChanges = true
+		s.gitProvider.BranchWithCheckoutConflict = []string{"feature/GH-1-local-issue"}
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorIs(err, use_cases.ErrDirtyWorkingTree)
+		s.Equal("main", s.gitProvider.CurrentBranch)
+	})
+
+	s.Run("should keep the stash if the carried changes conflict with the target branch", func() {
+		s.uc.Cfg.IssueID = "1"
+		s.uc.Cfg.CarryChanges = true
+		s.gitProvider.UncommittedChanges = true
+		s.gitProvider.BranchWithStashConflict = []string{"feature/GH-1-local-issue"}
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, "the uncommitted changes carried to the branch feature/GH-1-local-issue conflict with it")
+		s.Equal([]string{"sherpa-carry: main"}, s.gitProvider.Stashes)
+	})
+
+	s.Run("should fail if the stash with the carried changes is not found", func() {
+		s.uc.Cfg.IssueID = "1"
+		s.uc.Cfg.CarryChanges = true
+		s.gitProvider.UncommittedChanges = true
+		s.gitProvider.BranchWithLostStash = []string{"feature/GH-1-local-issue"}
+
+		_, err := s.uc.Execute(context.Background())
+
+		s.ErrorContains(err, "the uncommitted changes could not be carried to the branch feature/GH-1-local-issue because the stash \"sherpa-carry: main\" was not found")
+	})
+}
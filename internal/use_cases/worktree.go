@@ -0,0 +1,244 @@
+package use_cases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/InditexTech/gh-sherpa/internal/branches"
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	"github.com/InditexTech/gh-sherpa/internal/logging"
+)
+
+// DefaultWorktreePath is where the worktrees of the issue branches are created when it is not configured
+const DefaultWorktreePath = "../{{repo}}-{{issue}}"
+
+// ErrNoWorktree is returned when there is no worktree for the issue or path
+func ErrNoWorktree(target string) error {
+	return fmt.Errorf("there is no worktree for %s", target)
+}
+
+// worktreePath returns the path of the worktree of the branch from the given pattern, relative to
+// the root of the repository if it is not absolute
+func worktreePath(ctx context.Context, git domain.GitProvider, pattern string, branch string) (string, error) {
+	if pattern == "" {
+		pattern = DefaultWorktreePath
+	}
+
+	root, err := git.GetRepositoryRoot(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to determine repository root: %w", err)
+	}
+
+	branchSlug := strings.ReplaceAll(branch, "/", "-")
+	issueID := branchSlug
+	if branchNameInfo := branches.ParseBranchName(branch); branchNameInfo != nil && branchNameInfo.IssueId != "" {
+		issueID = branchNameInfo.IssueId
+	}
+
+	path := strings.NewReplacer(
+		"{{repo}}", filepath.Base(root),
+		"{{issue}}", issueID,
+		"{{branch}}", branchSlug,
+	).Replace(pattern)
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(root, path)
+	}
+
+	return filepath.Clean(path), nil
+}
+
+// WorktreeItem holds the information of a worktree of the repository
+type WorktreeItem struct {
+	Path    string `json:"path"`
+	Branch  string `json:"branch"`
+	IssueID string `json:"issue_id,omitempty"`
+	Main    bool   `json:"main"`
+}
+
+// WorktreeListResult holds the outcome of a successful WorktreeList execution.
+type WorktreeListResult struct {
+	Worktrees []WorktreeItem `json:"worktrees"`
+}
+
+// WorktreeListConfiguration contains the arguments for the WorktreeList use case
+type WorktreeListConfiguration struct {
+	OutputFormat string // --output: "" (default) or "json"
+}
+
+// WorktreeList lists the worktrees of the repository with the issue of their branch
+type WorktreeList struct {
+	Cfg WorktreeListConfiguration
+	Git domain.GitProvider
+}
+
+// Execute executes the worktree list use case
+func (wl WorktreeList) Execute(ctx context.Context) (result WorktreeListResult, err error) {
+	worktrees, err := wl.Git.ListWorktrees(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	result.Worktrees = []WorktreeItem{}
+	for _, worktree := range worktrees {
+		result.Worktrees = append(result.Worktrees, newWorktreeItem(worktree))
+	}
+
+	if wl.Cfg.OutputFormat == "json" {
+		jsonBytes, jsonErr := json.Marshal(result)
+		if jsonErr != nil {
+			return result, fmt.Errorf("failed to serialize result: %w", jsonErr)
+		}
+		fmt.Println(string(jsonBytes))
+	} else {
+		printWorktrees(result)
+	}
+
+	return result, nil
+}
+
+func newWorktreeItem(worktree domain.Worktree) WorktreeItem {
+	item := WorktreeItem{Path: worktree.Path, Branch: worktree.Branch, Main: worktree.Main}
+	if branchNameInfo := branches.ParseBranchName(worktree.Branch); branchNameInfo != nil {
+		item.IssueID = branchNameInfo.IssueId
+	}
+	return item
+}
+
+func printWorktrees(result WorktreeListResult) {
+	w := tabwriter.NewWriter(logging.Output(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PATH\tBRANCH\tISSUE")
+	for _, item := range result.Worktrees {
+		branch, issue := "(detached)", "-"
+		if item.Branch != "" {
+			branch = item.Branch
+		}
+		if item.IssueID != "" {
+			issue = item.IssueID
+		}
+		if item.Main {
+			branch += " (main)"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\n", item.Path, branch, issue)
+	}
+	w.Flush()
+}
+
+// WorktreeRemoveResult holds the outcome of a successful WorktreeRemove execution.
+type WorktreeRemoveResult struct {
+	Path    string `json:"path"`
+	Branch  string `json:"branch"`
+	Removed bool   `json:"removed"`
+}
+
+// WorktreeRemoveConfiguration contains the arguments for the WorktreeRemove use case
+type WorktreeRemoveConfiguration struct {
+	Target        string // issue identifier or path of the worktree
+	Force         bool   // --force: remove the worktree even if it has uncommitted changes
+	IsInteractive bool
+	OutputFormat  string // --output: "" (default) or "json"
+}
+
+// WorktreeRemove removes the worktree of an issue. The branch is kept.
+type WorktreeRemove struct {
+	Cfg                     WorktreeRemoveConfiguration
+	Git                     domain.GitProvider
+	IssueTrackerProvider    domain.IssueTrackerProvider
+	UserInteractionProvider domain.UserInteractionProvider
+}
+
+// Execute executes the worktree remove use case
+func (wr WorktreeRemove) Execute(ctx context.Context) (result WorktreeRemoveResult, err error) {
+	if wr.Cfg.Target == "" {
+		return result, fmt.Errorf("sherpa needs an issue identifier or the path of the worktree")
+	}
+
+	worktree, err := wr.find(ctx)
+	if err != nil {
+		return result, err
+	}
+	if worktree.Main {
+		return result, fmt.Errorf("the main working tree %s cannot be removed", worktree.Path)
+	}
+
+	result.Path = worktree.Path
+	result.Branch = worktree.Branch
+
+	if wr.Cfg.IsInteractive {
+		confirmed, err := wr.UserInteractionProvider.AskUserForConfirmation(
+			fmt.Sprintf("Do you want to remove the worktree %s", worktree.Path), true)
+		if err != nil {
+			return result, err
+		}
+		if !confirmed {
+			return result, nil
+		}
+	}
+
+	if err := wr.Git.RemoveWorktree(ctx, worktree.Path, wr.Cfg.Force); err != nil {
+		return result, err
+	}
+	result.Removed = true
+
+	if wr.Cfg.OutputFormat == "json" {
+		jsonBytes, jsonErr := json.Marshal(result)
+		if jsonErr != nil {
+			return result, fmt.Errorf("failed to serialize result: %w", jsonErr)
+		}
+		fmt.Println(string(jsonBytes))
+	} else {
+		fmt.Fprintf(logging.Output(), "The worktree %s has been removed, the branch %s is kept\n",
+			logging.PaintInfo(worktree.Path), logging.PaintInfo(worktree.Branch))
+	}
+
+	return result, nil
+}
+
+// find returns the worktree at the target path or, if there is none, the only worktree whose
+// branch belongs to the target issue
+func (wr WorktreeRemove) find(ctx context.Context) (worktree domain.Worktree, err error) {
+	worktrees, err := wr.Git.ListWorktrees(ctx)
+	if err != nil {
+		return worktree, err
+	}
+
+	if path, err := filepath.Abs(wr.Cfg.Target); err == nil {
+		for _, w := range worktrees {
+			if filepath.Clean(w.Path) == path {
+				return w, nil
+			}
+		}
+	}
+
+	issueID := wr.IssueTrackerProvider.ParseIssueId(wr.Cfg.Target)
+	if issueID == "" {
+		return worktree, ErrNoWorktree(wr.Cfg.Target)
+	}
+
+	matches := []domain.Worktree{}
+	for _, w := range worktrees {
+		item := newWorktreeItem(w)
+		if item.IssueID != "" && wr.IssueTrackerProvider.ParseIssueId(item.IssueID) == issueID {
+			matches = append(matches, w)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return worktree, ErrNoWorktree(wr.Cfg.Target)
+	case 1:
+		return matches[0], nil
+	default:
+		paths := make([]string, 0, len(matches))
+		for _, w := range matches {
+			paths = append(paths, w.Path)
+		}
+		return worktree, fmt.Errorf("there are several worktrees for %s, remove one of them by its path: %s",
+			wr.Cfg.Target, strings.Join(paths, ", "))
+	}
+}
@@ -0,0 +1,136 @@
+package use_cases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/InditexTech/gh-sherpa/internal/domain"
+	domainFakes "github.com/InditexTech/gh-sherpa/internal/fakes/domain"
+	domainMocks "github.com/InditexTech/gh-sherpa/internal/mocks/domain"
+	"github.com/InditexTech/gh-sherpa/internal/use_cases"
+	"github.com/stretchr/testify/suite"
+)
+
+type WorktreeExecutionTestSuite struct {
+	suite.Suite
+	gitProvider             *domainFakes.FakeGitProvider
+	userInteractionProvider *domainMocks.MockUserInteractionProvider
+	list                    use_cases.WorktreeList
+	remove                  use_cases.WorktreeRemove
+}
+
+func TestWorktreeExecutionTestSuite(t *testing.T) {
+	suite.Run(t, new(WorktreeExecutionTestSuite))
+}
+
+func (s *WorktreeExecutionTestSuite) SetupSubTest() {
+	s.gitProvider = domainFakes.NewFakeGitProvider()
+	s.gitProvider.RepositoryRoot = "/src/repo"
+	s.gitProvider.AddLocalBranches("feature/GH-1-local-issue", "feature/PROJECTKEY-1-jira-issue")
+	s.gitProvider.Worktrees = []domain.Worktree{
+		{Path: "/src/repo-GH-1", Branch: "feature/GH-1-local-issue"},
+		{Path: "/src/repo-PROJECTKEY-1", Branch: "feature/PROJECTKEY-1-jira-issue"},
+		{Path: "/src/repo-review"},
+	}
+
+	s.userInteractionProvider = &domainMocks.MockUserInteractionProvider{}
+
+	s.list = use_cases.WorktreeList{
+		Cfg: use_cases.WorktreeListConfiguration{OutputFormat: "json"},
+		Git: s.gitProvider,
+	}
+	s.remove = use_cases.WorktreeRemove{
+		Cfg:                     use_cases.WorktreeRemoveConfiguration{OutputFormat: "json"},
+		Git:                     s.gitProvider,
+		IssueTrackerProvider:    domainFakes.NewFakeIssueTrackerProvider(),
+		UserInteractionProvider: s.userInteractionProvider,
+	}
+}
+
+func (s *WorktreeExecutionTestSuite) TestWorktreeListExecution() {
+	s.Run("should list the worktrees with the issue of their branch", func() {
+		result, err := s.list.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal([]use_cases.WorktreeItem{
+			{Path: "/src/repo", Branch: "main", Main: true},
+			{Path: "/src/repo-GH-1", Branch: "feature/GH-1-local-issue", IssueID: "GH-1"},
+			{Path: "/src/repo-PROJECTKEY-1", Branch: "feature/PROJECTKEY-1-jira-issue", IssueID: "PROJECTKEY-1"},
+			{Path: "/src/repo-review"},
+		}, result.Worktrees)
+	})
+}
+
+func (s *WorktreeExecutionTestSuite) TestWorktreeRemoveExecution() {
+	s.Run("should remove the worktree of the issue", func() {
+		s.remove.Cfg.Target = "1"
+
+		result, err := s.remove.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal(use_cases.WorktreeRemoveResult{Path: "/src/repo-GH-1", Branch: "feature/GH-1-local-issue", Removed: true}, result)
+		s.Len(s.gitProvider.Worktrees, 2)
+		s.True(s.gitProvider.BranchExists(context.Background(), "feature/GH-1-local-issue"))
+	})
+
+	s.Run("should remove the worktree of a Jira issue", func() {
+		s.remove.Cfg.Target = "PROJECTKEY-1"
+
+		result, err := s.remove.Execute(context.Background())
+
+		s.NoError(err)
+		s.Equal("/src/repo-PROJECTKEY-1", result.Path)
+	})
+
+	s.Run("should remove the worktree at the given path", func() {
+		s.remove.Cfg.Target = "/src/repo-review"
+
+		result, err := s.remove.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(result.Removed)
+		s.Len(s.gitProvider.Worktrees, 2)
+	})
+
+	s.Run("should error if there is no worktree for the issue", func() {
+		s.remove.Cfg.Target = "2"
+
+		_, err := s.remove.Execute(context.Background())
+
+		s.EqualError(err, use_cases.ErrNoWorktree("2").Error())
+	})
+
+	s.Run("should not remove the main working tree", func() {
+		s.remove.Cfg.Target = "/src/repo"
+
+		_, err := s.remove.Execute(context.Background())
+
+		s.ErrorContains(err, "the main working tree /src/repo cannot be removed")
+	})
+
+	s.Run("should error if the worktree has changes unless it is forced", func() {
+		s.remove.Cfg.Target = "1"
+		s.gitProvider.DirtyWorktrees = []string{"/src/repo-GH-1"}
+
+		_, err := s.remove.Execute(context.Background())
+		s.Error(err)
+
+		s.remove.Cfg.Force = true
+		result, err := s.remove.Execute(context.Background())
+
+		s.NoError(err)
+		s.True(result.Removed)
+	})
+
+	s.Run("should keep the worktree if the user does not confirm it", func() {
+		s.remove.Cfg.Target = "1"
+		s.remove.Cfg.IsInteractive = true
+		s.userInteractionProvider.EXPECT().AskUserForConfirmation("Do you want to remove the worktree /src/repo-GH-1", true).Return(false, nil).Once()
+
+		result, err := s.remove.Execute(context.Background())
+
+		s.NoError(err)
+		s.False(result.Removed)
+		s.Len(s.gitProvider.Worktrees, 3)
+	})
+}
@@ -6,45 +6,44 @@ import (
 	"strings"
 )
 
+// ExtractRepoFromURL returns the owner and name of the repository of a GitHub remote URL, or the URL
+// as it is if it is not a GitHub URL
 func ExtractRepoFromURL(gitURL string) string {
-	if strings.Contains(gitURL, "@github.com") {
-		return extractFromSSHURL(gitURL)
-	}
-
 	if strings.HasPrefix(gitURL, "http://") || strings.HasPrefix(gitURL, "https://") {
 		return extractFromHTTPSURL(gitURL)
 	}
 
+	if strings.HasPrefix(gitURL, "ssh://") || strings.Contains(gitURL, "@") {
+		return extractFromSSHURL(gitURL)
+	}
+
 	return gitURL
 }
 
 func extractFromSSHURL(sshURL string) string {
-	if !strings.Contains(sshURL, "@github.com") {
-		return sshURL
-	}
+	var host, path string
 
 	if strings.HasPrefix(sshURL, "ssh://") {
-		parts := strings.Split(sshURL, "github.com/")
-		if len(parts) != 2 {
+		parsedURL, err := url.Parse(sshURL)
+		if err != nil {
 			return sshURL
 		}
-
-		pathParts := strings.Split(parts[1], "/")
-		if len(pathParts) >= 2 {
-			owner := pathParts[0]
-			repo := strings.TrimSuffix(pathParts[1], ".git")
-			return fmt.Sprintf("%s/%s", owner, repo)
+		host, path = parsedURL.Hostname(), parsedURL.Path
+	} else {
+		// scp-like syntax: user@host:owner/repo.git
+		userHost, repoPath, found := strings.Cut(sshURL, ":")
+		if !found {
+			return sshURL
 		}
-
-		return sshURL
+		_, host, _ = strings.Cut(userHost, "@")
+		path = repoPath
 	}
 
-	parts := strings.Split(sshURL, "@github.com:")
-	if len(parts) != 2 {
+	if !IsGitHubHost(host) {
 		return sshURL
 	}
 
-	repoParts := strings.Split(parts[1], "/")
+	repoParts := strings.Split(strings.Trim(path, "/"), "/")
 	if len(repoParts) >= 2 {
 		owner := repoParts[0]
 		repo := strings.TrimSuffix(repoParts[1], ".git")
@@ -63,7 +62,7 @@ func extractFromHTTPSURL(httpsURL string) string {
 		return httpsURL
 	}
 
-	if !isGitHubHost(parsedURL.Host) {
+	if !IsGitHubHost(parsedURL.Hostname()) {
 		return httpsURL
 	}
 
@@ -94,6 +93,20 @@ func extractFromHTTPSURL(httpsURL string) string {
 	return httpsURL
 }
 
-func isGitHubHost(host string) bool {
-	return host == "github.com" || host == "www.github.com"
+// HostFromURL returns the host of a remote URL, or an empty string if it is not a URL
+func HostFromURL(gitURL string) string {
+	if strings.HasPrefix(gitURL, "http://") || strings.HasPrefix(gitURL, "https://") || strings.HasPrefix(gitURL, "ssh://") {
+		parsedURL, err := url.Parse(gitURL)
+		if err != nil {
+			return ""
+		}
+		return parsedURL.Hostname()
+	}
+
+	if userHost, _, found := strings.Cut(gitURL, ":"); found && strings.Contains(userHost, "@") {
+		_, host, _ := strings.Cut(userHost, "@")
+		return host
+	}
+
+	return ""
 }
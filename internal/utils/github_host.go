@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+)
+
+// DefaultGitHubHost is the host of github.com
+const DefaultGitHubHost = "github.com"
+
+var (
+	gitHubHost  = DefaultGitHubHost
+	gitHubHosts = []string{DefaultGitHubHost, "www.github.com"}
+)
+
+// SetGitHubHost sets the host of the GitHub instance of the repository, e.g. a GitHub Enterprise
+// Server host, and recognizes it in the remote URLs
+func SetGitHubHost(host string) {
+	host = normalizeHost(host)
+	if host == "" {
+		return
+	}
+
+	gitHubHost = host
+	AddGitHubHosts(host)
+}
+
+// AddGitHubHosts recognizes the given hosts as GitHub hosts in the remote URLs
+func AddGitHubHosts(hosts ...string) {
+	for _, host := range hosts {
+		if host = normalizeHost(host); host != "" && !slices.Contains(gitHubHosts, host) {
+			gitHubHosts = append(gitHubHosts, host)
+		}
+	}
+}
+
+// GitHubHost returns the host of the GitHub instance of the repository, github.com by default
+func GitHubHost() string {
+	return gitHubHost
+}
+
+// IsGitHubHost reports whether the host is github.com or a known GitHub Enterprise Server host
+func IsGitHubHost(host string) bool {
+	return slices.Contains(gitHubHosts, normalizeHost(host))
+}
+
+// normalizeHost removes the scheme, path and letter case of a host
+func normalizeHost(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if _, rest, found := strings.Cut(host, "://"); found {
+		host = rest
+	}
+	host, _, _ = strings.Cut(host, "/")
+	return host
+}
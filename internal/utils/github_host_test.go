@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func useGitHubHost(t *testing.T, host string) {
+	originalHost, originalHosts := gitHubHost, gitHubHosts
+	t.Cleanup(func() {
+		gitHubHost, gitHubHosts = originalHost, originalHosts
+	})
+
+	SetGitHubHost(host)
+}
+
+func TestSetGitHubHost(t *testing.T) {
+	useGitHubHost(t, "https://GitHub.Example.com/")
+
+	assert.Equal(t, "github.example.com", GitHubHost())
+	assert.True(t, IsGitHubHost("github.example.com"))
+	assert.True(t, IsGitHubHost("github.com"))
+	assert.False(t, IsGitHubHost("gitlab.com"))
+}
+
+func TestExtractRepoFromURLWithEnterpriseHost(t *testing.T) {
+	useGitHubHost(t, "github.example.com")
+
+	tests := []struct {
+		name     string
+		url      string
+		expected string
+	}{
+		{name: "HTTPS URL", url: "https://github.example.com/owner/repo.git", expected: "owner/repo"},
+		{name: "SSH URL", url: "git@github.example.com:owner/repo.git", expected: "owner/repo"},
+		{name: "SSH URL with scheme and port", url: "ssh://git@github.example.com:2222/owner/repo.git", expected: "owner/repo"},
+		{name: "Unknown host", url: "git@git.example.com:owner/repo.git", expected: "git@git.example.com:owner/repo.git"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ExtractRepoFromURL(tt.url))
+		})
+	}
+}
+
+func TestHostFromURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "https://github.example.com/owner/repo.git", want: "github.example.com"},
+		{url: "git@github.example.com:owner/repo.git", want: "github.example.com"},
+		{url: "ssh://git@github.example.com:2222/owner/repo.git", want: "github.example.com"},
+		{url: "/path/to/repo", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			assert.Equal(t, tt.want, HostFromURL(tt.url))
+		})
+	}
+}